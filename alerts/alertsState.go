@@ -0,0 +1,336 @@
+package alerts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+var log = logger.GetOrCreate("alerts")
+
+const (
+	storageKey = "Alerts"
+
+	// oneShotRetention is how long a one-shot alert is kept active after it was last raised, unless acknowledged
+	oneShotRetention = time.Hour
+)
+
+// ArgsAlertsState defines the arguments needed to create a new alerts state
+type ArgsAlertsState struct {
+	Storer clients.Storer
+}
+
+type alertsState struct {
+	storer         clients.Storer
+	active         map[string]*ActiveAlert
+	silences       map[string]Silence
+	mut            sync.RWMutex
+	getTimeHandler func() time.Time
+}
+
+// NewAlertsState will create a new alerts state instance, loading the previously persisted state, if any
+func NewAlertsState(args ArgsAlertsState) (*alertsState, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	as := &alertsState{
+		storer:         args.Storer,
+		active:         make(map[string]*ActiveAlert),
+		silences:       make(map[string]Silence),
+		getTimeHandler: time.Now,
+	}
+	as.load()
+
+	return as, nil
+}
+
+func (as *alertsState) load() {
+	state := persistedState{}
+	err := as.storer.Get(storageKey, &state)
+	if err != nil {
+		log.Debug("no stored alerts state will be used", "reason", err.Error())
+		return
+	}
+
+	for idx := range state.Active {
+		activeAlert := state.Active[idx]
+		as.active[activeAlert.ID] = &activeAlert
+	}
+	for _, silence := range state.Silences {
+		as.silences[silence.PubKey] = silence
+	}
+
+	log.Info("loaded stored alerts state", "num active alerts", len(as.active), "num silences", len(as.silences))
+}
+
+// ProcessEvent will update the active alerts out of the event received from a client and will return the
+// event holding all the alerts which are still firing, so that the next handlers know them; every notified line
+// is prefixed with its alert id. The alerts of the client which are no longer firing are returned as resolved.
+// The acknowledged alerts and the alerts of the silenced keys are not removed here, as they are only muted
+// when delivered. The one-shot alerts are passed on as still firing until acknowledged or until the retention passed
+func (as *alertsState) ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage {
+	as.mut.Lock()
+	defer as.mut.Unlock()
+
+	now := as.getTimeHandler()
+	as.removeExpiredSilences(now)
+
+	firing := make(map[string]struct{})
+	for _, alert := range event.Alerts {
+		firing[alert.ID] = struct{}{}
+
+		activeAlert, ok := as.active[alert.ID]
+		if !ok {
+			as.active[alert.ID] = &ActiveAlert{
+				Alert:      alert,
+				FiredAt:    now,
+				LastSeenAt: now,
+			}
+			continue
+		}
+
+		activeAlert.Alert = alert
+		activeAlert.LastSeenAt = now
+	}
+
+	resolved := make([]data.Alert, 0)
+	retained := make([]data.Alert, 0)
+	for id, activeAlert := range as.active {
+		if activeAlert.ClientID != clientID {
+			continue
+		}
+		if _, ok := firing[id]; ok {
+			continue
+		}
+		if isRetained(activeAlert, now) {
+			alert := activeAlert.Alert
+			alert.Changed = false
+			retained = append(retained, alert)
+			continue
+		}
+
+		log.Info("alert resolved", "alert", id, "node", activeAlert.NodeName, "condition", activeAlert.Condition)
+		resolved = append(resolved, activeAlert.Alert)
+		delete(as.active, id)
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].ID < resolved[j].ID
+	})
+	if len(retained) > 0 {
+		sort.Slice(retained, func(i, j int) bool {
+			return retained[i].ID < retained[j].ID
+		})
+		event.Alerts = append(append(make([]data.Alert, 0, len(event.Alerts)+len(retained)), event.Alerts...), retained...)
+	}
+
+	as.save()
+
+	if len(event.Alerts) != 0 {
+		event = createEvent(event)
+	}
+	if len(resolved) == 0 {
+		return event
+	}
+
+	event.Resolved = resolved
+	if event.Level < common.InfoEvent {
+		event.Level = common.InfoEvent
+	}
+
+	return event
+}
+
+// isRetained returns true if the one-shot alert is still kept active, even if it was not raised again
+func isRetained(activeAlert *ActiveAlert, now time.Time) bool {
+	if !activeAlert.OneShot || activeAlert.Acknowledged {
+		return false
+	}
+
+	return now.Sub(activeAlert.LastSeenAt) < oneShotRetention
+}
+
+// createEvent builds the event holding all the alerts, with the lines of the changed alerts prefixed by their id
+func createEvent(event data.NotificationMessage) data.NotificationMessage {
+	result := data.NotificationMessage{
+		Level:  common.InfoEvent,
+		Alerts: event.Alerts,
+	}
+	if event.Level < result.Level {
+		result.Level = event.Level
+	}
+
+	lines := make([]string, 0, len(event.Alerts))
+	for _, alert := range event.Alerts {
+		if !alert.Changed {
+			continue
+		}
+
+		if alert.Level > result.Level {
+			result.Level = alert.Level
+		}
+		lines = append(lines, fmt.Sprintf("[%s] %s\n", alert.ID, alert.Message))
+	}
+	result.Message = strings.Join(lines, "")
+
+	return result
+}
+
+// IsMuted returns true if the alert has been acknowledged or its key is silenced, so it should not be delivered
+func (as *alertsState) IsMuted(alert data.Alert) bool {
+	as.mut.RLock()
+	defer as.mut.RUnlock()
+
+	silence, silenced := as.silences[alert.PubKey]
+	if silenced && as.getTimeHandler().Before(silence.Until) {
+		return true
+	}
+
+	activeAlert, ok := as.active[alert.ID]
+
+	return ok && activeAlert.Acknowledged
+}
+
+func (as *alertsState) removeExpiredSilences(now time.Time) {
+	for pubKey, silence := range as.silences {
+		if now.Before(silence.Until) {
+			continue
+		}
+
+		log.Info("silence expired", "key", pubKey)
+		delete(as.silences, pubKey)
+	}
+}
+
+// Acknowledge will mark the active alert as acknowledged: it will not be notified again until it is resolved
+func (as *alertsState) Acknowledge(alertID string, by string) (ActiveAlert, error) {
+	as.mut.Lock()
+	defer as.mut.Unlock()
+
+	activeAlert, ok := as.active[strings.ToLower(alertID)]
+	if !ok {
+		return ActiveAlert{}, fmt.Errorf("%w %s", ErrUnknownAlert, alertID)
+	}
+
+	if !activeAlert.Acknowledged {
+		activeAlert.Acknowledged = true
+		activeAlert.AcknowledgedBy = by
+		log.Info("alert acknowledged", "alert", activeAlert.ID, "by", by)
+		as.save()
+	}
+
+	return *activeAlert, nil
+}
+
+// Silence will mute all the alerts of the provided public key for the provided duration
+func (as *alertsState) Silence(pubKey string, duration time.Duration, by string) (Silence, error) {
+	if duration <= 0 {
+		return Silence{}, fmt.Errorf("%w: %s", ErrInvalidSilenceDuration, duration)
+	}
+
+	as.mut.Lock()
+	defer as.mut.Unlock()
+
+	silence := Silence{
+		PubKey: pubKey,
+		Until:  as.getTimeHandler().Add(duration),
+		By:     by,
+	}
+	as.silences[pubKey] = silence
+	log.Info("key silenced", "key", pubKey, "until", silence.Until, "by", by)
+	as.save()
+
+	return silence, nil
+}
+
+// ActiveAlerts returns the alerts which are still firing, the oldest first
+func (as *alertsState) ActiveAlerts() []ActiveAlert {
+	as.mut.RLock()
+	defer as.mut.RUnlock()
+
+	activeAlerts := make([]ActiveAlert, 0, len(as.active))
+	for _, activeAlert := range as.active {
+		activeAlerts = append(activeAlerts, *activeAlert)
+	}
+	sort.Slice(activeAlerts, func(i, j int) bool {
+		if activeAlerts[i].FiredAt.Equal(activeAlerts[j].FiredAt) {
+			return activeAlerts[i].ID < activeAlerts[j].ID
+		}
+
+		return activeAlerts[i].FiredAt.Before(activeAlerts[j].FiredAt)
+	})
+
+	return activeAlerts
+}
+
+// FiringAlerts returns the alerts of the client which are still firing, sorted by id. The one-shot alerts are left
+// out, as they are not raised again while their condition lasts
+func (as *alertsState) FiringAlerts(clientID string) []data.Alert {
+	as.mut.RLock()
+	defer as.mut.RUnlock()
+
+	firingAlerts := make([]data.Alert, 0)
+	for _, activeAlert := range as.active {
+		if activeAlert.ClientID != clientID || activeAlert.OneShot {
+			continue
+		}
+		firingAlerts = append(firingAlerts, activeAlert.Alert)
+	}
+	sort.Slice(firingAlerts, func(i, j int) bool {
+		return firingAlerts[i].ID < firingAlerts[j].ID
+	})
+
+	return firingAlerts
+}
+
+// Silences returns the silences which did not expire yet, the first to expire first
+func (as *alertsState) Silences() []Silence {
+	as.mut.RLock()
+	defer as.mut.RUnlock()
+
+	now := as.getTimeHandler()
+	silences := make([]Silence, 0, len(as.silences))
+	for _, silence := range as.silences {
+		if !now.Before(silence.Until) {
+			continue
+		}
+
+		silences = append(silences, silence)
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		return silences[i].Until.Before(silences[j].Until)
+	})
+
+	return silences
+}
+
+func (as *alertsState) save() {
+	state := persistedState{
+		Active:   make([]ActiveAlert, 0, len(as.active)),
+		Silences: make([]Silence, 0, len(as.silences)),
+	}
+	for _, activeAlert := range as.active {
+		state.Active = append(state.Active, *activeAlert)
+	}
+	for _, silence := range as.silences {
+		state.Silences = append(state.Silences, silence)
+	}
+
+	err := as.storer.Put(storageKey, state)
+	if err != nil {
+		log.Warn("failed to save alerts state", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (as *alertsState) IsInterfaceNil() bool {
+	return as == nil
+}
@@ -0,0 +1,290 @@
+package alerts_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "client"
+
+func createAlert(pubKey string, level common.EventLevel, changed bool) data.Alert {
+	return data.Alert{
+		ID:        data.NewAlertID(testClientID, pubKey, "condition"),
+		ClientID:  testClientID,
+		Condition: "condition",
+		PubKey:    pubKey,
+		NodeName:  pubKey + "-name",
+		Level:     level,
+		Message:   pubKey + " message",
+		Changed:   changed,
+	}
+}
+
+func createEvent(alertsList ...data.Alert) data.NotificationMessage {
+	event := data.NotificationMessage{Level: common.InfoEvent, Alerts: alertsList}
+	for _, alert := range alertsList {
+		if alert.Changed && alert.Level > event.Level {
+			event.Level = alert.Level
+		}
+		if alert.Changed {
+			event.Message += alert.Message + "\n"
+		}
+	}
+
+	return event
+}
+
+func createAlertsState(t *testing.T) (*alerts.AlertsState, *time.Time) {
+	as, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+	require.Nil(t, err)
+
+	now := time.Unix(1000, 0)
+	as.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+
+	return as, &now
+}
+
+func TestNewAlertsState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		as, err := alerts.NewAlertsState(alerts.ArgsAlertsState{})
+		require.Nil(t, as)
+		assert.Equal(t, alerts.ErrNilStorer, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		as, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+		require.Nil(t, err)
+		assert.False(t, as.IsInterfaceNil())
+		assert.Empty(t, as.ActiveAlerts())
+		assert.Empty(t, as.Silences())
+	})
+}
+
+func TestAlertsState_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("events without alerts are not changed", func(t *testing.T) {
+		t.Parallel()
+
+		as, _ := createAlertsState(t)
+		event := data.NotificationMessage{Message: "message", Level: common.CriticalEvent}
+		assert.Equal(t, event, as.ProcessEvent(testClientID, event))
+	})
+
+	t.Run("alerts are tracked until resolved", func(t *testing.T) {
+		t.Parallel()
+
+		as, _ := createAlertsState(t)
+		alert1 := createAlert("key1", common.CriticalEvent, true)
+		alert2 := createAlert("key2", common.WarningEvent, true)
+
+		event := as.ProcessEvent(testClientID, createEvent(alert1, alert2))
+		assert.Equal(t, common.CriticalEvent, event.Level)
+		assert.Equal(t, "["+alert1.ID+"] key1 message\n["+alert2.ID+"] key2 message\n", event.Message)
+		assert.Len(t, event.Alerts, 2)
+		assert.Len(t, as.ActiveAlerts(), 2)
+
+		// alert2 still firing without changes, alert1 resolved
+		alert2.Changed = false
+		event = as.ProcessEvent(testClientID, createEvent(alert2))
+		assert.Equal(t, common.InfoEvent, event.Level)
+		assert.Empty(t, event.Message)
+		assert.Equal(t, []data.Alert{alert1}, event.Resolved)
+		activeAlerts := as.ActiveAlerts()
+		require.Len(t, activeAlerts, 1)
+		assert.Equal(t, alert2.ID, activeAlerts[0].ID)
+
+		// alerts of other clients are not resolved
+		event = as.ProcessEvent("another client", data.NotificationMessage{})
+		assert.Empty(t, event.Resolved)
+		assert.Len(t, as.ActiveAlerts(), 1)
+
+		event = as.ProcessEvent(testClientID, data.NotificationMessage{})
+		assert.Equal(t, common.InfoEvent, event.Level)
+		require.Len(t, event.Resolved, 1)
+		assert.Equal(t, alert2.ID, event.Resolved[0].ID)
+		assert.Empty(t, as.ActiveAlerts())
+	})
+
+	t.Run("acknowledged alerts are muted until resolved", func(t *testing.T) {
+		t.Parallel()
+
+		as, _ := createAlertsState(t)
+		alert := createAlert("key1", common.CriticalEvent, true)
+		_ = as.ProcessEvent(testClientID, createEvent(alert))
+
+		_, err := as.Acknowledge("missing", "user")
+		assert.True(t, errors.Is(err, alerts.ErrUnknownAlert))
+
+		ackedAlert, err := as.Acknowledge(alert.ID, "user")
+		require.Nil(t, err)
+		assert.True(t, ackedAlert.Acknowledged)
+		assert.Equal(t, "user", ackedAlert.AcknowledgedBy)
+
+		// still passed on, so the next handlers know it is firing, but muted
+		event := as.ProcessEvent(testClientID, createEvent(alert))
+		assert.Equal(t, []data.Alert{alert}, event.Alerts)
+		assert.True(t, as.IsMuted(alert))
+
+		// resolved, the next occurrence should be notified
+		_ = as.ProcessEvent(testClientID, data.NotificationMessage{})
+		event = as.ProcessEvent(testClientID, createEvent(alert))
+		assert.Equal(t, common.CriticalEvent, event.Level)
+		assert.Len(t, event.Alerts, 1)
+		assert.False(t, as.IsMuted(alert))
+	})
+
+	t.Run("silenced keys are muted until the silence expires", func(t *testing.T) {
+		t.Parallel()
+
+		as, now := createAlertsState(t)
+		alert1 := createAlert("key1", common.CriticalEvent, true)
+		alert2 := createAlert("key2", common.WarningEvent, true)
+
+		_, err := as.Silence("key1", 0, "user")
+		assert.True(t, errors.Is(err, alerts.ErrInvalidSilenceDuration))
+
+		silence, err := as.Silence("key1", time.Hour, "user")
+		require.Nil(t, err)
+		assert.Equal(t, now.Add(time.Hour), silence.Until)
+		assert.Equal(t, []alerts.Silence{silence}, as.Silences())
+
+		event := as.ProcessEvent(testClientID, createEvent(alert1, alert2))
+		assert.Len(t, event.Alerts, 2)
+		assert.Len(t, as.ActiveAlerts(), 2)
+		assert.True(t, as.IsMuted(alert1))
+		assert.False(t, as.IsMuted(alert2))
+
+		*now = now.Add(time.Hour)
+		assert.Empty(t, as.Silences())
+		assert.False(t, as.IsMuted(alert1))
+	})
+
+	t.Run("one-shot alerts are kept firing until acknowledged or retained long enough", func(t *testing.T) {
+		t.Parallel()
+
+		as, now := createAlertsState(t)
+		alert1 := createAlert("key1", common.CriticalEvent, true)
+		alert1.OneShot = true
+		alert2 := createAlert("key2", common.CriticalEvent, true)
+		alert2.OneShot = true
+		_ = as.ProcessEvent(testClientID, createEvent(alert1, alert2))
+
+		// not raised again, both are passed on as still firing
+		*now = now.Add(time.Minute)
+		event := as.ProcessEvent(testClientID, data.NotificationMessage{})
+		assert.Empty(t, event.Resolved)
+		assert.Empty(t, event.Message)
+		require.Len(t, event.Alerts, 2)
+		assert.False(t, event.Alerts[0].Changed)
+		assert.False(t, event.Alerts[1].Changed)
+
+		// the acknowledged one is resolved with the next event
+		_, err := as.Acknowledge(alert1.ID, "operator")
+		require.Nil(t, err)
+		*now = now.Add(time.Minute)
+		event = as.ProcessEvent(testClientID, data.NotificationMessage{})
+		require.Len(t, event.Resolved, 1)
+		assert.Equal(t, alert1.ID, event.Resolved[0].ID)
+		require.Len(t, event.Alerts, 1)
+		assert.Equal(t, alert2.ID, event.Alerts[0].ID)
+
+		// raised again, the retention starts over
+		*now = now.Add(time.Minute * 50)
+		_ = as.ProcessEvent(testClientID, createEvent(alert2))
+		*now = now.Add(time.Minute * 50)
+		event = as.ProcessEvent(testClientID, data.NotificationMessage{})
+		assert.Empty(t, event.Resolved)
+		assert.Len(t, event.Alerts, 1)
+
+		*now = now.Add(time.Minute * 10)
+		event = as.ProcessEvent(testClientID, data.NotificationMessage{})
+		require.Len(t, event.Resolved, 1)
+		assert.Equal(t, alert2.ID, event.Resolved[0].ID)
+		assert.Empty(t, event.Alerts)
+		assert.Empty(t, as.ActiveAlerts())
+	})
+
+	t.Run("unchanged alerts are passed on without their lines", func(t *testing.T) {
+		t.Parallel()
+
+		as, _ := createAlertsState(t)
+		alert1 := createAlert("key1", common.CriticalEvent, false)
+		alert2 := createAlert("key2", common.WarningEvent, true)
+
+		event := as.ProcessEvent(testClientID, createEvent(alert1, alert2))
+		assert.Equal(t, []data.Alert{alert1, alert2}, event.Alerts)
+		assert.Equal(t, common.WarningEvent, event.Level)
+		assert.Equal(t, "["+alert2.ID+"] key2 message\n", event.Message)
+	})
+}
+
+func TestAlertsState_Persistence(t *testing.T) {
+	t.Parallel()
+
+	storer, err := storage.NewJSONFileStorer(filepath.Join(t.TempDir(), "state.json"))
+	require.Nil(t, err)
+
+	as, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: storer})
+	require.Nil(t, err)
+
+	alert := createAlert("key1", common.CriticalEvent, true)
+	_ = as.ProcessEvent(testClientID, createEvent(alert))
+	_, err = as.Acknowledge(alert.ID, "user")
+	require.Nil(t, err)
+	_, err = as.Silence("key2", time.Hour, "user")
+	require.Nil(t, err)
+
+	reloaded, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: storer})
+	require.Nil(t, err)
+
+	activeAlerts := reloaded.ActiveAlerts()
+	require.Len(t, activeAlerts, 1)
+	assert.Equal(t, alert.ID, activeAlerts[0].ID)
+	assert.True(t, activeAlerts[0].Acknowledged)
+	require.Len(t, reloaded.Silences(), 1)
+	assert.Equal(t, "key2", reloaded.Silences()[0].PubKey)
+}
+
+func TestAlertsState_FiringAlerts(t *testing.T) {
+	t.Parallel()
+
+	as, _ := createAlertsState(t)
+
+	alert1 := createAlert("key1", common.CriticalEvent, true)
+	alert2 := createAlert("key2", common.WarningEvent, true)
+	oneShotAlert := createAlert("key3", common.WarningEvent, true)
+	oneShotAlert.OneShot = true
+	otherClientAlert := createAlert("key4", common.WarningEvent, true)
+	otherClientAlert.ClientID = "other"
+	otherClientAlert.ID = data.NewAlertID("other", "key4", "condition")
+
+	_ = as.ProcessEvent(testClientID, createEvent(alert2, alert1, oneShotAlert))
+	_ = as.ProcessEvent("other", createEvent(otherClientAlert))
+
+	expected := []data.Alert{alert1, alert2}
+	if alert2.ID < alert1.ID {
+		expected = []data.Alert{alert2, alert1}
+	}
+	assert.Equal(t, expected, as.FiringAlerts(testClientID))
+	assert.Equal(t, []data.Alert{otherClientAlert}, as.FiringAlerts("other"))
+	assert.Empty(t, as.FiringAlerts("missing"))
+}
@@ -0,0 +1,29 @@
+package alerts
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+// ActiveAlert holds an alert which is still firing
+type ActiveAlert struct {
+	data.Alert
+	FiredAt        time.Time
+	LastSeenAt     time.Time
+	Acknowledged   bool
+	AcknowledgedBy string
+}
+
+// Silence holds the mute applied to the alerts of a public key
+type Silence struct {
+	PubKey string
+	Until  time.Time
+	By     string
+}
+
+// persistedState defines the alerts state persisted between runs
+type persistedState struct {
+	Active   []ActiveAlert
+	Silences []Silence
+}
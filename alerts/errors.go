@@ -0,0 +1,12 @@
+package alerts
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrUnknownAlert signals that the provided alert id does not match any active alert
+var ErrUnknownAlert = errors.New("unknown alert")
+
+// ErrInvalidSilenceDuration signals that an invalid silence duration has been provided
+var ErrInvalidSilenceDuration = errors.New("invalid silence duration")
@@ -0,0 +1,13 @@
+package alerts
+
+import "time"
+
+// SetGetTimeHandler -
+func (as *alertsState) SetGetTimeHandler(handler func() time.Time) {
+	as.mut.Lock()
+	as.getTimeHandler = handler
+	as.mut.Unlock()
+}
+
+// AlertsState -
+type AlertsState = alertsState
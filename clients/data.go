@@ -32,3 +32,10 @@ type APINode struct {
 	ValidatorSuccess           int     `json:"validatorSuccess"`
 	Position                   int     `json:"position"`
 }
+
+// APIStats defines the network stats returned by the api
+type APIStats struct {
+	Epoch          uint32 `json:"epoch"`
+	RoundsPassed   int    `json:"roundsPassed"`
+	RoundsPerEpoch int    `json:"roundsPerEpoch"`
+}
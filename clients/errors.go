@@ -0,0 +1,9 @@
+package clients
+
+import "errors"
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
+
+// ErrEmptyApiUrl signals that an empty api url has been provided
+var ErrEmptyApiUrl = errors.New("empty api url has been provided")
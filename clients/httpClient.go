@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -11,12 +12,14 @@ import (
 
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
 )
 
 var log = logger.GetOrCreate("clients/httpClient")
 
 const (
 	minReqTimeoutSec = 1
+	maxErrorBodySize = 4096
 )
 
 type httpClientWrapper struct {
@@ -81,38 +84,61 @@ func (hcw *httpClientWrapper) CallGetRestEndPoint(
 func (hcw *httpClientWrapper) CallPostRestEndPoint(
 	address string,
 	path string,
-	data interface{},
+	payload interface{},
 ) error {
-	buff, err := json.Marshal(data)
+	buff, err := json.Marshal(payload)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, address+path, bytes.NewReader(buff))
+	_, err = hcw.CallRestEndPoint(data.HTTPRequest{
+		Method: http.MethodPost,
+		URL:    address + path,
+		Body:   buff,
+	})
+
+	return err
+}
+
+// CallRestEndPoint sends the provided request and returns the response body. The provided headers override the
+// default ones and a non successful status code is returned as a common.HTTPStatusError
+func (hcw *httpClientWrapper) CallRestEndPoint(request data.HTTPRequest) ([]byte, error) {
+	req, err := http.NewRequest(request.Method, request.URL, bytes.NewReader(request.Body))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	userAgent := "MultiversX Node Monitoring / 1.0.0 <Requesting data from api>"
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
+	if len(request.Body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("User-Agent", userAgent)
+	for name, value := range request.Headers {
+		req.Header.Set(name, value)
+	}
 
 	resp, err := hcw.httpClient.Do(req)
 	if err != nil {
-		if isTimeoutError(err) {
-			return err
-		}
-
-		return err
+		return nil, err
 	}
 
-	errNotCritical := resp.Body.Close()
-	if errNotCritical != nil {
-		log.Warn("base process GET: close body", "error", errNotCritical.Error())
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("base process request: close body", "method", request.Method, "error", errNotCritical.Error())
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, &common.HTTPStatusError{
+			StatusCode: resp.StatusCode,
+			Body:       body,
+		}
 	}
 
-	return nil
+	return ioutil.ReadAll(resp.Body)
 }
 
 func isTimeoutError(err error) bool {
@@ -0,0 +1,125 @@
+package clients_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPClientWrapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request timeout", func(t *testing.T) {
+		t.Parallel()
+
+		hcw, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 0})
+		require.Nil(t, hcw)
+		assert.True(t, errors.Is(err, common.ErrInvalidValue))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hcw, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 1})
+		require.Nil(t, err)
+		assert.False(t, hcw.IsInterfaceNil())
+	})
+}
+
+func TestCallPostRestEndPoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successful status code", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/path", r.URL.Path)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		hcw, _ := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 1})
+		err := hcw.CallPostRestEndPoint(server.URL, "/path", map[string]string{"text": "message"})
+		require.Nil(t, err)
+	})
+
+	t.Run("not successful status code", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("invalid_token"))
+		}))
+		defer server.Close()
+
+		hcw, _ := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 1})
+		err := hcw.CallPostRestEndPoint(server.URL, "", map[string]string{"text": "message"})
+
+		statusErr := &common.HTTPStatusError{}
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusForbidden, statusErr.StatusCode)
+		assert.Equal(t, "invalid_token", string(statusErr.Body))
+	})
+}
+
+func TestCallRestEndPoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("custom method, headers and body", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/path", r.URL.Path)
+			assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+			assert.Equal(t, "GenieKey key", r.Header.Get("Authorization"))
+
+			body, err := ioutil.ReadAll(r.Body)
+			require.Nil(t, err)
+			assert.Equal(t, "body", string(body))
+
+			_, _ = w.Write([]byte("response"))
+		}))
+		defer server.Close()
+
+		hcw, _ := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 1})
+		response, err := hcw.CallRestEndPoint(data.HTTPRequest{
+			Method: http.MethodPut,
+			URL:    server.URL + "/path",
+			Headers: map[string]string{
+				"Content-Type":  "text/plain",
+				"Authorization": "GenieKey key",
+			},
+			Body: []byte("body"),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "response", string(response))
+	})
+
+	t.Run("not successful status code", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		hcw, _ := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 1})
+		response, err := hcw.CallRestEndPoint(data.HTTPRequest{Method: http.MethodGet, URL: server.URL})
+		assert.Nil(t, response)
+
+		statusErr := &common.HTTPStatusError{}
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusUnauthorized, statusErr.StatusCode)
+	})
+}
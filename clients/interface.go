@@ -1,7 +1,23 @@
 package clients
 
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
 // HTTPClient defines the behaviour of a http client
 type HTTPClient interface {
 	CallGetRestEndPoint(address string, path string) ([]byte, error)
+	CallRestEndPoint(request data.HTTPRequest) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// NodesMetricsHandler defines the behaviour of a component able to export the metrics of the fetched nodes
+type NodesMetricsHandler interface {
+	UpdateNodeMetrics(node APINode)
+	IsInterfaceNil() bool
+}
+
+// Storer defines the behaviour of a component able to persist values between runs
+type Storer interface {
+	Put(key string, value interface{}) error
+	Get(key string, value interface{}) error
 	IsInterfaceNil() bool
 }
@@ -10,3 +10,9 @@ var ErrEmptyPubKeys = errors.New("no public keys provided in config")
 
 // ErrEmptyApiUrl signals that an empty api url has been provided
 var ErrEmptyApiUrl = errors.New("empty api url has been provided")
+
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
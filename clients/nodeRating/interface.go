@@ -0,0 +1,8 @@
+package noderating
+
+import "github.com/multiversx/mx-chain-node-monitoring/clients"
+
+// nodesFetcher defines the behaviour of a component able to fetch the nodes info from api
+type nodesFetcher interface {
+	FetchNodes(pubKeys []string) ([]clients.APINode, error)
+}
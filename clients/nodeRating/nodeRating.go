@@ -1,9 +1,9 @@
 package noderating
 
 import (
-	"encoding/json"
 	"fmt"
 	"math"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -18,24 +18,37 @@ var log = logger.GetOrCreate("clients/nodeRating")
 const (
 	maxRating        = 100
 	defaultLastValue = -1.0
-)
+	clientID         = "NodeRating"
 
-const (
-	// TODO: handle a more generic path; we should be able to provide also node's api
-	nodesBLSKeyPath = "/nodes/%s"
+	conditionRatingDecrease      = "ratingDecrease"
+	conditionTempRatingThreshold = "tempRatingThreshold"
 )
 
 // ArgsNodeRating defines the arguments needed to create a new client
 type ArgsNodeRating struct {
-	Client clients.HTTPClient
-	Config *config.NodeRating
+	Client         clients.HTTPClient
+	MetricsHandler clients.NodesMetricsHandler
+	Storer         clients.Storer
+	Config         *config.NodeRating
+	// LastValues holds the temp rating values to be used as baseline, for the keys that are still configured.
+	// If not provided, the values persisted by the storer will be used
+	LastValues map[string]float64
+	// ThresholdLevels holds the absolute threshold levels already notified, for the keys that are still configured,
+	// so that the nodes below the thresholds are not notified again
+	ThresholdLevels map[string]common.EventLevel
 }
 
 type nodeRating struct {
-	httpClient clients.HTTPClient
-	lastValues map[string]float64
-	firstRun   bool
-	config     *config.NodeRating
+	nodesFetcher    nodesFetcher
+	metricsHandler  clients.NodesMetricsHandler
+	storer          clients.Storer
+	lastValues      map[string]float64
+	lastNodes       []clients.APINode
+	thresholdLevels map[string]common.EventLevel
+	nodesLabels     map[string]config.NodeLabels
+	mutLastValues   sync.RWMutex
+	firstRun        bool
+	config          *config.NodeRating
 }
 
 // NewNodeRatingClient creates an instance of httpClient which is a wrapper for http.Client
@@ -45,23 +58,81 @@ func NewNodeRatingClient(args ArgsNodeRating) (*nodeRating, error) {
 		return nil, err
 	}
 
+	argsNodesFetcher := clients.ArgsNodesFetcher{
+		Client: args.Client,
+		ApiUrl: args.Config.ApiUrl,
+	}
+	fetcher, err := clients.NewNodesFetcher(argsNodesFetcher)
+	if err != nil {
+		return nil, err
+	}
+
+	providedValues := args.LastValues
+	if providedValues == nil {
+		providedValues = loadLastValues(args.Storer)
+	}
+
+	firstRun := true
 	lastValues := make(map[string]float64)
 	for _, pubKey := range args.Config.PubKeys {
-		lastValues[pubKey] = defaultLastValue
+		lastValue, ok := providedValues[pubKey]
+		if !ok {
+			lastValues[pubKey] = defaultLastValue
+			continue
+		}
+
+		lastValues[pubKey] = lastValue
+		firstRun = false
+	}
+
+	thresholdLevels := make(map[string]common.EventLevel)
+	for _, pubKey := range args.Config.PubKeys {
+		level, ok := args.ThresholdLevels[pubKey]
+		if ok {
+			thresholdLevels[pubKey] = level
+		}
+	}
+
+	nodesLabels := make(map[string]config.NodeLabels)
+	for _, nodeLabels := range args.Config.Nodes {
+		nodesLabels[nodeLabels.PubKey] = nodeLabels
 	}
 
 	return &nodeRating{
-		httpClient: args.Client,
-		lastValues: lastValues,
-		firstRun:   true,
-		config:     args.Config,
+		nodesFetcher:    fetcher,
+		metricsHandler:  args.MetricsHandler,
+		storer:          args.Storer,
+		lastValues:      lastValues,
+		thresholdLevels: thresholdLevels,
+		nodesLabels:     nodesLabels,
+		firstRun:        firstRun,
+		config:          args.Config,
 	}, nil
 }
 
+func loadLastValues(storer clients.Storer) map[string]float64 {
+	lastValues := make(map[string]float64)
+	err := storer.Get(clientID, &lastValues)
+	if err != nil {
+		log.Debug("no stored last values will be used", "reason", err.Error())
+		return nil
+	}
+
+	log.Info("loaded stored last values", "num keys", len(lastValues))
+
+	return lastValues
+}
+
 func checkArgs(args ArgsNodeRating) error {
 	if check.IfNil(args.Client) {
 		return ErrNilHTTPClient
 	}
+	if check.IfNil(args.MetricsHandler) {
+		return ErrNilMetricsHandler
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
 	if len(args.Config.PubKeys) == 0 {
 		return ErrEmptyPubKeys
 	}
@@ -71,19 +142,33 @@ func checkArgs(args ArgsNodeRating) error {
 	if args.Config.Threshold <= 0 {
 		return fmt.Errorf("%w: invalid node rating threshold, provided %.2f", common.ErrInvalidValue, args.Config.Threshold)
 	}
+	if args.Config.WarningTempRating < 0 || args.Config.CriticalTempRating < 0 {
+		return fmt.Errorf("%w: invalid node absolute temp rating thresholds, provided warning %.2f, critical %.2f",
+			common.ErrInvalidValue, args.Config.WarningTempRating, args.Config.CriticalTempRating)
+	}
 
 	return nil
 }
 
+// GetEvent will fetch the nodes and will return the event triggered by the rating changes, if any
 func (hcw *nodeRating) GetEvent() (data.NotificationMessage, error) {
+	var event data.NotificationMessage
+	var err error
 	if hcw.firstRun == true {
-		log.Info("First run. Will not trigger any event.")
+		log.Info("First run. Will not trigger any rating decrease event.")
 		hcw.firstRun = false
 
-		return hcw.handleFirstRun()
+		event, err = hcw.handleFirstRun()
+	} else {
+		event, err = hcw.handleEvents()
+	}
+	if err != nil {
+		return event, err
 	}
 
-	return hcw.handleEvents()
+	hcw.saveLastValues()
+
+	return event, nil
 }
 
 func (hcw *nodeRating) handleEvents() (data.NotificationMessage, error) {
@@ -94,6 +179,9 @@ func (hcw *nodeRating) handleEvents() (data.NotificationMessage, error) {
 		return event, err
 	}
 
+	hcw.mutLastValues.Lock()
+	defer hcw.mutLastValues.Unlock()
+
 	msg := ""
 	for _, node := range nodes {
 		if hcw.lastValues[node.Bls] != defaultLastValue {
@@ -108,13 +196,19 @@ func (hcw *nodeRating) handleEvents() (data.NotificationMessage, error) {
 			if changePercentage > hcw.config.Threshold {
 				event.Level = common.CriticalEvent
 				nodeMsg := fmt.Sprintf(
-					"NodeName: %s - TempRating decreased with %.1f percent, current value: %.2f, last value: %.2f\n",
+					"NodeName: %s - TempRating decreased with %.1f percent, current value: %.2f, last value: %.2f",
 					node.Name,
 					hcw.config.Threshold,
 					node.TempRating,
 					hcw.lastValues[node.Bls],
 				)
-				msg = msg + nodeMsg
+				msg = msg + nodeMsg + "\n"
+
+				alert := hcw.newAlert(node, conditionRatingDecrease, common.CriticalEvent, nodeMsg, true)
+				alert.PreviousValue = hcw.lastValues[node.Bls]
+				alert.Threshold = hcw.config.Threshold
+				alert.OneShot = true
+				event.Alerts = append(event.Alerts, alert)
 			}
 		}
 
@@ -122,6 +216,7 @@ func (hcw *nodeRating) handleEvents() (data.NotificationMessage, error) {
 	}
 
 	event.Message = msg
+	hcw.checkAbsoluteThresholds(nodes, &event)
 
 	return event, nil
 }
@@ -132,38 +227,157 @@ func (hcw *nodeRating) handleFirstRun() (data.NotificationMessage, error) {
 		return data.NotificationMessage{}, err
 	}
 
+	hcw.mutLastValues.Lock()
+	defer hcw.mutLastValues.Unlock()
+
 	for _, node := range nodes {
 		hcw.lastValues[node.Bls] = node.TempRating
 	}
 
-	return data.NotificationMessage{Level: common.NoEvent}, nil
+	event := data.NotificationMessage{Level: common.NoEvent}
+	hcw.checkAbsoluteThresholds(nodes, &event)
+
+	return event, nil
 }
 
-func (hcw *nodeRating) fetchAPINodesByBLSKey() ([]clients.APINode, error) {
-	nodes := make([]clients.APINode, 0)
+// checkAbsoluteThresholds will add to the event the nodes whose temp rating went below the configured
+// thresholds; a node is notified again only if its threshold level changes, but its alert is kept while
+// the temp rating stays below the thresholds
+func (hcw *nodeRating) checkAbsoluteThresholds(nodes []clients.APINode, event *data.NotificationMessage) {
+	for _, node := range nodes {
+		level, threshold := hcw.thresholdLevel(node.TempRating)
+		lastLevel := hcw.thresholdLevels[node.Bls]
+		hcw.thresholdLevels[node.Bls] = level
+		changed := level != lastLevel
+
+		if level == common.NoEvent {
+			if changed {
+				log.Info("node temp rating recovered above thresholds", "node", node.Name, "temp rating", node.TempRating)
+			}
+			continue
+		}
 
-	for _, pubKey := range hcw.config.PubKeys {
-		path := fmt.Sprintf(nodesBLSKeyPath, pubKey)
-		responseBodyBytes, err := hcw.httpClient.CallGetRestEndPoint(hcw.config.ApiUrl, path)
-		if err != nil {
-			return nil, err
+		nodeMsg := fmt.Sprintf(
+			"NodeName: %s - TempRating is below the %s threshold %.2f, current value: %.2f",
+			node.Name,
+			level.String(),
+			threshold,
+			node.TempRating,
+		)
+		alert := hcw.newAlert(node, conditionTempRatingThreshold, level, nodeMsg, changed)
+		alert.Threshold = threshold
+		event.Alerts = append(event.Alerts, alert)
+		if !changed {
+			continue
 		}
 
-		var response clients.APINode
-		err = json.Unmarshal(responseBodyBytes, &response)
-		if err != nil {
-			return nil, err
+		if level > event.Level {
+			event.Level = level
 		}
+		event.Message += nodeMsg + "\n"
+	}
+}
+
+func (hcw *nodeRating) newAlert(node clients.APINode, condition string, level common.EventLevel, msg string, changed bool) data.Alert {
+	nodeLabels := hcw.nodesLabels[node.Bls]
+
+	return data.Alert{
+		ID:           data.NewAlertID(clientID, node.Bls, condition),
+		ClientID:     clientID,
+		Condition:    condition,
+		Level:        level,
+		Message:      msg,
+		Changed:      changed,
+		PubKey:       node.Bls,
+		NodeName:     node.Name,
+		Alias:        nodeLabels.Alias,
+		Identity:     node.Identity,
+		Provider:     node.Provider,
+		Shard:        node.Shard,
+		Tags:         nodeLabels.Tags,
+		CurrentValue: node.TempRating,
+	}
+}
+
+func (hcw *nodeRating) thresholdLevel(tempRating float64) (common.EventLevel, float64) {
+	if tempRating < hcw.config.CriticalTempRating {
+		return common.CriticalEvent, hcw.config.CriticalTempRating
+	}
+	if tempRating < hcw.config.WarningTempRating {
+		return common.WarningEvent, hcw.config.WarningTempRating
+	}
+
+	return common.NoEvent, 0
+}
+
+func (hcw *nodeRating) saveLastValues() {
+	err := hcw.storer.Put(clientID, hcw.GetLastValues())
+	if err != nil {
+		log.Warn("failed to save last values", "error", err.Error())
+	}
+}
 
-		nodes = append(nodes, response)
+func (hcw *nodeRating) fetchAPINodesByBLSKey() ([]clients.APINode, error) {
+	nodes, err := hcw.nodesFetcher.FetchNodes(hcw.config.PubKeys)
+	if err != nil {
+		return nil, err
 	}
 
+	for _, node := range nodes {
+		hcw.metricsHandler.UpdateNodeMetrics(node)
+	}
+
+	hcw.mutLastValues.Lock()
+	hcw.lastNodes = nodes
+	hcw.mutLastValues.Unlock()
+
 	return nodes, nil
 }
 
+// GetNodes returns a copy of the nodes fetched on the last check
+func (hcw *nodeRating) GetNodes() []clients.APINode {
+	hcw.mutLastValues.RLock()
+	defer hcw.mutLastValues.RUnlock()
+
+	nodes := make([]clients.APINode, len(hcw.lastNodes))
+	copy(nodes, hcw.lastNodes)
+
+	return nodes
+}
+
+// GetLastValues returns a copy of the temp rating values used as baseline, mapped by public key
+func (hcw *nodeRating) GetLastValues() map[string]float64 {
+	hcw.mutLastValues.RLock()
+	defer hcw.mutLastValues.RUnlock()
+
+	lastValues := make(map[string]float64)
+	for pubKey, lastValue := range hcw.lastValues {
+		if lastValue == defaultLastValue {
+			continue
+		}
+
+		lastValues[pubKey] = lastValue
+	}
+
+	return lastValues
+}
+
+// GetThresholdLevels returns a copy of the absolute threshold levels already notified, mapped by public key
+func (hcw *nodeRating) GetThresholdLevels() map[string]common.EventLevel {
+	hcw.mutLastValues.RLock()
+	defer hcw.mutLastValues.RUnlock()
+
+	thresholdLevels := make(map[string]common.EventLevel, len(hcw.thresholdLevels))
+	for pubKey, level := range hcw.thresholdLevels {
+		thresholdLevels[pubKey] = level
+	}
+
+	return thresholdLevels
+}
+
 // GetID will return using id for client
 func (hcw *nodeRating) GetID() string {
-	return "NodeRating"
+	return clientID
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -3,6 +3,7 @@ package noderating_test
 import (
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-node-monitoring/clients"
@@ -17,7 +18,9 @@ import (
 
 func createDefaultMockArgs() noderating.ArgsNodeRating {
 	return noderating.ArgsNodeRating{
-		Client: &mocks.HTTPClientStub{},
+		Client:         &mocks.HTTPClientStub{},
+		MetricsHandler: &mocks.NodesMetricsHandlerStub{},
+		Storer:         &mocks.StorerStub{},
 		Config: &config.NodeRating{
 			Threshold: 1.0,
 			ApiUrl:    "http://localhost:8080",
@@ -40,6 +43,28 @@ func TestNewNodeRatingClient(t *testing.T) {
 		assert.Equal(t, noderating.ErrNilHTTPClient, err)
 	})
 
+	t.Run("nil metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultMockArgs()
+		args.MetricsHandler = nil
+
+		nr, err := noderating.NewNodeRatingClient(args)
+		require.Nil(t, nr)
+		assert.Equal(t, noderating.ErrNilMetricsHandler, err)
+	})
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultMockArgs()
+		args.Storer = nil
+
+		nr, err := noderating.NewNodeRatingClient(args)
+		require.Nil(t, nr)
+		assert.Equal(t, noderating.ErrNilStorer, err)
+	})
+
 	t.Run("no public keys provided in config", func(t *testing.T) {
 		t.Parallel()
 
@@ -62,6 +87,17 @@ func TestNewNodeRatingClient(t *testing.T) {
 		assert.True(t, errors.Is(err, common.ErrInvalidValue))
 	})
 
+	t.Run("invalid absolute thresholds in config", func(t *testing.T) {
+		t.Parallel()
+
+		args := createDefaultMockArgs()
+		args.Config.CriticalTempRating = -1
+
+		nr, err := noderating.NewNodeRatingClient(args)
+		require.Nil(t, nr)
+		assert.True(t, errors.Is(err, common.ErrInvalidValue))
+	})
+
 	t.Run("empty api url in config", func(t *testing.T) {
 		t.Parallel()
 
@@ -94,11 +130,18 @@ func TestGetEvent(t *testing.T) {
 		testAPINodeBytes, _ := json.Marshal(testAPINode)
 
 		args.Client = &mocks.HTTPClientStub{
-			CallGetRestEndPointCalled: func(address, path string) ([]byte, error) {
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
 				return testAPINodeBytes, nil
 			},
 		}
 
+		updatedNodes := make([]clients.APINode, 0)
+		args.MetricsHandler = &mocks.NodesMetricsHandlerStub{
+			UpdateNodeMetricsCalled: func(node clients.APINode) {
+				updatedNodes = append(updatedNodes, node)
+			},
+		}
+
 		nr, err := noderating.NewNodeRatingClient(args)
 		require.Nil(t, err)
 
@@ -111,6 +154,7 @@ func TestGetEvent(t *testing.T) {
 
 		assert.Equal(t, expectedEvent, event)
 		assert.False(t, nr.GetFirstRun())
+		assert.Equal(t, []clients.APINode{*testAPINode}, updatedNodes)
 	})
 
 	t.Run("after first run", func(t *testing.T) {
@@ -118,6 +162,7 @@ func TestGetEvent(t *testing.T) {
 
 		args := createDefaultMockArgs()
 		args.Config.PubKeys = []string{"blskey"}
+		args.Config.Nodes = []config.NodeLabels{{PubKey: "blskey", Alias: "validator-1", Tags: []string{"prod"}}}
 
 		testAPINode := &clients.APINode{
 			Bls:        "blskey",
@@ -128,12 +173,14 @@ func TestGetEvent(t *testing.T) {
 		testAPINode2 := &clients.APINode{
 			Bls:        "blskey",
 			TempRating: 90,
+			Identity:   "identity",
+			Shard:      1,
 		}
 		testAPINodeBytes2, _ := json.Marshal(testAPINode2)
 
 		numCalls := 0
 		args.Client = &mocks.HTTPClientStub{
-			CallGetRestEndPointCalled: func(address, path string) ([]byte, error) {
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
 				if numCalls == 0 {
 					numCalls++
 					return testAPINodeBytes, nil
@@ -156,5 +203,181 @@ func TestGetEvent(t *testing.T) {
 		require.Nil(t, err)
 
 		assert.Equal(t, common.CriticalEvent, event.Level)
+		require.Len(t, event.Alerts, 1)
+		assert.Equal(t, "ratingDecrease", event.Alerts[0].Condition)
+		assert.Equal(t, "blskey", event.Alerts[0].PubKey)
+		assert.True(t, event.Alerts[0].Changed)
+		assert.True(t, event.Alerts[0].OneShot)
+		assert.Contains(t, event.Message, event.Alerts[0].Message)
+		assert.Equal(t, "validator-1", event.Alerts[0].Alias)
+		assert.Equal(t, []string{"prod"}, event.Alerts[0].Tags)
+		assert.Equal(t, "identity", event.Alerts[0].Identity)
+		assert.Equal(t, 1, event.Alerts[0].Shard)
+		assert.Equal(t, 90.0, event.Alerts[0].CurrentValue)
+		assert.Equal(t, 100.0, event.Alerts[0].PreviousValue)
 	})
 }
+
+func TestNodeRating_LastValues(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultMockArgs()
+	args.Config.PubKeys = []string{"blskey1", "blskey2"}
+	args.LastValues = map[string]float64{
+		"blskey1": 100,
+		"removed": 50,
+	}
+
+	args.Client = &mocks.HTTPClientStub{
+		CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+			testAPINode := &clients.APINode{
+				Bls:        request.URL[strings.LastIndex(request.URL, "/")+1:],
+				TempRating: 90,
+			}
+			return json.Marshal(testAPINode)
+		},
+	}
+
+	nr, err := noderating.NewNodeRatingClient(args)
+	require.Nil(t, err)
+
+	// baseline is kept for unchanged keys, so there is no first run anymore
+	assert.False(t, nr.GetFirstRun())
+	assert.Equal(t, map[string]float64{"blskey1": 100}, nr.GetLastValues())
+
+	event, err := nr.GetEvent()
+	require.Nil(t, err)
+	assert.Equal(t, common.CriticalEvent, event.Level)
+	assert.Equal(t, map[string]float64{"blskey1": 90, "blskey2": 90}, nr.GetLastValues())
+}
+
+func createAPINodeClientStub(tempRatings map[string]float64) *mocks.HTTPClientStub {
+	return &mocks.HTTPClientStub{
+		CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+			bls := request.URL[strings.LastIndex(request.URL, "/")+1:]
+			testAPINode := &clients.APINode{
+				Bls:        bls,
+				Name:       bls + "-name",
+				TempRating: tempRatings[bls],
+			}
+			return json.Marshal(testAPINode)
+		},
+	}
+}
+
+func TestNodeRating_StoredValues(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultMockArgs()
+	args.Config.PubKeys = []string{"blskey"}
+	args.Client = createAPINodeClientStub(map[string]float64{"blskey": 90})
+
+	savedValues := make(map[string]float64)
+	args.Storer = &mocks.StorerStub{
+		GetCalled: func(key string, value interface{}) error {
+			assert.Equal(t, "NodeRating", key)
+			values := value.(*map[string]float64)
+			*values = map[string]float64{"blskey": 100}
+			return nil
+		},
+		PutCalled: func(key string, value interface{}) error {
+			assert.Equal(t, "NodeRating", key)
+			savedValues = value.(map[string]float64)
+			return nil
+		},
+	}
+
+	nr, err := noderating.NewNodeRatingClient(args)
+	require.Nil(t, err)
+	assert.False(t, nr.GetFirstRun())
+
+	event, err := nr.GetEvent()
+	require.Nil(t, err)
+	assert.Equal(t, common.CriticalEvent, event.Level)
+	assert.Equal(t, map[string]float64{"blskey": 90}, savedValues)
+}
+
+func TestNodeRating_AbsoluteThresholds(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultMockArgs()
+	args.Config.PubKeys = []string{"blskey1", "blskey2", "blskey3"}
+	args.Config.WarningTempRating = 90
+	args.Config.CriticalTempRating = 80
+	tempRatings := map[string]float64{
+		"blskey1": 100,
+		"blskey2": 85,
+		"blskey3": 75,
+	}
+	args.Client = createAPINodeClientStub(tempRatings)
+
+	nr, err := noderating.NewNodeRatingClient(args)
+	require.Nil(t, err)
+
+	// thresholds are evaluated even without a previous value
+	event, err := nr.GetEvent()
+	require.Nil(t, err)
+	assert.Equal(t, common.CriticalEvent, event.Level)
+	assert.NotContains(t, event.Message, "blskey1-name")
+	assert.Contains(t, event.Message, "blskey2-name - TempRating is below the warning threshold 90.00")
+	assert.Contains(t, event.Message, "blskey3-name - TempRating is below the critical threshold 80.00")
+	require.Len(t, event.Alerts, 2)
+	assert.Equal(t, "blskey2", event.Alerts[0].PubKey)
+	assert.Equal(t, common.WarningEvent, event.Alerts[0].Level)
+	assert.True(t, event.Alerts[0].Changed)
+	assert.Equal(t, data.NewAlertID("NodeRating", "blskey3", "tempRatingThreshold"), event.Alerts[1].ID)
+	assert.Equal(t, common.CriticalEvent, event.Alerts[1].Level)
+	assert.True(t, event.Alerts[1].Changed)
+
+	// same levels, nodes should not be reported again but their alerts are still firing
+	event, err = nr.GetEvent()
+	require.Nil(t, err)
+	assert.Equal(t, common.InfoEvent, event.Level)
+	assert.Empty(t, event.Message)
+	require.Len(t, event.Alerts, 2)
+	assert.False(t, event.Alerts[0].Changed)
+	assert.False(t, event.Alerts[1].Changed)
+
+	// blskey3 recovered to warning, blskey1 recovered, nothing critical
+	tempRatings["blskey3"] = 85
+	event, err = nr.GetEvent()
+	require.Nil(t, err)
+	assert.Equal(t, common.WarningEvent, event.Level)
+	assert.Contains(t, event.Message, "blskey3-name - TempRating is below the warning threshold 90.00")
+	assert.NotContains(t, event.Message, "blskey2-name")
+	require.Len(t, event.Alerts, 2)
+	assert.False(t, event.Alerts[0].Changed)
+	assert.Equal(t, common.WarningEvent, event.Alerts[1].Level)
+	assert.True(t, event.Alerts[1].Changed)
+
+	assert.Len(t, nr.GetNodes(), 3)
+}
+
+func TestNodeRating_ThresholdLevels(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultMockArgs()
+	args.Config.PubKeys = []string{"blskey1", "blskey2"}
+	args.Config.WarningTempRating = 90
+	args.Config.CriticalTempRating = 80
+	args.Client = createAPINodeClientStub(map[string]float64{"blskey1": 85, "blskey2": 75})
+
+	nr, err := noderating.NewNodeRatingClient(args)
+	require.Nil(t, err)
+	_, err = nr.GetEvent()
+	require.Nil(t, err)
+	thresholdLevels := nr.GetThresholdLevels()
+	assert.Equal(t, map[string]common.EventLevel{"blskey1": common.WarningEvent, "blskey2": common.CriticalEvent}, thresholdLevels)
+
+	// a client created with the previous levels does not notify the nodes again
+	args.LastValues = nr.GetLastValues()
+	args.ThresholdLevels = thresholdLevels
+	reloaded, err := noderating.NewNodeRatingClient(args)
+	require.Nil(t, err)
+	event, err := reloaded.GetEvent()
+	require.Nil(t, err)
+	assert.Empty(t, event.Message)
+	require.Len(t, event.Alerts, 2)
+	assert.False(t, event.Alerts[0].Changed)
+	assert.False(t, event.Alerts[1].Changed)
+}
@@ -0,0 +1,71 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+// TODO: handle a more generic path; we should be able to provide also node's api
+const nodesBLSKeyPath = "/nodes/%s"
+
+// ArgsNodesFetcher defines the arguments needed to create a new nodes fetcher
+type ArgsNodesFetcher struct {
+	Client HTTPClient
+	ApiUrl string
+}
+
+type nodesFetcher struct {
+	httpClient HTTPClient
+	apiUrl     string
+}
+
+// NewNodesFetcher creates an instance able to fetch the nodes info from api, by public key
+func NewNodesFetcher(args ArgsNodesFetcher) (*nodesFetcher, error) {
+	if check.IfNil(args.Client) {
+		return nil, ErrNilHTTPClient
+	}
+	if len(args.ApiUrl) == 0 {
+		return nil, ErrEmptyApiUrl
+	}
+
+	return &nodesFetcher{
+		httpClient: args.Client,
+		apiUrl:     args.ApiUrl,
+	}, nil
+}
+
+// FetchNodes will fetch from api the nodes with the provided public keys (BLS keys). A non successful response is
+// returned as an error, as its body would otherwise be read as a node without any values
+func (nf *nodesFetcher) FetchNodes(pubKeys []string) ([]APINode, error) {
+	nodes := make([]APINode, 0, len(pubKeys))
+
+	for _, pubKey := range pubKeys {
+		path := fmt.Sprintf(nodesBLSKeyPath, pubKey)
+		responseBodyBytes, err := nf.httpClient.CallRestEndPoint(data.HTTPRequest{
+			Method: http.MethodGet,
+			URL:    nf.apiUrl + path,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var response APINode
+		err = json.Unmarshal(responseBodyBytes, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, response)
+	}
+
+	return nodes, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nf *nodesFetcher) IsInterfaceNil() bool {
+	return nf == nil
+}
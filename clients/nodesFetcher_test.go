@@ -0,0 +1,103 @@
+package clients_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodesFetcher(t *testing.T) {
+	t.Parallel()
+
+	nf, err := clients.NewNodesFetcher(clients.ArgsNodesFetcher{ApiUrl: "http://localhost"})
+	require.Nil(t, nf)
+	assert.Equal(t, clients.ErrNilHTTPClient, err)
+
+	nf, err = clients.NewNodesFetcher(clients.ArgsNodesFetcher{Client: &mocks.HTTPClientStub{}})
+	require.Nil(t, nf)
+	assert.Equal(t, clients.ErrEmptyApiUrl, err)
+
+	nf, err = clients.NewNodesFetcher(clients.ArgsNodesFetcher{Client: &mocks.HTTPClientStub{}, ApiUrl: "http://localhost"})
+	require.Nil(t, err)
+	assert.False(t, nf.IsInterfaceNil())
+}
+
+func TestNodesFetcher_FetchNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should fetch every key", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make([]string, 0)
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				assert.Equal(t, http.MethodGet, request.Method)
+				path := strings.TrimPrefix(request.URL, "http://localhost")
+				requestedPaths = append(requestedPaths, path)
+				return json.Marshal(clients.APINode{Bls: path[len("/nodes/"):], TempRating: 100})
+			},
+		}
+		nf, _ := clients.NewNodesFetcher(clients.ArgsNodesFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		nodes, err := nf.FetchNodes([]string{"key1", "key2"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"/nodes/key1", "/nodes/key2"}, requestedPaths)
+		assert.Equal(t, []clients.APINode{{Bls: "key1", TempRating: 100}, {Bls: "key2", TempRating: 100}}, nodes)
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		nf, _ := clients.NewNodesFetcher(clients.ArgsNodesFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		nodes, err := nf.FetchNodes([]string{"key1"})
+		require.Nil(t, nodes)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("non successful status", func(t *testing.T) {
+		t.Parallel()
+
+		statusErr := &common.HTTPStatusError{StatusCode: http.StatusNotFound, Body: []byte(`{"message":"Not Found"}`)}
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, statusErr
+			},
+		}
+		nf, _ := clients.NewNodesFetcher(clients.ArgsNodesFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		nodes, err := nf.FetchNodes([]string{"key1"})
+		require.Nil(t, nodes)
+		assert.Equal(t, statusErr, err)
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return []byte("not json"), nil
+			},
+		}
+		nf, _ := clients.NewNodesFetcher(clients.ArgsNodesFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		nodes, err := nf.FetchNodes([]string{"key1"})
+		require.Nil(t, nodes)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,62 @@
+package clients
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+const statsPath = "/stats"
+
+// ArgsStatsFetcher defines the arguments needed to create a new stats fetcher
+type ArgsStatsFetcher struct {
+	Client HTTPClient
+	ApiUrl string
+}
+
+type statsFetcher struct {
+	httpClient HTTPClient
+	apiUrl     string
+}
+
+// NewStatsFetcher creates an instance able to fetch the network stats, like the current epoch, from api
+func NewStatsFetcher(args ArgsStatsFetcher) (*statsFetcher, error) {
+	if check.IfNil(args.Client) {
+		return nil, ErrNilHTTPClient
+	}
+	if len(args.ApiUrl) == 0 {
+		return nil, ErrEmptyApiUrl
+	}
+
+	return &statsFetcher{
+		httpClient: args.Client,
+		apiUrl:     args.ApiUrl,
+	}, nil
+}
+
+// FetchStats will fetch the network stats from api. A non successful response is returned as an error, as its
+// body would otherwise be read as empty stats
+func (sf *statsFetcher) FetchStats() (APIStats, error) {
+	responseBodyBytes, err := sf.httpClient.CallRestEndPoint(data.HTTPRequest{
+		Method: http.MethodGet,
+		URL:    sf.apiUrl + statsPath,
+	})
+	if err != nil {
+		return APIStats{}, err
+	}
+
+	var response APIStats
+	err = json.Unmarshal(responseBodyBytes, &response)
+	if err != nil {
+		return APIStats{}, err
+	}
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sf *statsFetcher) IsInterfaceNil() bool {
+	return sf == nil
+}
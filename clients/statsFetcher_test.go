@@ -0,0 +1,95 @@
+package clients_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStatsFetcher(t *testing.T) {
+	t.Parallel()
+
+	sf, err := clients.NewStatsFetcher(clients.ArgsStatsFetcher{ApiUrl: "http://localhost"})
+	require.Nil(t, sf)
+	assert.Equal(t, clients.ErrNilHTTPClient, err)
+
+	sf, err = clients.NewStatsFetcher(clients.ArgsStatsFetcher{Client: &mocks.HTTPClientStub{}})
+	require.Nil(t, sf)
+	assert.Equal(t, clients.ErrEmptyApiUrl, err)
+
+	sf, err = clients.NewStatsFetcher(clients.ArgsStatsFetcher{Client: &mocks.HTTPClientStub{}, ApiUrl: "http://localhost"})
+	require.Nil(t, err)
+	assert.False(t, sf.IsInterfaceNil())
+}
+
+func TestStatsFetcher_FetchStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				assert.Equal(t, http.MethodGet, request.Method)
+				assert.Equal(t, "http://localhost/stats", request.URL)
+				return []byte(`{"shards":3,"blocks":100,"epoch":1234,"roundsPassed":600,"roundsPerEpoch":14400}`), nil
+			},
+		}
+		sf, _ := clients.NewStatsFetcher(clients.ArgsStatsFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		stats, err := sf.FetchStats()
+		require.Nil(t, err)
+		assert.Equal(t, clients.APIStats{Epoch: 1234, RoundsPassed: 600, RoundsPerEpoch: 14400}, stats)
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		sf, _ := clients.NewStatsFetcher(clients.ArgsStatsFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		_, err := sf.FetchStats()
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("non successful status", func(t *testing.T) {
+		t.Parallel()
+
+		statusErr := &common.HTTPStatusError{StatusCode: http.StatusInternalServerError}
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, statusErr
+			},
+		}
+		sf, _ := clients.NewStatsFetcher(clients.ArgsStatsFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		_, err := sf.FetchStats()
+		assert.Equal(t, statusErr, err)
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		t.Parallel()
+
+		client := &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return []byte("not json"), nil
+			},
+		}
+		sf, _ := clients.NewStatsFetcher(clients.ArgsStatsFetcher{Client: client, ApiUrl: "http://localhost"})
+
+		_, err := sf.FetchStats()
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/monitoring"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/urfave/cli"
+)
+
+// checkStatus defines the nagios plugin return codes
+type checkStatus int
+
+const (
+	checkOK checkStatus = iota
+	checkWarning
+	checkCritical
+	checkUnknown
+)
+
+var checkStatusNames = map[checkStatus]string{
+	checkOK:       "OK",
+	checkWarning:  "WARNING",
+	checkCritical: "CRITICAL",
+	checkUnknown:  "UNKNOWN",
+}
+
+// checkStatusSeverity defines the order used to compute the overall status: a critical client
+// outweighs a client which could not be checked, which outweighs a warning
+var checkStatusSeverity = map[checkStatus]int{
+	checkOK:       0,
+	checkWarning:  1,
+	checkUnknown:  2,
+	checkCritical: 3,
+}
+
+var (
+	stateFile = cli.StringFlag{
+		Name:  "state-file",
+		Usage: "The file where the previous values are kept between checks. Defaults to General.StateFilePath from config, with a .check suffix added to its name, so that the checks do not share the state of a running monitoring",
+	}
+
+	checkCommand = cli.Command{
+		Name:   "check",
+		Usage:  "Runs every configured client once, prints a summary and exits with nagios style codes (0 OK, 1 WARNING, 2 CRITICAL, 3 UNKNOWN)",
+		Flags:  []cli.Flag{generalConfigFile, stateFile},
+		Action: runCheck,
+	}
+)
+
+type checkResult struct {
+	clientID string
+	status   checkStatus
+	details  string
+}
+
+func runCheck(ctx *cli.Context) error {
+	// the output is parsed by the monitoring system, so the logs should not be mixed in
+	_ = logger.SetLogLevel("*:" + logger.LogNone.String())
+
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return exitWithUnknown(err)
+	}
+
+	cfg, err := config.LoadConfig(flagsConfig.GeneralConfigPath)
+	if err != nil {
+		return exitWithUnknown(err)
+	}
+	err = config.CheckConfig(cfg)
+	if err != nil {
+		return exitWithUnknown(err)
+	}
+
+	stateFilePath := checkStateFilePath(cfg.General.StateFilePath)
+	if ctx.IsSet(stateFile.Name) {
+		stateFilePath = ctx.String(stateFile.Name)
+	}
+
+	connectors, err := monitoring.CreateClients(cfg.Alarms, stateFilePath)
+	if err != nil {
+		return exitWithUnknown(err)
+	}
+
+	results := make([]checkResult, 0, len(connectors))
+	for _, connector := range connectors {
+		results = append(results, checkConnector(connector))
+	}
+
+	overallStatus := checkOK
+	summaries := make([]string, 0, len(results))
+	for _, result := range results {
+		if checkStatusSeverity[result.status] > checkStatusSeverity[overallStatus] {
+			overallStatus = result.status
+		}
+		summaries = append(summaries, fmt.Sprintf("%s %s", result.clientID, checkStatusNames[result.status]))
+	}
+
+	fmt.Printf("NODE MONITORING %s - %s\n", checkStatusNames[overallStatus], strings.Join(summaries, ", "))
+	for _, result := range results {
+		if result.details == "" {
+			continue
+		}
+
+		fmt.Printf("[%s] %s\n", result.clientID, strings.TrimSpace(result.details))
+	}
+
+	if overallStatus == checkOK {
+		return nil
+	}
+
+	return cli.NewExitError("", int(overallStatus))
+}
+
+func checkConnector(connector process.Connector) checkResult {
+	event, err := connector.GetEvent()
+	if err != nil {
+		return checkResult{
+			clientID: connector.GetID(),
+			status:   checkUnknown,
+			details:  err.Error(),
+		}
+	}
+
+	status := checkOK
+	switch event.Level {
+	case common.CriticalEvent:
+		status = checkCritical
+	case common.WarningEvent:
+		status = checkWarning
+	}
+
+	return checkResult{
+		clientID: connector.GetID(),
+		status:   status,
+		details:  event.Message,
+	}
+}
+
+// checkStateFilePath returns the state file of the checks, next to the monitoring one: state.json becomes
+// state.check.json
+func checkStateFilePath(monitoringStateFilePath string) string {
+	if monitoringStateFilePath == "" {
+		return ""
+	}
+
+	extension := filepath.Ext(monitoringStateFilePath)
+
+	return strings.TrimSuffix(monitoringStateFilePath, extension) + ".check" + extension
+}
+
+// exitWithUnknown prints the status line on stdout, where the monitoring system reads it, and exits with the
+// unknown code
+func exitWithUnknown(err error) error {
+	fmt.Printf("NODE MONITORING %s - %s\n", checkStatusNames[checkUnknown], err.Error())
+
+	return cli.NewExitError("", int(checkUnknown))
+}
@@ -13,11 +13,14 @@ var (
 	cliHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} [command [command options]]{{end}}
    {{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -32,6 +35,11 @@ VERSION:
 		Usage: "The path for the general config",
 		Value: "./config/config.toml",
 	}
+
+	dryRun = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "If set, the notifications are written to stdout exactly as each notifier would send them, instead of being sent",
+	}
 )
 
 func main() {
@@ -40,6 +48,7 @@ func main() {
 	app.Name = "MultiversX Node Monitoring"
 	app.Flags = []cli.Flag{
 		generalConfigFile,
+		dryRun,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -47,6 +56,12 @@ func main() {
 			Email: "contact@multiversx.com",
 		},
 	}
+	app.Commands = []cli.Command{
+		validateConfigCommand,
+		testNotifiersCommand,
+		checkCommand,
+		statusCommand,
+	}
 	app.Action = startNodeMonitoring
 
 	err := app.Run(os.Args)
@@ -70,6 +85,11 @@ func startNodeMonitoring(ctx *cli.Context) error {
 	}
 	cfg.Flags = flagsConfig
 
+	err = config.CheckConfig(cfg)
+	if err != nil {
+		return err
+	}
+
 	runner, err := monitoring.NewMonitoringRunner(cfg)
 	if err != nil {
 		return err
@@ -87,6 +107,10 @@ func getFlagsConfig(ctx *cli.Context) (*config.FlagsConfig, error) {
 	flagsConfig := &config.FlagsConfig{}
 
 	flagsConfig.GeneralConfigPath = ctx.GlobalString(generalConfigFile.Name)
+	if ctx.IsSet(generalConfigFile.Name) {
+		flagsConfig.GeneralConfigPath = ctx.String(generalConfigFile.Name)
+	}
+	flagsConfig.DryRun = ctx.GlobalBool(dryRun.Name)
 
 	return flagsConfig, nil
 }
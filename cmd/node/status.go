@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+	"github.com/urfave/cli"
+)
+
+const (
+	statusReqTimeoutSec = 10
+	clearScreen         = "\033[H\033[2J"
+)
+
+var (
+	sortBy = cli.StringFlag{
+		Name:  "sort",
+		Usage: fmt.Sprintf("The field used to sort the nodes, prefixed with - for descending order. Available: %s", strings.Join(status.SortFields(), ", ")),
+		Value: "node",
+	}
+	filterIdentity = cli.StringFlag{
+		Name:  "identity",
+		Usage: "If set, only the nodes with the provided identity are displayed",
+	}
+	filterTag = cli.StringFlag{
+		Name:  "tag",
+		Usage: "If set, only the nodes with the provided tag (from Alarms.NodeRating.Nodes config) are displayed",
+	}
+	watchIntervalSec = cli.IntFlag{
+		Name:  "watch",
+		Usage: "If set, the status is refreshed every provided number of seconds",
+	}
+	jsonOutput = cli.BoolFlag{
+		Name:  "json",
+		Usage: "If set, the status is printed as json",
+	}
+
+	statusCommand = cli.Command{
+		Name:   "status",
+		Usage:  "Prints the current state of the monitored nodes",
+		Flags:  []cli.Flag{generalConfigFile, sortBy, filterIdentity, filterTag, watchIntervalSec, jsonOutput},
+		Action: printStatus,
+	}
+)
+
+type nodesFetcher interface {
+	FetchNodes(pubKeys []string) ([]clients.APINode, error)
+}
+
+type statusPrinter struct {
+	nodesFetcher nodesFetcher
+	config       *config.NodeRating
+	sortBy       string
+	identity     string
+	tag          string
+	json         bool
+}
+
+func printStatus(ctx *cli.Context) error {
+	// the output might be consumed by scripts, so the logs should not be mixed in
+	_ = logger.SetLogLevel("*:" + logger.LogError.String())
+
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig(flagsConfig.GeneralConfigPath)
+	if err != nil {
+		return err
+	}
+	if cfg.Alarms == nil || cfg.Alarms.NodeRating == nil {
+		return fmt.Errorf("%w: missing Alarms.NodeRating section", config.ErrInvalidConfig)
+	}
+
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{
+		ReqTimeoutSec: statusReqTimeoutSec,
+	})
+	if err != nil {
+		return err
+	}
+	fetcher, err := clients.NewNodesFetcher(clients.ArgsNodesFetcher{
+		Client: httpClient,
+		ApiUrl: cfg.Alarms.NodeRating.ApiUrl,
+	})
+	if err != nil {
+		return err
+	}
+
+	// fail fast on an unknown sort field, before fetching anything
+	err = status.Sort(nil, ctx.String(sortBy.Name))
+	if err != nil {
+		return err
+	}
+
+	printer := &statusPrinter{
+		nodesFetcher: fetcher,
+		config:       cfg.Alarms.NodeRating,
+		sortBy:       ctx.String(sortBy.Name),
+		identity:     ctx.String(filterIdentity.Name),
+		tag:          ctx.String(filterTag.Name),
+		json:         ctx.Bool(jsonOutput.Name),
+	}
+
+	watchInterval := ctx.Int(watchIntervalSec.Name)
+	if watchInterval <= 0 {
+		return printer.print()
+	}
+
+	timer := time.NewTicker(time.Duration(watchInterval) * time.Second)
+	defer timer.Stop()
+
+	for {
+		if !printer.json {
+			fmt.Print(clearScreen)
+			fmt.Printf("Every %ds, last refresh: %s\n\n", watchInterval, time.Now().Format(time.RFC1123))
+		}
+
+		err = printer.print()
+		if err != nil {
+			fmt.Printf("failed to fetch the nodes: %s\n", err.Error())
+		}
+
+		<-timer.C
+	}
+}
+
+func (sp *statusPrinter) print() error {
+	nodes, err := sp.nodesFetcher.FetchNodes(sp.config.PubKeys)
+	if err != nil {
+		return err
+	}
+
+	statuses := status.NewNodesStatus(nodes, sp.config.Nodes)
+	statuses = status.Filter(statuses, sp.identity, sp.tag)
+	err = status.Sort(statuses, sp.sortBy)
+	if err != nil {
+		return err
+	}
+
+	if sp.json {
+		return status.WriteJSON(os.Stdout, statuses)
+	}
+
+	return status.WriteTable(os.Stdout, statuses)
+}
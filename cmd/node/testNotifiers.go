@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/monitoring"
+	"github.com/urfave/cli"
+)
+
+var (
+	testLevel = cli.StringFlag{
+		Name:  "level",
+		Usage: "The level of the test notification (info, warning or critical)",
+		Value: common.CriticalEvent.String(),
+	}
+	testNotifier = cli.StringFlag{
+		Name:  "notifier",
+		Usage: "The name of the notifier to be tested. If not provided, all the enabled notifiers will be tested",
+	}
+
+	testNotifiersCommand = cli.Command{
+		Name:   "test-notifiers",
+		Usage:  "Sends a test notification through every enabled notifier and reports the result of each one",
+		Flags:  []cli.Flag{generalConfigFile, testLevel, testNotifier},
+		Action: testNotifiers,
+	}
+)
+
+func testNotifiers(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	level, err := common.EventLevelFromString(ctx.String(testLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig(flagsConfig.GeneralConfigPath)
+	if err != nil {
+		return err
+	}
+	cfg.Flags = flagsConfig
+
+	// the same validation as the one applied by the monitoring, so a config which fails to start is not tested
+	err = config.CheckConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	notifiers, err := monitoring.CreateNotifiers(cfg.Notifiers, flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(notifiers))
+	for name := range notifiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	selectedNotifier := ctx.String(testNotifier.Name)
+	if selectedNotifier != "" {
+		names = filterNotifierNames(names, selectedNotifier)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no enabled notifier to be tested, selected: %q", selectedNotifier)
+	}
+
+	msg := data.NotificationMessage{
+		Message: fmt.Sprintf("This is a test notification sent by MultiversX Node Monitoring at %s", time.Now().Format(time.RFC1123)),
+		Level:   level,
+	}
+
+	numFailed := 0
+	for _, name := range names {
+		err = notifiers[name].PushMessage(msg)
+		if err != nil {
+			numFailed++
+			fmt.Printf("%s: FAILED: %s\n", name, err.Error())
+			continue
+		}
+
+		fmt.Printf("%s: OK\n", name)
+	}
+
+	if numFailed > 0 {
+		return cli.NewExitError(fmt.Sprintf("%d of %d notifiers failed", numFailed, len(names)), 1)
+	}
+
+	return nil
+}
+
+func filterNotifierNames(names []string, selected string) []string {
+	for _, name := range names {
+		if strings.EqualFold(name, selected) {
+			return []string{name}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/urfave/cli"
+)
+
+var validateConfigCommand = cli.Command{
+	Name:   "validate-config",
+	Usage:  "Validates every section of the config file and prints all the problems found",
+	Flags:  []cli.Flag{generalConfigFile},
+	Action: validateConfig,
+}
+
+func validateConfig(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadConfig(flagsConfig.GeneralConfigPath)
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("failed to load config %s: %s", flagsConfig.GeneralConfigPath, err.Error()), 1)
+	}
+
+	problems := config.ValidateConfig(cfg)
+	if len(problems) == 0 {
+		fmt.Printf("config %s is valid\n", flagsConfig.GeneralConfigPath)
+		return nil
+	}
+
+	fmt.Printf("config %s has %d problem(s):\n", flagsConfig.GeneralConfigPath, len(problems))
+	for _, problem := range problems {
+		fmt.Printf("  - %s\n", problem.Error())
+	}
+
+	return cli.NewExitError("", 1)
+}
@@ -1,5 +1,10 @@
 package common
 
+import (
+	"fmt"
+	"strings"
+)
+
 // EventLevel defines event level type
 type EventLevel int
 
@@ -8,6 +13,36 @@ const (
 	NoEvent EventLevel = iota
 	// InfoEvent defines a general info event type
 	InfoEvent
+	// WarningEvent defines a warning event type
+	WarningEvent
 	// CriticalEvent defines a critical event type
 	CriticalEvent
 )
+
+var eventLevelNames = map[EventLevel]string{
+	NoEvent:       "none",
+	InfoEvent:     "info",
+	WarningEvent:  "warning",
+	CriticalEvent: "critical",
+}
+
+// String returns the name of the event level
+func (el EventLevel) String() string {
+	name, ok := eventLevelNames[el]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", int(el))
+	}
+
+	return name
+}
+
+// EventLevelFromString returns the event level with the provided name
+func EventLevelFromString(name string) (EventLevel, error) {
+	for level, levelName := range eventLevelNames {
+		if strings.EqualFold(levelName, name) {
+			return level, nil
+		}
+	}
+
+	return NoEvent, fmt.Errorf("%w: unknown event level %s", ErrInvalidValue, name)
+}
@@ -0,0 +1,29 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventLevelFromString(t *testing.T) {
+	t.Parallel()
+
+	for _, level := range []common.EventLevel{common.NoEvent, common.InfoEvent, common.WarningEvent, common.CriticalEvent} {
+		parsedLevel, err := common.EventLevelFromString(level.String())
+		require.Nil(t, err)
+		assert.Equal(t, level, parsedLevel)
+	}
+
+	parsedLevel, err := common.EventLevelFromString("CRITICAL")
+	require.Nil(t, err)
+	assert.Equal(t, common.CriticalEvent, parsedLevel)
+
+	_, err = common.EventLevelFromString("unknown")
+	assert.True(t, errors.Is(err, common.ErrInvalidValue))
+
+	assert.Equal(t, "unknown(10)", common.EventLevel(10).String())
+}
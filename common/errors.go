@@ -1,6 +1,20 @@
 package common
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrInvalidValue signals that an invalid value have been provided
 var ErrInvalidValue = errors.New("invalid value")
+
+// HTTPStatusError signals that a http request returned a non successful status code
+type HTTPStatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+// Error returns the error message, containing the status code and the response body
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("http request failed with status code %d: %s", e.StatusCode, string(e.Body))
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseWeekday returns the weekday with the provided english name, like monday
+func ParseWeekday(name string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, nil
+		}
+	}
+
+	return time.Sunday, fmt.Errorf("%w: unknown weekday %q", ErrInvalidValue, name)
+}
+
+// ParseTimeOfDay returns the hour and the minute of a time of day provided as HH:MM
+func ParseTimeOfDay(value string) (int, int, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: time of day should be HH:MM, provided %q", ErrInvalidValue, value)
+	}
+
+	return parsed.Hour(), parsed.Minute(), nil
+}
@@ -0,0 +1,40 @@
+package common_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWeekday(t *testing.T) {
+	t.Parallel()
+
+	day, err := common.ParseWeekday("Monday")
+	require.Nil(t, err)
+	assert.Equal(t, time.Monday, day)
+
+	day, err = common.ParseWeekday("sunday")
+	require.Nil(t, err)
+	assert.Equal(t, time.Sunday, day)
+
+	_, err = common.ParseWeekday("mon")
+	assert.True(t, errors.Is(err, common.ErrInvalidValue))
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	hour, minute, err := common.ParseTimeOfDay("09:30")
+	require.Nil(t, err)
+	assert.Equal(t, 9, hour)
+	assert.Equal(t, 30, minute)
+
+	for _, value := range []string{"", "9", "24:00", "12:60", "noon"} {
+		_, _, err = common.ParseTimeOfDay(value)
+		assert.True(t, errors.Is(err, common.ErrInvalidValue), value)
+	}
+}
@@ -2,21 +2,57 @@ package config
 
 // GeneralConfig will hold the configs
 type GeneralConfig struct {
-	Flags     *FlagsConfig
-	General   *General
-	Notifiers *Notifiers
-	Alarms    *Alarms
+	Flags       *FlagsConfig
+	General     *General
+	Notifiers   *Notifiers
+	Throttling  *Throttling
+	Alarms      *Alarms
+	Hysteresis  *Hysteresis
+	Inhibition  *Inhibition
+	Escalation  *Escalation
+	Correlation *Correlation
+	Reports     *Reports
+	Metrics     *Metrics
 }
 
 // General holds the general configuration
 type General struct {
 	TriggerIntervalSec int
+	StateFilePath      string
 }
 
 // Notifiers holds the configuration for notifiers
 type Notifiers struct {
-	Email *Email
-	Slack *Slack
+	Email      *Email
+	Slack      *Slack
+	Telegram   *Telegram
+	Discord    *Discord
+	Teams      *Teams
+	Mattermost *Mattermost
+	PagerDuty  *PagerDuty
+	Opsgenie   *Opsgenie
+	Webhook    *Webhook
+	Ntfy       *Ntfy
+	Gotify     *Gotify
+	Pushover   *Pushover
+	Console    *Console
+}
+
+// Throttling holds the configuration for the grouping and the rate limiting of the notifications
+type Throttling struct {
+	Enabled bool
+	// GroupingWindowSec is the time the notifications are gathered for, before being sent as a single message
+	GroupingWindowSec int
+	Default           RateLimit
+	// Notifiers overrides the default rate limit for some notifiers, by name
+	Notifiers map[string]RateLimit
+}
+
+// RateLimit holds the configuration of a token bucket: Burst messages can be sent at once, after which
+// the messages are sent at the MessagesPerMinute rate
+type RateLimit struct {
+	MessagesPerMinute float64
+	Burst             int
 }
 
 // Alarms holds the configuration for the alarms defined
@@ -24,11 +60,131 @@ type Alarms struct {
 	NodeRating *NodeRating
 }
 
+// Reports holds the configuration for the scheduled reports
+type Reports struct {
+	Digest *Digest
+	Epoch  *EpochReport
+}
+
+// Digest holds the configuration for the daily or weekly summary of the monitored nodes
+type Digest struct {
+	Enabled bool
+	// Frequency is one of daily or weekly
+	Frequency string
+	// Weekday is the day the weekly digest is sent on, like monday
+	Weekday string
+	// Time is the local time the digest is sent at, as HH:MM
+	Time      string
+	Timezone  string
+	Notifiers []string
+}
+
+// EpochReport holds the configuration for the performance report sent at every epoch change
+type EpochReport struct {
+	Enabled          bool
+	CheckIntervalSec int
+	// MinSuccessRatio is the validator and leader success ratio (0 - 1) below which a node is flagged
+	MinSuccessRatio float64
+	Notifiers       []string
+}
+
+// Hysteresis holds the confirmation of the alerts state changes and the flapping detection
+type Hysteresis struct {
+	Enabled bool
+	Default HysteresisRule
+	// Conditions overrides the default rule for some alert conditions, by condition name
+	Conditions map[string]HysteresisRule
+	// An alert which changes its state FlappingMaxChanges times within FlappingWindowSec is marked as flapping.
+	// The flapping detection is disabled if FlappingMaxChanges is 0
+	FlappingWindowSec  int
+	FlappingMaxChanges int
+}
+
+// HysteresisRule requires a condition to hold in FireN of the last FireM evaluations before its alert fires
+// and to be clear in ResolveN of the last ResolveM evaluations before its alert resolves
+type HysteresisRule struct {
+	FireN    int
+	FireM    int
+	ResolveN int
+	ResolveM int
+}
+
+// Inhibition holds the rules which suppress the alerts depending on other firing alerts
+type Inhibition struct {
+	Enabled bool
+	Rules   []InhibitionRule
+}
+
+// InhibitionRule suppresses the alerts matching the target selector while an alert matching the source selector is firing
+type InhibitionRule struct {
+	Name   string
+	Source AlertSelector
+	Target AlertSelector
+	// Equal lists the labels which should have the same value for the source and the target alerts
+	Equal []string
+}
+
+// AlertSelector matches the alerts by their fields. Every provided criterion should match, an empty list matches any value
+type AlertSelector struct {
+	ClientIDs  []string
+	Conditions []string
+	// Levels lists the matched alert levels: info, warning or critical
+	Levels []string
+	// Labels holds the values of the matched labels: identity, shard, provider or tag
+	Labels map[string]string
+}
+
+// Escalation holds the policies which notify the unacknowledged alerts to wider audiences over time
+type Escalation struct {
+	Enabled  bool
+	Policies []EscalationPolicy
+}
+
+// EscalationPolicy routes the alerts matched by its selector through its steps, instead of notifying them
+// through all the notifiers. The first matching policy is applied
+type EscalationPolicy struct {
+	Name  string
+	Match AlertSelector
+	Steps []EscalationStep
+}
+
+// EscalationStep notifies the alert through the listed notifiers once DelaySec passed since the alert was first
+// notified, unless it has been acknowledged or resolved meanwhile
+type EscalationStep struct {
+	DelaySec  int
+	Notifiers []string
+}
+
+// Correlation holds the rules which group the firing alerts into incidents
+type Correlation struct {
+	Enabled bool
+	Rules   []CorrelationRule
+}
+
+// CorrelationRule groups the alerts with the same values of the listed labels. The first matching rule is applied
+type CorrelationRule struct {
+	Name string
+	// By lists the labels the alerts are grouped by: identity, shard, provider or tag
+	By []string
+	// MinMembers is the number of firing alerts from which the group is notified as a single incident
+	MinMembers int
+}
+
 // NodeRating holds the configuration for node rating alarm
 type NodeRating struct {
-	Threshold float64
-	ApiUrl    string
-	PubKeys   []string
+	Threshold          float64
+	WarningTempRating  float64
+	CriticalTempRating float64
+	ApiUrl             string
+	PubKeys            []string
+	Nodes              []NodeLabels
+}
+
+// NodeLabels holds the optional labels of a monitored public key
+type NodeLabels struct {
+	PubKey string
+	Alias  string
+	Tags   []string
 }
 
 // Email holds the configuration for email notifier
@@ -40,15 +196,135 @@ type Email struct {
 	EmailPassword string
 	From          string
 	To            []string
+	// TLSMode is one of implicit, starttls or none; empty selects implicit on port 465 and starttls otherwise
+	TLSMode               string
+	TLSInsecureSkipVerify bool
+	ExplorerUrl           string
 }
 
 // Slack holds the configuration for slack notifier
 type Slack struct {
+	Enabled       bool
+	URL           string
+	BotToken      string
+	ApiUrl        string
+	Channel       string
+	LevelChannels map[string]string
+	ExplorerUrl   string
+
+	SigningSecret             string
+	InteractionsListenAddress string
+}
+
+// Telegram holds the configuration for telegram notifier
+type Telegram struct {
+	Enabled         bool
+	ApiUrl          string
+	BotToken        string
+	ChatIDs         []string
+	CommandsEnabled bool
+	AllowedChatIDs  []string
+}
+
+// Discord holds the configuration for discord notifier
+type Discord struct {
+	Enabled     bool
+	URL         string
+	ExplorerUrl string
+}
+
+// Teams holds the configuration for microsoft teams notifier
+type Teams struct {
+	Enabled     bool
+	URL         string
+	ExplorerUrl string
+}
+
+// Mattermost holds the configuration for mattermost notifier
+type Mattermost struct {
+	Enabled     bool
+	URL         string
+	Channel     string
+	Username    string
+	ExplorerUrl string
+}
+
+// PagerDuty holds the configuration for pagerduty notifier
+type PagerDuty struct {
+	Enabled     bool
+	ApiUrl      string
+	RoutingKey  string
+	ExplorerUrl string
+}
+
+// Opsgenie holds the configuration for opsgenie notifier
+type Opsgenie struct {
+	Enabled     bool
+	ApiUrl      string
+	ApiKey      string
+	Responders  []OpsgenieResponder
+	ExplorerUrl string
+}
+
+// OpsgenieResponder holds a team, user, schedule or escalation to be notified of the opsgenie alerts
+type OpsgenieResponder struct {
+	Type string
+	Name string
+}
+
+// Webhook holds the configuration for the generic webhook notifier
+type Webhook struct {
+	Enabled         bool
+	URL             string
+	Method          string
+	Headers         map[string]string
+	BodyTemplate    string
+	Secret          string
+	SignatureHeader string
+	TimestampHeader string
+}
+
+// Ntfy holds the configuration for ntfy notifier
+type Ntfy struct {
+	Enabled     bool
+	URL         string
+	AccessToken string
+	ExplorerUrl string
+}
+
+// Gotify holds the configuration for gotify notifier
+type Gotify struct {
+	Enabled     bool
+	URL         string
+	AppToken    string
+	ExplorerUrl string
+}
+
+// Pushover holds the configuration for pushover notifier
+type Pushover struct {
+	Enabled            bool
+	ApiUrl             string
+	AppToken           string
+	UserKey            string
+	Device             string
+	EmergencyRetrySec  int
+	EmergencyExpireSec int
+	ExplorerUrl        string
+}
+
+// Console holds the configuration for console notifier
+type Console struct {
 	Enabled bool
-	URL     string
+}
+
+// Metrics holds the configuration for the prometheus metrics exporter
+type Metrics struct {
+	Enabled       bool
+	ListenAddress string
 }
 
 // FlagsConfig holds the values for CLI flags
 type FlagsConfig struct {
 	GeneralConfigPath string
+	DryRun            bool
 }
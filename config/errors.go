@@ -0,0 +1,6 @@
+package config
+
+import "errors"
+
+// ErrInvalidConfig signals that the provided config did not pass validation
+var ErrInvalidConfig = errors.New("invalid config")
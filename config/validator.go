@@ -0,0 +1,865 @@
+package config
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+const (
+	blsKeyHexLength = 192
+	minTriggerSec   = 1
+	maxThreshold    = 100
+	maxTempRating   = 100
+	minPort         = 1
+	maxPort         = 65535
+)
+
+type configValidator struct {
+	problems []error
+}
+
+// ValidateConfig checks the semantic of every config section and returns all the problems found
+func ValidateConfig(cfg *GeneralConfig) []error {
+	cv := &configValidator{
+		problems: make([]error, 0),
+	}
+	if cfg == nil {
+		cv.addProblem("config", "missing config")
+		return cv.problems
+	}
+
+	cv.validateGeneral(cfg.General)
+	cv.validateAlarms(cfg.Alarms)
+	cv.validateNotifiers(cfg.Notifiers)
+	cv.validateThrottling(cfg.Throttling, cfg.Notifiers)
+	cv.validateHysteresis(cfg.Hysteresis)
+	cv.validateInhibition(cfg.Inhibition)
+	cv.validateEscalation(cfg.Escalation, cfg.Notifiers)
+	cv.validateCorrelation(cfg.Correlation)
+	cv.validateReports(cfg.Reports, cfg.Notifiers)
+	cv.validateMetrics(cfg.Metrics)
+
+	return cv.problems
+}
+
+// CheckConfig validates the provided config and returns a single error holding all the problems found
+func CheckConfig(cfg *GeneralConfig) error {
+	problems := ValidateConfig(cfg)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		messages = append(messages, problem.Error())
+	}
+
+	return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(messages, "; "))
+}
+
+func (cv *configValidator) addProblem(field string, format string, args ...interface{}) {
+	cv.problems = append(cv.problems, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+}
+
+func (cv *configValidator) validateGeneral(cfg *General) {
+	if cfg == nil {
+		cv.addProblem("General", "missing section")
+		return
+	}
+
+	if cfg.TriggerIntervalSec < minTriggerSec {
+		cv.addProblem("General.TriggerIntervalSec", "should be at least %d, provided %d", minTriggerSec, cfg.TriggerIntervalSec)
+	}
+}
+
+func (cv *configValidator) validateAlarms(cfg *Alarms) {
+	if cfg == nil {
+		cv.addProblem("Alarms", "missing section")
+		return
+	}
+
+	cv.validateNodeRating(cfg.NodeRating)
+}
+
+func (cv *configValidator) validateNodeRating(cfg *NodeRating) {
+	if cfg == nil {
+		cv.addProblem("Alarms.NodeRating", "missing section")
+		return
+	}
+
+	if cfg.Threshold <= 0 || cfg.Threshold > maxThreshold {
+		cv.addProblem("Alarms.NodeRating.Threshold", "should be in (0, %d] percent interval, provided %.2f", maxThreshold, cfg.Threshold)
+	}
+	cv.validateTempRating("Alarms.NodeRating.WarningTempRating", cfg.WarningTempRating)
+	cv.validateTempRating("Alarms.NodeRating.CriticalTempRating", cfg.CriticalTempRating)
+	if cfg.WarningTempRating > 0 && cfg.CriticalTempRating > cfg.WarningTempRating {
+		cv.addProblem("Alarms.NodeRating.CriticalTempRating", "should not be greater than WarningTempRating, provided %.2f > %.2f", cfg.CriticalTempRating, cfg.WarningTempRating)
+	}
+	cv.validateURL("Alarms.NodeRating.ApiUrl", cfg.ApiUrl)
+
+	if len(cfg.PubKeys) == 0 {
+		cv.addProblem("Alarms.NodeRating.PubKeys", "no public keys provided")
+	}
+	seenKeys := make(map[string]int)
+	for idx, pubKey := range cfg.PubKeys {
+		field := fmt.Sprintf("Alarms.NodeRating.PubKeys[%d]", idx)
+		cv.validateBLSKey(field, pubKey)
+
+		firstIdx, found := seenKeys[pubKey]
+		if found {
+			cv.addProblem(field, "duplicate of PubKeys[%d]", firstIdx)
+			continue
+		}
+		seenKeys[pubKey] = idx
+	}
+
+	labeledKeys := make(map[string]int)
+	for idx, nodeLabels := range cfg.Nodes {
+		field := fmt.Sprintf("Alarms.NodeRating.Nodes[%d].PubKey", idx)
+		_, monitored := seenKeys[nodeLabels.PubKey]
+		if !monitored {
+			cv.addProblem(field, "key is not listed in PubKeys")
+		}
+
+		firstIdx, found := labeledKeys[nodeLabels.PubKey]
+		if found {
+			cv.addProblem(field, "duplicate of Nodes[%d]", firstIdx)
+			continue
+		}
+		labeledKeys[nodeLabels.PubKey] = idx
+	}
+}
+
+func (cv *configValidator) validateNotifiers(cfg *Notifiers) {
+	if cfg == nil {
+		cv.addProblem("Notifiers", "missing section")
+		return
+	}
+
+	cv.validateSlack(cfg.Slack)
+	cv.validateTelegram(cfg.Telegram)
+	cv.validateDiscord(cfg.Discord)
+	cv.validateTeams(cfg.Teams)
+	cv.validateMattermost(cfg.Mattermost)
+	cv.validatePagerDuty(cfg.PagerDuty)
+	cv.validateOpsgenie(cfg.Opsgenie)
+	cv.validateWebhook(cfg.Webhook)
+	cv.validateNtfy(cfg.Ntfy)
+	cv.validateGotify(cfg.Gotify)
+	cv.validatePushover(cfg.Pushover)
+	cv.validateEmail(cfg.Email)
+}
+
+// disabled notifiers are not required to be complete, but the urls, the ports and the addresses provided should still
+// be valid. The other settings are checked only for the enabled notifiers
+
+func (cv *configValidator) validateSlack(cfg *Slack) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.BotToken == "" {
+		if cfg.Enabled || cfg.URL != "" {
+			cv.validateURL("Notifiers.Slack.URL", cfg.URL)
+		}
+	} else if cfg.Enabled {
+		cv.validateURL("Notifiers.Slack.ApiUrl", cfg.ApiUrl)
+		cv.validateRequired("Notifiers.Slack.Channel", cfg.Channel)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Slack.ExplorerUrl", cfg.ExplorerUrl)
+	}
+	if !cfg.Enabled {
+		return
+	}
+
+	if strings.ContainsAny(cfg.BotToken, " /") {
+		cv.addProblem("Notifiers.Slack.BotToken", "should not contain slashes or spaces")
+	}
+	for levelName := range cfg.LevelChannels {
+		_, err := common.EventLevelFromString(levelName)
+		if err != nil {
+			cv.addProblem(fmt.Sprintf("Notifiers.Slack.LevelChannels[%q]", levelName), "should be one of none, info, warning or critical")
+		}
+	}
+	if cfg.InteractionsListenAddress != "" {
+		cv.validateListenAddress("Notifiers.Slack.InteractionsListenAddress", cfg.InteractionsListenAddress)
+		cv.validateRequired("Notifiers.Slack.SigningSecret", cfg.SigningSecret)
+		if cfg.BotToken == "" {
+			cv.addProblem("Notifiers.Slack.InteractionsListenAddress", "the buttons are handled only for the messages posted with a bot token")
+		}
+	}
+}
+
+func (cv *configValidator) validateTelegram(cfg *Telegram) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled {
+		cv.validateRequired("Notifiers.Telegram.BotToken", cfg.BotToken)
+		if len(cfg.ChatIDs) == 0 {
+			cv.addProblem("Notifiers.Telegram.ChatIDs", "no chat ids provided")
+		}
+	}
+
+	if cfg.Enabled || cfg.ApiUrl != "" {
+		cv.validateURL("Notifiers.Telegram.ApiUrl", cfg.ApiUrl)
+	}
+	if !cfg.Enabled {
+		return
+	}
+
+	if strings.ContainsAny(cfg.BotToken, "/ ") {
+		cv.addProblem("Notifiers.Telegram.BotToken", "should not contain slashes or spaces")
+	}
+	seenChatIDs := make(map[string]int)
+	for idx, chatID := range cfg.ChatIDs {
+		field := fmt.Sprintf("Notifiers.Telegram.ChatIDs[%d]", idx)
+		if strings.TrimSpace(chatID) == "" {
+			cv.addProblem(field, "should not be empty")
+			continue
+		}
+
+		firstIdx, found := seenChatIDs[chatID]
+		if found {
+			cv.addProblem(field, "duplicate of ChatIDs[%d]", firstIdx)
+			continue
+		}
+		seenChatIDs[chatID] = idx
+	}
+
+	if cfg.CommandsEnabled && len(cfg.AllowedChatIDs) == 0 {
+		cv.addProblem("Notifiers.Telegram.AllowedChatIDs", "no chat ids allowed to send commands")
+	}
+	for idx, chatID := range cfg.AllowedChatIDs {
+		_, err := strconv.ParseInt(chatID, 10, 64)
+		if err != nil {
+			cv.addProblem(fmt.Sprintf("Notifiers.Telegram.AllowedChatIDs[%d]", idx), "should be a numeric chat id, provided %q", chatID)
+		}
+	}
+}
+
+func (cv *configValidator) validateDiscord(cfg *Discord) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled || cfg.URL != "" {
+		cv.validateURL("Notifiers.Discord.URL", cfg.URL)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Discord.ExplorerUrl", cfg.ExplorerUrl)
+	}
+}
+
+func (cv *configValidator) validateTeams(cfg *Teams) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled || cfg.URL != "" {
+		cv.validateURL("Notifiers.Teams.URL", cfg.URL)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Teams.ExplorerUrl", cfg.ExplorerUrl)
+	}
+}
+
+func (cv *configValidator) validateMattermost(cfg *Mattermost) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled || cfg.URL != "" {
+		cv.validateURL("Notifiers.Mattermost.URL", cfg.URL)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Mattermost.ExplorerUrl", cfg.ExplorerUrl)
+	}
+	if strings.ContainsAny(cfg.Channel, " #") {
+		cv.addProblem("Notifiers.Mattermost.Channel", "should be the channel name as in its url, provided %q", cfg.Channel)
+	}
+}
+
+func (cv *configValidator) validatePagerDuty(cfg *PagerDuty) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled {
+		cv.validateRequired("Notifiers.PagerDuty.RoutingKey", cfg.RoutingKey)
+	}
+	if cfg.Enabled || cfg.ApiUrl != "" {
+		cv.validateURL("Notifiers.PagerDuty.ApiUrl", cfg.ApiUrl)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.PagerDuty.ExplorerUrl", cfg.ExplorerUrl)
+	}
+}
+
+var opsgenieResponderTypes = map[string]struct{}{
+	"team":       {},
+	"user":       {},
+	"schedule":   {},
+	"escalation": {},
+}
+
+func (cv *configValidator) validateOpsgenie(cfg *Opsgenie) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled {
+		cv.validateRequired("Notifiers.Opsgenie.ApiKey", cfg.ApiKey)
+	}
+	if cfg.Enabled || cfg.ApiUrl != "" {
+		cv.validateURL("Notifiers.Opsgenie.ApiUrl", cfg.ApiUrl)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Opsgenie.ExplorerUrl", cfg.ExplorerUrl)
+	}
+	for idx, responder := range cfg.Responders {
+		field := fmt.Sprintf("Notifiers.Opsgenie.Responders[%d]", idx)
+		if _, ok := opsgenieResponderTypes[responder.Type]; !ok {
+			cv.addProblem(field+".Type", "should be one of team, user, schedule or escalation, provided %q", responder.Type)
+		}
+		cv.validateRequired(field+".Name", responder.Name)
+	}
+}
+
+var webhookMethods = map[string]struct{}{
+	"":      {},
+	"POST":  {},
+	"PUT":   {},
+	"PATCH": {},
+}
+
+func (cv *configValidator) validateWebhook(cfg *Webhook) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled || cfg.URL != "" {
+		cv.validateURL("Notifiers.Webhook.URL", cfg.URL)
+	}
+	if _, ok := webhookMethods[strings.ToUpper(cfg.Method)]; !ok {
+		cv.addProblem("Notifiers.Webhook.Method", "should be one of POST, PUT or PATCH, provided %q", cfg.Method)
+	}
+	for name := range cfg.Headers {
+		cv.validateHeaderName(fmt.Sprintf("Notifiers.Webhook.Headers[%q]", name), name)
+	}
+	if cfg.SignatureHeader != "" {
+		cv.validateHeaderName("Notifiers.Webhook.SignatureHeader", cfg.SignatureHeader)
+	}
+	if cfg.TimestampHeader != "" {
+		cv.validateHeaderName("Notifiers.Webhook.TimestampHeader", cfg.TimestampHeader)
+	}
+}
+
+func (cv *configValidator) validateHeaderName(field string, name string) {
+	if name == "" || strings.ContainsAny(name, " :\t\r\n") {
+		cv.addProblem(field, "should be a valid http header name, provided %q", name)
+	}
+}
+
+func (cv *configValidator) validateNtfy(cfg *Ntfy) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled || cfg.URL != "" {
+		cv.validateURL("Notifiers.Ntfy.URL", cfg.URL)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Ntfy.ExplorerUrl", cfg.ExplorerUrl)
+	}
+}
+
+func (cv *configValidator) validateGotify(cfg *Gotify) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled {
+		cv.validateRequired("Notifiers.Gotify.AppToken", cfg.AppToken)
+	}
+	if cfg.Enabled || cfg.URL != "" {
+		cv.validateURL("Notifiers.Gotify.URL", cfg.URL)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Gotify.ExplorerUrl", cfg.ExplorerUrl)
+	}
+}
+
+// pushover limits of the emergency notifications, see https://pushover.net/api#priority
+const (
+	minPushoverRetrySec  = 30
+	maxPushoverExpireSec = 10800
+)
+
+func (cv *configValidator) validatePushover(cfg *Pushover) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled {
+		cv.validateRequired("Notifiers.Pushover.AppToken", cfg.AppToken)
+		cv.validateRequired("Notifiers.Pushover.UserKey", cfg.UserKey)
+	}
+	if cfg.Enabled || cfg.ApiUrl != "" {
+		cv.validateURL("Notifiers.Pushover.ApiUrl", cfg.ApiUrl)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Pushover.ExplorerUrl", cfg.ExplorerUrl)
+	}
+	if cfg.EmergencyRetrySec != 0 && cfg.EmergencyRetrySec < minPushoverRetrySec {
+		cv.addProblem("Notifiers.Pushover.EmergencyRetrySec", "should be at least %d, provided %d", minPushoverRetrySec, cfg.EmergencyRetrySec)
+	}
+	if cfg.EmergencyExpireSec < 0 || cfg.EmergencyExpireSec > maxPushoverExpireSec {
+		cv.addProblem("Notifiers.Pushover.EmergencyExpireSec", "should be between 0 and %d, provided %d", maxPushoverExpireSec, cfg.EmergencyExpireSec)
+	}
+}
+
+func (cv *configValidator) validateEmail(cfg *Email) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled {
+		cv.validateRequired("Notifiers.Email.EmailHost", cfg.EmailHost)
+		cv.validateRequired("Notifiers.Email.From", cfg.From)
+		if len(cfg.To) == 0 {
+			cv.addProblem("Notifiers.Email.To", "no email addresses provided")
+		}
+	}
+
+	// the credentials are optional, for the relays which do not require authentication
+	if cfg.EmailUsername != "" && cfg.EmailPassword == "" {
+		cv.addProblem("Notifiers.Email.EmailPassword", "should be provided together with EmailUsername")
+	}
+	if cfg.EmailUsername == "" && cfg.EmailPassword != "" {
+		cv.addProblem("Notifiers.Email.EmailUsername", "should be provided together with EmailPassword")
+	}
+	switch cfg.TLSMode {
+	case "", "implicit", "starttls":
+	case "none":
+		if cfg.EmailUsername != "" {
+			cv.addProblem("Notifiers.Email.TLSMode", "the credentials are not sent over an unencrypted connection, use implicit or starttls")
+		}
+	default:
+		cv.addProblem("Notifiers.Email.TLSMode", "should be one of implicit, starttls or none, provided %q", cfg.TLSMode)
+	}
+	if cfg.ExplorerUrl != "" {
+		cv.validateURL("Notifiers.Email.ExplorerUrl", cfg.ExplorerUrl)
+	}
+
+	if cfg.Enabled || cfg.EmailPort != 0 {
+		cv.validatePort("Notifiers.Email.EmailPort", cfg.EmailPort)
+	}
+	if cfg.From != "" {
+		cv.validateEmailAddress("Notifiers.Email.From", cfg.From)
+	}
+	cv.validateEmailAddresses("Notifiers.Email.To", cfg.To)
+}
+
+func (cv *configValidator) validateHysteresis(cfg *Hysteresis) {
+	if cfg == nil || !cfg.Enabled {
+		return
+	}
+
+	cv.validateHysteresisRule("Hysteresis.Default", cfg.Default)
+	conditions := make([]string, 0, len(cfg.Conditions))
+	for condition := range cfg.Conditions {
+		conditions = append(conditions, condition)
+	}
+	sort.Strings(conditions)
+	for _, condition := range conditions {
+		cv.validateHysteresisRule("Hysteresis.Conditions."+condition, cfg.Conditions[condition])
+	}
+
+	if cfg.FlappingMaxChanges == 0 {
+		return
+	}
+	if cfg.FlappingMaxChanges < 2 {
+		cv.addProblem("Hysteresis.FlappingMaxChanges", "should be at least 2 or 0 to disable the flapping detection, provided %d", cfg.FlappingMaxChanges)
+	}
+	if cfg.FlappingWindowSec < 1 {
+		cv.addProblem("Hysteresis.FlappingWindowSec", "should be positive, provided %d", cfg.FlappingWindowSec)
+	}
+}
+
+func (cv *configValidator) validateHysteresisRule(field string, rule HysteresisRule) {
+	cv.validateEvaluations(field+".FireN", field+".FireM", rule.FireN, rule.FireM)
+	cv.validateEvaluations(field+".ResolveN", field+".ResolveM", rule.ResolveN, rule.ResolveM)
+}
+
+func (cv *configValidator) validateEvaluations(fieldN string, fieldM string, n int, m int) {
+	if n < 1 {
+		cv.addProblem(fieldN, "should be at least 1, provided %d", n)
+		n = 1
+	}
+	if m < n {
+		cv.addProblem(fieldM, "should be at least %d, provided %d", n, m)
+	}
+}
+
+func (cv *configValidator) validateInhibition(cfg *Inhibition) {
+	if cfg == nil {
+		return
+	}
+
+	names := make(map[string]struct{})
+	for i, rule := range cfg.Rules {
+		field := fmt.Sprintf("Inhibition.Rules[%d]", i)
+		cv.validateRequired(field+".Name", rule.Name)
+		if _, exists := names[rule.Name]; exists && rule.Name != "" {
+			cv.addProblem(field+".Name", "duplicated rule name %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		cv.validateAlertSelector(field+".Source", rule.Source)
+		cv.validateAlertSelector(field+".Target", rule.Target)
+		for j, label := range rule.Equal {
+			if !isGroupingLabel(label) {
+				cv.addProblem(fmt.Sprintf("%s.Equal[%d]", field, j), "unknown label %q, should be one of %s", label, strings.Join(data.GroupingLabels(), ", "))
+			}
+		}
+	}
+	if cfg.Enabled && len(cfg.Rules) == 0 {
+		cv.addProblem("Inhibition.Rules", "no rules provided")
+	}
+}
+
+func (cv *configValidator) validateAlertSelector(field string, selector AlertSelector) {
+	if len(selector.ClientIDs) == 0 && len(selector.Conditions) == 0 && len(selector.Levels) == 0 && len(selector.Labels) == 0 {
+		cv.addProblem(field, "empty selector, it would match every alert")
+	}
+	for i, level := range selector.Levels {
+		eventLevel, err := common.EventLevelFromString(level)
+		if err != nil || eventLevel == common.NoEvent {
+			cv.addProblem(fmt.Sprintf("%s.Levels[%d]", field, i), "unknown level %q, should be one of info, warning, critical", level)
+		}
+	}
+	labels := make([]string, 0, len(selector.Labels))
+	for label := range selector.Labels {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		if !isGroupingLabel(label) {
+			cv.addProblem(fmt.Sprintf("%s.Labels.%s", field, label), "unknown label %q, should be one of %s", label, strings.Join(data.GroupingLabels(), ", "))
+		}
+	}
+}
+
+func (cv *configValidator) validateEscalation(cfg *Escalation, notifiers *Notifiers) {
+	if cfg == nil || !cfg.Enabled {
+		return
+	}
+
+	names := make(map[string]struct{})
+	for i, policy := range cfg.Policies {
+		field := fmt.Sprintf("Escalation.Policies[%d]", i)
+		cv.validateRequired(field+".Name", policy.Name)
+		if _, exists := names[policy.Name]; exists && policy.Name != "" {
+			cv.addProblem(field+".Name", "duplicated policy name %q", policy.Name)
+		}
+		names[policy.Name] = struct{}{}
+
+		cv.validateAlertSelector(field+".Match", policy.Match)
+		if len(policy.Steps) == 0 {
+			cv.addProblem(field+".Steps", "no steps provided")
+		}
+		for j, step := range policy.Steps {
+			stepField := fmt.Sprintf("%s.Steps[%d]", field, j)
+			if step.DelaySec < 0 {
+				cv.addProblem(stepField+".DelaySec", "should not be negative, provided %d", step.DelaySec)
+			}
+			if j > 0 && step.DelaySec <= policy.Steps[j-1].DelaySec {
+				cv.addProblem(stepField+".DelaySec", "should be greater than the delay of the previous step, provided %d", step.DelaySec)
+			}
+			if len(step.Notifiers) == 0 {
+				cv.addProblem(stepField+".Notifiers", "no notifiers provided")
+			}
+			cv.validateNotifierNames(stepField+".Notifiers", step.Notifiers, notifiers)
+		}
+	}
+	if len(cfg.Policies) == 0 {
+		cv.addProblem("Escalation.Policies", "no policies provided")
+	}
+}
+
+func (cv *configValidator) validateCorrelation(cfg *Correlation) {
+	if cfg == nil {
+		return
+	}
+
+	names := make(map[string]struct{})
+	for i, rule := range cfg.Rules {
+		field := fmt.Sprintf("Correlation.Rules[%d]", i)
+		cv.validateRequired(field+".Name", rule.Name)
+		if _, exists := names[rule.Name]; exists && rule.Name != "" {
+			cv.addProblem(field+".Name", "duplicated rule name %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		if len(rule.By) == 0 {
+			cv.addProblem(field+".By", "no labels provided, should be some of %s", strings.Join(data.GroupingLabels(), ", "))
+		}
+		labels := make(map[string]struct{})
+		for j, label := range rule.By {
+			if !isGroupingLabel(label) {
+				cv.addProblem(fmt.Sprintf("%s.By[%d]", field, j), "unknown label %q, should be one of %s", label, strings.Join(data.GroupingLabels(), ", "))
+				continue
+			}
+			if _, exists := labels[label]; exists {
+				cv.addProblem(fmt.Sprintf("%s.By[%d]", field, j), "duplicated label %q", label)
+			}
+			labels[label] = struct{}{}
+		}
+
+		if rule.MinMembers < 2 {
+			cv.addProblem(field+".MinMembers", "should be at least 2, provided %d", rule.MinMembers)
+		}
+	}
+	if cfg.Enabled && len(cfg.Rules) == 0 {
+		cv.addProblem("Correlation.Rules", "no rules provided")
+	}
+}
+
+func isGroupingLabel(label string) bool {
+	for _, groupingLabel := range data.GroupingLabels() {
+		if label == groupingLabel {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cv *configValidator) validateReports(cfg *Reports, notifiers *Notifiers) {
+	if cfg == nil {
+		return
+	}
+
+	cv.validateDigest(cfg.Digest, notifiers)
+	cv.validateEpochReport(cfg.Epoch, notifiers)
+}
+
+func (cv *configValidator) validateDigest(digest *Digest, notifiers *Notifiers) {
+	if digest == nil || !digest.Enabled {
+		return
+	}
+
+	switch digest.Frequency {
+	case "daily":
+	case "weekly":
+		_, err := common.ParseWeekday(digest.Weekday)
+		if err != nil {
+			cv.addProblem("Reports.Digest.Weekday", err.Error())
+		}
+	default:
+		cv.addProblem("Reports.Digest.Frequency", "should be daily or weekly, provided %q", digest.Frequency)
+	}
+	_, _, err := common.ParseTimeOfDay(digest.Time)
+	if err != nil {
+		cv.addProblem("Reports.Digest.Time", err.Error())
+	}
+	_, err = time.LoadLocation(digest.Timezone)
+	if err != nil {
+		cv.addProblem("Reports.Digest.Timezone", "unknown timezone %q", digest.Timezone)
+	}
+
+	if len(digest.Notifiers) == 0 {
+		cv.addProblem("Reports.Digest.Notifiers", "no notifiers provided")
+	}
+	cv.validateNotifierNames("Reports.Digest.Notifiers", digest.Notifiers, notifiers)
+}
+
+func (cv *configValidator) validateEpochReport(cfg *EpochReport, notifiers *Notifiers) {
+	if cfg == nil || !cfg.Enabled {
+		return
+	}
+
+	if cfg.CheckIntervalSec < 1 {
+		cv.addProblem("Reports.Epoch.CheckIntervalSec", "should be at least 1, provided %d", cfg.CheckIntervalSec)
+	}
+	if cfg.MinSuccessRatio < 0 || cfg.MinSuccessRatio > 1 {
+		cv.addProblem("Reports.Epoch.MinSuccessRatio", "should be between 0 and 1, provided %v", cfg.MinSuccessRatio)
+	}
+
+	if len(cfg.Notifiers) == 0 {
+		cv.addProblem("Reports.Epoch.Notifiers", "no notifiers provided")
+	}
+	cv.validateNotifierNames("Reports.Epoch.Notifiers", cfg.Notifiers, notifiers)
+}
+
+// validateNotifierNames checks that every name is the name of an enabled notifier section, like Slack or Email
+func (cv *configValidator) validateNotifierNames(field string, names []string, notifiers *Notifiers) {
+	for i, name := range names {
+		section, ok := notifierSection(notifiers, name)
+		if !ok {
+			cv.addProblem(fmt.Sprintf("%s[%d]", field, i), "unknown notifier %q", name)
+			continue
+		}
+		if section.IsNil() || !section.Elem().FieldByName("Enabled").Bool() {
+			cv.addProblem(fmt.Sprintf("%s[%d]", field, i), "notifier %q is not enabled", name)
+		}
+	}
+}
+
+// notifierSection returns the config section of the notifier with the provided name, if there is such a notifier
+func notifierSection(notifiers *Notifiers, name string) (reflect.Value, bool) {
+	if notifiers == nil {
+		return reflect.Value{}, false
+	}
+
+	section := reflect.ValueOf(notifiers).Elem().FieldByName(name)
+
+	return section, section.IsValid() && section.Kind() == reflect.Ptr
+}
+
+func (cv *configValidator) validateThrottling(cfg *Throttling, notifiers *Notifiers) {
+	if cfg == nil || !cfg.Enabled {
+		return
+	}
+
+	if cfg.GroupingWindowSec < 0 {
+		cv.addProblem("Throttling.GroupingWindowSec", "should not be negative, provided %d", cfg.GroupingWindowSec)
+	}
+	cv.validateRateLimit("Throttling.Default", cfg.Default)
+
+	names := make([]string, 0, len(cfg.Notifiers))
+	for name := range cfg.Notifiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field := "Throttling.Notifiers." + name
+		_, ok := notifierSection(notifiers, name)
+		if !ok {
+			cv.addProblem(field, "unknown notifier %q", name)
+			continue
+		}
+		cv.validateRateLimit(field, cfg.Notifiers[name])
+	}
+}
+
+func (cv *configValidator) validateRateLimit(field string, cfg RateLimit) {
+	if cfg.MessagesPerMinute <= 0 {
+		cv.addProblem(field+".MessagesPerMinute", "should be positive, provided %v", cfg.MessagesPerMinute)
+	}
+	if cfg.Burst < 1 {
+		cv.addProblem(field+".Burst", "should be at least 1, provided %d", cfg.Burst)
+	}
+}
+
+func (cv *configValidator) validateMetrics(cfg *Metrics) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.Enabled || cfg.ListenAddress != "" {
+		cv.validateListenAddress("Metrics.ListenAddress", cfg.ListenAddress)
+	}
+}
+
+func (cv *configValidator) validateRequired(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		cv.addProblem(field, "should not be empty")
+	}
+}
+
+func (cv *configValidator) validateURL(field string, value string) {
+	if value == "" {
+		cv.addProblem(field, "should not be empty")
+		return
+	}
+
+	parsedURL, err := url.Parse(value)
+	if err != nil {
+		cv.addProblem(field, "invalid url: %s", err.Error())
+		return
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		cv.addProblem(field, "invalid url %q, scheme should be http or https", value)
+		return
+	}
+	if parsedURL.Host == "" {
+		cv.addProblem(field, "invalid url %q, missing host", value)
+	}
+}
+
+func (cv *configValidator) validateTempRating(field string, value float64) {
+	if value < 0 || value > maxTempRating {
+		cv.addProblem(field, "should be in [0, %d] interval, provided %.2f", maxTempRating, value)
+	}
+}
+
+func (cv *configValidator) validateBLSKey(field string, value string) {
+	if len(value) != blsKeyHexLength {
+		cv.addProblem(field, "invalid BLS key length, expected %d hex characters, provided %d", blsKeyHexLength, len(value))
+		return
+	}
+
+	_, err := hex.DecodeString(value)
+	if err != nil {
+		cv.addProblem(field, "invalid BLS key, not hex encoded")
+	}
+}
+
+func (cv *configValidator) validatePort(field string, port int) {
+	if port < minPort || port > maxPort {
+		cv.addProblem(field, "should be in [%d, %d] interval, provided %d", minPort, maxPort, port)
+	}
+}
+
+func (cv *configValidator) validateListenAddress(field string, value string) {
+	_, portStr, err := net.SplitHostPort(value)
+	if err != nil {
+		cv.addProblem(field, "invalid address %q: %s", value, err.Error())
+		return
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		cv.addProblem(field, "invalid port %q", portStr)
+		return
+	}
+	cv.validatePort(field, port)
+}
+
+func (cv *configValidator) validateEmailAddress(field string, value string) {
+	_, err := mail.ParseAddress(value)
+	if err != nil {
+		cv.addProblem(field, "invalid email address %q", value)
+	}
+}
+
+func (cv *configValidator) validateEmailAddresses(field string, values []string) {
+	seenAddresses := make(map[string]int)
+	for idx, value := range values {
+		itemField := fmt.Sprintf("%s[%d]", field, idx)
+		cv.validateEmailAddress(itemField, value)
+
+		address := strings.ToLower(value)
+		firstIdx, found := seenAddresses[address]
+		if found {
+			cv.addProblem(itemField, "duplicate of %s[%d]", field, firstIdx)
+			continue
+		}
+		seenAddresses[address] = idx
+	}
+}
@@ -0,0 +1,686 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBLSKey = strings.Repeat("ab", 96)
+
+func createValidConfig() *config.GeneralConfig {
+	return &config.GeneralConfig{
+		General: &config.General{
+			TriggerIntervalSec: 5,
+		},
+		Alarms: &config.Alarms{
+			NodeRating: &config.NodeRating{
+				Threshold: 1.0,
+				ApiUrl:    "https://api.multiversx.com",
+				PubKeys:   []string{testBLSKey},
+			},
+		},
+		Notifiers: &config.Notifiers{
+			Slack: &config.Slack{
+				Enabled: true,
+				URL:     "https://hooks.slack.com/services/YYY/XXX",
+			},
+			Telegram: &config.Telegram{
+				Enabled:  false,
+				ApiUrl:   "https://api.telegram.org",
+				BotToken: "123456:ABC-DEF",
+				ChatIDs:  []string{"-1001234567890", "@channel"},
+			},
+			Discord: &config.Discord{
+				Enabled:     false,
+				ExplorerUrl: "https://explorer.multiversx.com",
+			},
+			Email: &config.Email{
+				Enabled: false,
+			},
+		},
+		Metrics: &config.Metrics{
+			Enabled:       true,
+			ListenAddress: ":9090",
+		},
+	}
+}
+
+func requireProblems(t *testing.T, cfg *config.GeneralConfig, expectedFields ...string) {
+	problems := config.ValidateConfig(cfg)
+	require.Equal(t, len(expectedFields), len(problems), "problems: %v", problems)
+
+	for idx, field := range expectedFields {
+		assert.True(t, strings.HasPrefix(problems[idx].Error(), field+":"), "expected problem for %s, got %s", field, problems[idx].Error())
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid config", func(t *testing.T) {
+		t.Parallel()
+
+		requireProblems(t, createValidConfig())
+		require.Nil(t, config.CheckConfig(createValidConfig()))
+	})
+
+	t.Run("nil config", func(t *testing.T) {
+		t.Parallel()
+
+		requireProblems(t, nil, "config")
+	})
+
+	t.Run("missing sections", func(t *testing.T) {
+		t.Parallel()
+
+		requireProblems(t, &config.GeneralConfig{}, "General", "Alarms", "Notifiers")
+	})
+
+	t.Run("invalid node rating values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.General.TriggerIntervalSec = 0
+		cfg.Alarms.NodeRating.Threshold = 101
+		cfg.Alarms.NodeRating.WarningTempRating = 90
+		cfg.Alarms.NodeRating.CriticalTempRating = 95
+		cfg.Alarms.NodeRating.ApiUrl = "ftp://api.multiversx.com"
+		cfg.Alarms.NodeRating.PubKeys = []string{testBLSKey, "abc", strings.Repeat("zz", 96), testBLSKey}
+
+		cfg.Alarms.NodeRating.Nodes = []config.NodeLabels{
+			{PubKey: testBLSKey, Alias: "validator-01"},
+			{PubKey: "unknown"},
+			{PubKey: testBLSKey},
+		}
+
+		requireProblems(t, cfg,
+			"General.TriggerIntervalSec",
+			"Alarms.NodeRating.Threshold",
+			"Alarms.NodeRating.CriticalTempRating",
+			"Alarms.NodeRating.ApiUrl",
+			"Alarms.NodeRating.PubKeys[1]",
+			"Alarms.NodeRating.PubKeys[2]",
+			"Alarms.NodeRating.PubKeys[3]",
+			"Alarms.NodeRating.Nodes[1].PubKey",
+			"Alarms.NodeRating.Nodes[2].PubKey",
+		)
+	})
+
+	t.Run("enabled notifiers should be complete", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Slack.URL = ""
+		cfg.Notifiers.Email.Enabled = true
+
+		requireProblems(t, cfg,
+			"Notifiers.Slack.URL",
+			"Notifiers.Email.EmailHost",
+			"Notifiers.Email.From",
+			"Notifiers.Email.To",
+			"Notifiers.Email.EmailPort",
+		)
+	})
+
+	t.Run("disabled notifiers should have valid values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Slack.Enabled = false
+		cfg.Notifiers.Slack.URL = "hooks.slack.com"
+		cfg.Notifiers.Email.EmailPort = 70000
+		cfg.Notifiers.Email.From = "not an address"
+		cfg.Notifiers.Email.To = []string{"aaa@mail.com", "AAA@mail.com"}
+
+		requireProblems(t, cfg,
+			"Notifiers.Slack.URL",
+			"Notifiers.Email.EmailPort",
+			"Notifiers.Email.From",
+			"Notifiers.Email.To[1]",
+		)
+	})
+
+	t.Run("telegram notifier", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Telegram.Enabled = true
+		requireProblems(t, cfg)
+
+		cfg.Notifiers.Telegram.ApiUrl = ""
+		cfg.Notifiers.Telegram.BotToken = ""
+		cfg.Notifiers.Telegram.ChatIDs = nil
+		requireProblems(t, cfg,
+			"Notifiers.Telegram.BotToken",
+			"Notifiers.Telegram.ChatIDs",
+			"Notifiers.Telegram.ApiUrl",
+		)
+
+		// the settings of a disabled section are not checked
+		cfg.Notifiers.Telegram.Enabled = false
+		cfg.Notifiers.Telegram.BotToken = "123/456"
+		cfg.Notifiers.Telegram.ChatIDs = []string{"@channel", " ", "@channel"}
+		cfg.Notifiers.Telegram.AllowedChatIDs = []string{"-100", "@channel"}
+		requireProblems(t, cfg)
+
+		cfg.Notifiers.Telegram.Enabled = true
+		cfg.Notifiers.Telegram.ApiUrl = "https://api.telegram.org"
+		requireProblems(t, cfg,
+			"Notifiers.Telegram.BotToken",
+			"Notifiers.Telegram.ChatIDs[1]",
+			"Notifiers.Telegram.ChatIDs[2]",
+			"Notifiers.Telegram.AllowedChatIDs[1]",
+		)
+
+		cfg = createValidConfig()
+		cfg.Notifiers.Telegram.Enabled = true
+		cfg.Notifiers.Telegram.CommandsEnabled = true
+		requireProblems(t, cfg, "Notifiers.Telegram.AllowedChatIDs")
+	})
+
+	t.Run("discord notifier", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Discord.Enabled = true
+		cfg.Notifiers.Discord.ExplorerUrl = "explorer"
+		requireProblems(t, cfg,
+			"Notifiers.Discord.URL",
+			"Notifiers.Discord.ExplorerUrl",
+		)
+
+		cfg.Notifiers.Discord.URL = "https://discord.com/api/webhooks/123/abc"
+		cfg.Notifiers.Discord.ExplorerUrl = ""
+		requireProblems(t, cfg)
+	})
+
+	t.Run("teams and mattermost notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Teams = &config.Teams{
+			Enabled: true,
+		}
+		cfg.Notifiers.Mattermost = &config.Mattermost{
+			Enabled:     false,
+			URL:         "https://mattermost.example.com/hooks/xxx",
+			Channel:     "#town square",
+			ExplorerUrl: "explorer",
+		}
+		requireProblems(t, cfg,
+			"Notifiers.Teams.URL",
+			"Notifiers.Mattermost.ExplorerUrl",
+			"Notifiers.Mattermost.Channel",
+		)
+
+		cfg.Notifiers.Teams.URL = "https://example.webhook.office.com/webhookb2/xxx"
+		cfg.Notifiers.Mattermost.Channel = "town-square"
+		cfg.Notifiers.Mattermost.ExplorerUrl = ""
+		requireProblems(t, cfg)
+	})
+
+	t.Run("opsgenie notifier", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Opsgenie = &config.Opsgenie{
+			Enabled: true,
+			ApiUrl:  "https://api.opsgenie.com",
+			Responders: []config.OpsgenieResponder{
+				{Type: "team", Name: "ops"},
+				{Type: "group", Name: ""},
+			},
+		}
+		requireProblems(t, cfg,
+			"Notifiers.Opsgenie.ApiKey",
+			"Notifiers.Opsgenie.Responders[1].Type",
+			"Notifiers.Opsgenie.Responders[1].Name",
+		)
+
+		cfg.Notifiers.Opsgenie.ApiKey = "key"
+		cfg.Notifiers.Opsgenie.Responders[1] = config.OpsgenieResponder{Type: "user", Name: "user@example.com"}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("webhook notifier", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Webhook = &config.Webhook{
+			Enabled:         true,
+			Method:          "GET",
+			Headers:         map[string]string{"X Api Key": "key"},
+			SignatureHeader: "X-Signature:",
+		}
+		requireProblems(t, cfg,
+			"Notifiers.Webhook.URL",
+			"Notifiers.Webhook.Method",
+			`Notifiers.Webhook.Headers["X Api Key"]`,
+			"Notifiers.Webhook.SignatureHeader",
+		)
+
+		cfg.Notifiers.Webhook.URL = "https://incidents.example.com/hook"
+		cfg.Notifiers.Webhook.Method = "patch"
+		cfg.Notifiers.Webhook.Headers = map[string]string{"X-Api-Key": "key"}
+		cfg.Notifiers.Webhook.SignatureHeader = ""
+		requireProblems(t, cfg)
+	})
+
+	t.Run("mobile push notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Ntfy = &config.Ntfy{
+			Enabled: true,
+		}
+		cfg.Notifiers.Gotify = &config.Gotify{
+			Enabled: true,
+			URL:     "https://gotify.example.com",
+		}
+		cfg.Notifiers.Pushover = &config.Pushover{
+			Enabled:            true,
+			ApiUrl:             "https://api.pushover.net",
+			AppToken:           "token",
+			EmergencyRetrySec:  10,
+			EmergencyExpireSec: 86400,
+		}
+		requireProblems(t, cfg,
+			"Notifiers.Ntfy.URL",
+			"Notifiers.Gotify.AppToken",
+			"Notifiers.Pushover.UserKey",
+			"Notifiers.Pushover.EmergencyRetrySec",
+			"Notifiers.Pushover.EmergencyExpireSec",
+		)
+
+		cfg.Notifiers.Ntfy.URL = "https://ntfy.sh/validators"
+		cfg.Notifiers.Gotify.AppToken = "token"
+		cfg.Notifiers.Pushover.UserKey = "user"
+		cfg.Notifiers.Pushover.EmergencyRetrySec = 30
+		cfg.Notifiers.Pushover.EmergencyExpireSec = 0
+		requireProblems(t, cfg)
+	})
+
+	t.Run("slack notifier in bot token mode", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Slack = &config.Slack{
+			Enabled:       true,
+			BotToken:      "xoxb-token",
+			ApiUrl:        "https://slack.com/api",
+			LevelChannels: map[string]string{"urgent": "C-urgent"},
+		}
+		requireProblems(t, cfg,
+			"Notifiers.Slack.Channel",
+			`Notifiers.Slack.LevelChannels["urgent"]`,
+		)
+
+		cfg.Notifiers.Slack.Channel = "C-default"
+		cfg.Notifiers.Slack.LevelChannels = map[string]string{"critical": "C-critical"}
+		requireProblems(t, cfg)
+
+		// a disabled section is not required to be complete
+		cfg.Notifiers.Slack = &config.Slack{
+			BotToken:      "xoxb token",
+			LevelChannels: map[string]string{"urgent": "C-urgent"},
+		}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("slack interactions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Slack.InteractionsListenAddress = "localhost"
+		requireProblems(t, cfg,
+			"Notifiers.Slack.InteractionsListenAddress",
+			"Notifiers.Slack.SigningSecret",
+			"Notifiers.Slack.InteractionsListenAddress",
+		)
+
+		cfg.Notifiers.Slack = &config.Slack{
+			Enabled:                   true,
+			BotToken:                  "xoxb-token",
+			ApiUrl:                    "https://slack.com/api",
+			Channel:                   "C-default",
+			SigningSecret:             "signing-secret",
+			InteractionsListenAddress: ":8081",
+		}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("email notifier", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.Email = &config.Email{
+			Enabled:   true,
+			EmailHost: "relay.local",
+			EmailPort: 25,
+			From:      "monitoring@mail.com",
+			To:        []string{"ops@mail.com"},
+			TLSMode:   "none",
+		}
+		requireProblems(t, cfg)
+
+		cfg.Notifiers.Email.EmailUsername = "user@mail.com"
+		cfg.Notifiers.Email.ExplorerUrl = "explorer"
+		requireProblems(t, cfg,
+			"Notifiers.Email.EmailPassword",
+			"Notifiers.Email.TLSMode",
+			"Notifiers.Email.ExplorerUrl",
+		)
+
+		cfg.Notifiers.Email.EmailPassword = "password"
+		cfg.Notifiers.Email.TLSMode = "ssl"
+		cfg.Notifiers.Email.ExplorerUrl = ""
+		requireProblems(t, cfg, "Notifiers.Email.TLSMode")
+
+		cfg.Notifiers.Email.TLSMode = "starttls"
+		cfg.Notifiers.Email.EmailUsername = ""
+		requireProblems(t, cfg, "Notifiers.Email.EmailUsername")
+	})
+
+	t.Run("pagerduty notifier", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Notifiers.PagerDuty = &config.PagerDuty{
+			Enabled: true,
+			ApiUrl:  "events.pagerduty.com",
+		}
+		requireProblems(t, cfg,
+			"Notifiers.PagerDuty.RoutingKey",
+			"Notifiers.PagerDuty.ApiUrl",
+		)
+
+		cfg.Notifiers.PagerDuty.ApiUrl = "https://events.pagerduty.com"
+		cfg.Notifiers.PagerDuty.RoutingKey = "routing-key"
+		requireProblems(t, cfg)
+	})
+
+	t.Run("throttling", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Throttling = &config.Throttling{
+			Enabled:           true,
+			GroupingWindowSec: -1,
+			Notifiers: map[string]config.RateLimit{
+				"Unknown": {MessagesPerMinute: 10, Burst: 5},
+				"Email":   {MessagesPerMinute: 10},
+			},
+		}
+		requireProblems(t, cfg,
+			"Throttling.GroupingWindowSec",
+			"Throttling.Default.MessagesPerMinute",
+			"Throttling.Default.Burst",
+			"Throttling.Notifiers.Email.Burst",
+			"Throttling.Notifiers.Unknown",
+		)
+
+		cfg.Throttling.GroupingWindowSec = 10
+		cfg.Throttling.Default = config.RateLimit{MessagesPerMinute: 20, Burst: 10}
+		cfg.Throttling.Notifiers = map[string]config.RateLimit{
+			"Email": {MessagesPerMinute: 1, Burst: 1},
+		}
+		requireProblems(t, cfg)
+
+		cfg.Throttling = &config.Throttling{Enabled: false}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("hysteresis", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Hysteresis = &config.Hysteresis{
+			Enabled: true,
+			Default: config.HysteresisRule{FireN: 0, FireM: 3, ResolveN: 3, ResolveM: 2},
+			Conditions: map[string]config.HysteresisRule{
+				"ratingDecrease": {FireN: 1, FireM: 0, ResolveN: 1, ResolveM: 1},
+			},
+			FlappingWindowSec:  0,
+			FlappingMaxChanges: 1,
+		}
+		requireProblems(t, cfg,
+			"Hysteresis.Default.FireN",
+			"Hysteresis.Default.ResolveM",
+			"Hysteresis.Conditions.ratingDecrease.FireM",
+			"Hysteresis.FlappingMaxChanges",
+			"Hysteresis.FlappingWindowSec",
+		)
+
+		cfg.Hysteresis.Default = config.HysteresisRule{FireN: 2, FireM: 3, ResolveN: 3, ResolveM: 3}
+		cfg.Hysteresis.Conditions["ratingDecrease"] = config.HysteresisRule{FireN: 1, FireM: 1, ResolveN: 1, ResolveM: 1}
+		cfg.Hysteresis.FlappingWindowSec = 600
+		cfg.Hysteresis.FlappingMaxChanges = 4
+		requireProblems(t, cfg)
+
+		cfg.Hysteresis.FlappingMaxChanges = 0
+		cfg.Hysteresis.FlappingWindowSec = 0
+		requireProblems(t, cfg)
+	})
+
+	t.Run("inhibition", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Inhibition = &config.Inhibition{
+			Enabled: true,
+		}
+		requireProblems(t, cfg, "Inhibition.Rules")
+
+		cfg.Inhibition.Rules = []config.InhibitionRule{
+			{
+				Name:   "api unreachable",
+				Source: config.AlertSelector{ClientIDs: []string{"Availability"}},
+				Target: config.AlertSelector{ClientIDs: []string{"NodeRating"}},
+			},
+			{
+				Name:   "api unreachable",
+				Source: config.AlertSelector{Levels: []string{"none", "fatal"}},
+				Equal:  []string{"shard", "datacenter"},
+			},
+			{
+				Source: config.AlertSelector{Conditions: []string{"nonceStalled"}},
+				Target: config.AlertSelector{Labels: map[string]string{"shard": "1", "region": "eu"}},
+			},
+		}
+		requireProblems(t, cfg,
+			"Inhibition.Rules[1].Name",
+			"Inhibition.Rules[1].Source.Levels[0]",
+			"Inhibition.Rules[1].Source.Levels[1]",
+			"Inhibition.Rules[1].Target",
+			"Inhibition.Rules[1].Equal[1]",
+			"Inhibition.Rules[2].Name",
+			"Inhibition.Rules[2].Target.Labels.region",
+		)
+
+		cfg.Inhibition.Rules = []config.InhibitionRule{
+			{
+				Name:   "shard stalled",
+				Source: config.AlertSelector{Conditions: []string{"nonceStalled"}, Levels: []string{"critical"}},
+				Target: config.AlertSelector{ClientIDs: []string{"NodeRating"}, Labels: map[string]string{"identity": "acme"}},
+				Equal:  []string{"shard"},
+			},
+		}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("escalation", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Escalation = &config.Escalation{
+			Enabled: true,
+		}
+		requireProblems(t, cfg, "Escalation.Policies")
+
+		cfg.Escalation.Policies = []config.EscalationPolicy{
+			{
+				Name:  "critical",
+				Match: config.AlertSelector{Levels: []string{"critical"}},
+				Steps: []config.EscalationStep{
+					{DelaySec: 0, Notifiers: []string{"Slack"}},
+					{DelaySec: 900, Notifiers: []string{"Telegram", "Pager"}},
+					{DelaySec: 900},
+				},
+			},
+			{
+				Name: "critical",
+			},
+		}
+		requireProblems(t, cfg,
+			"Escalation.Policies[0].Steps[1].Notifiers[0]",
+			"Escalation.Policies[0].Steps[1].Notifiers[1]",
+			"Escalation.Policies[0].Steps[2].DelaySec",
+			"Escalation.Policies[0].Steps[2].Notifiers",
+			"Escalation.Policies[1].Name",
+			"Escalation.Policies[1].Match",
+			"Escalation.Policies[1].Steps",
+		)
+
+		cfg.Notifiers.Telegram.Enabled = true
+		cfg.Escalation.Policies = []config.EscalationPolicy{
+			{
+				Name:  "critical",
+				Match: config.AlertSelector{Levels: []string{"critical"}},
+				Steps: []config.EscalationStep{
+					{DelaySec: 0, Notifiers: []string{"Slack"}},
+					{DelaySec: 900, Notifiers: []string{"Telegram"}},
+				},
+			},
+		}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("correlation", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Correlation = &config.Correlation{
+			Enabled: true,
+		}
+		requireProblems(t, cfg, "Correlation.Rules")
+
+		cfg.Correlation.Rules = []config.CorrelationRule{
+			{Name: "identity", By: []string{"identity"}, MinMembers: 3},
+			{Name: "identity", By: []string{"shard", "datacenter", "shard"}, MinMembers: 1},
+			{By: nil, MinMembers: 2},
+		}
+		requireProblems(t, cfg,
+			"Correlation.Rules[1].Name",
+			"Correlation.Rules[1].By[1]",
+			"Correlation.Rules[1].By[2]",
+			"Correlation.Rules[1].MinMembers",
+			"Correlation.Rules[2].Name",
+			"Correlation.Rules[2].By",
+		)
+
+		cfg.Correlation.Rules = []config.CorrelationRule{
+			{Name: "identity", By: []string{"identity"}, MinMembers: 3},
+			{Name: "datacenter", By: []string{"tag", "shard"}, MinMembers: 2},
+		}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("reports digest", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Reports = &config.Reports{
+			Digest: &config.Digest{
+				Enabled:   true,
+				Frequency: "monthly",
+				Time:      "9am",
+				Timezone:  "Europe/Unknown",
+				Notifiers: []string{"Unknown", "Email"},
+			},
+		}
+		requireProblems(t, cfg,
+			"Reports.Digest.Frequency",
+			"Reports.Digest.Time",
+			"Reports.Digest.Timezone",
+			"Reports.Digest.Notifiers[0]",
+			"Reports.Digest.Notifiers[1]",
+		)
+
+		cfg.Reports.Digest.Frequency = "weekly"
+		cfg.Reports.Digest.Weekday = "someday"
+		cfg.Reports.Digest.Time = "09:00"
+		cfg.Reports.Digest.Timezone = "Europe/Bucharest"
+		cfg.Reports.Digest.Notifiers = nil
+		requireProblems(t, cfg,
+			"Reports.Digest.Weekday",
+			"Reports.Digest.Notifiers",
+		)
+
+		cfg.Reports.Digest.Weekday = "Monday"
+		cfg.Reports.Digest.Notifiers = []string{"Slack"}
+		requireProblems(t, cfg)
+
+		// a disabled digest is not checked
+		cfg.Reports.Digest = &config.Digest{
+			Time:      "9am",
+			Timezone:  "Europe/Unknown",
+			Notifiers: []string{"Email"},
+		}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("reports epoch", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Reports = &config.Reports{
+			Epoch: &config.EpochReport{
+				Enabled:         true,
+				MinSuccessRatio: 95,
+			},
+		}
+		requireProblems(t, cfg,
+			"Reports.Epoch.CheckIntervalSec",
+			"Reports.Epoch.MinSuccessRatio",
+			"Reports.Epoch.Notifiers",
+		)
+
+		cfg.Reports.Epoch.CheckIntervalSec = 60
+		cfg.Reports.Epoch.MinSuccessRatio = 0.95
+		cfg.Reports.Epoch.Notifiers = []string{"Slack", "Telegram"}
+		requireProblems(t, cfg, "Reports.Epoch.Notifiers[1]")
+
+		cfg.Reports.Epoch.Notifiers = []string{"Slack"}
+		requireProblems(t, cfg)
+
+		cfg.Reports.Epoch = &config.EpochReport{MinSuccessRatio: 95}
+		requireProblems(t, cfg)
+	})
+
+	t.Run("invalid metrics listen address", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createValidConfig()
+		cfg.Metrics.ListenAddress = "localhost"
+
+		requireProblems(t, cfg, "Metrics.ListenAddress")
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := createValidConfig()
+	cfg.General.TriggerIntervalSec = 0
+	cfg.Alarms.NodeRating.PubKeys = nil
+
+	err := config.CheckConfig(cfg)
+	require.True(t, errors.Is(err, config.ErrInvalidConfig))
+	assert.Contains(t, err.Error(), "General.TriggerIntervalSec")
+	assert.Contains(t, err.Error(), "Alarms.NodeRating.PubKeys")
+}
@@ -0,0 +1,559 @@
+package correlation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+var log = logger.GetOrCreate("correlation")
+
+const (
+	storageKey = "Correlation"
+
+	// IncidentClientID is the client id of the alerts which notify the incidents
+	IncidentClientID  = "correlation"
+	incidentCondition = "incident"
+)
+
+// ArgsCorrelator defines the arguments needed to create a new correlator
+type ArgsCorrelator struct {
+	Config *config.Correlation
+	Storer clients.Storer
+}
+
+// group holds the firing alerts with the same values of the labels of a rule
+type group struct {
+	rule     config.CorrelationRule
+	labels   string
+	members  map[string]data.Alert
+	incident *data.Alert
+	openedAt time.Time
+}
+
+// OpenIncident holds an incident which is open, with the alert it has been last notified with
+type OpenIncident struct {
+	Key      string
+	OpenedAt time.Time
+	Alert    data.Alert
+}
+
+// persistedState is the correlation state kept in the state file
+type persistedState struct {
+	Rules  []config.CorrelationRule
+	Firing []data.Alert
+	Open   []OpenIncident
+}
+
+// changes holds the members which joined or left a group since its incident was last notified
+type changes struct {
+	joined []data.Alert
+	left   []data.Alert
+}
+
+type correlator struct {
+	rules           []config.CorrelationRule
+	firing          map[string]data.Alert
+	groups          map[string]*group
+	memberOf        map[string]string
+	pendingChanges  map[string]*changes
+	pendingResolved []data.Alert
+	storer          clients.Storer
+	mut             sync.Mutex
+	getTimeHandler  func() time.Time
+}
+
+// NewCorrelator will create a component which groups the firing alerts by the labels of the correlation rules.
+// A group with enough members is notified as a single incident listing its members, instead of an alert per node,
+// and the incident is notified again as members join or leave
+func NewCorrelator(args ArgsCorrelator) (*correlator, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	c := &correlator{
+		rules:          enabledRules(args.Config),
+		firing:         make(map[string]data.Alert),
+		groups:         make(map[string]*group),
+		memberOf:       make(map[string]string),
+		pendingChanges: make(map[string]*changes),
+		storer:         args.Storer,
+		getTimeHandler: time.Now,
+	}
+	c.load()
+
+	return c, nil
+}
+
+func enabledRules(cfg *config.Correlation) []config.CorrelationRule {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	return cfg.Rules
+}
+
+func (c *correlator) load() {
+	state := persistedState{}
+	err := c.storer.Get(storageKey, &state)
+	if err != nil {
+		log.Debug("no stored correlation state will be used", "reason", err.Error())
+		return
+	}
+
+	for _, alert := range state.Firing {
+		c.firing[alert.ID] = alert
+	}
+	if !reflect.DeepEqual(state.Rules, c.rules) {
+		log.Info("correlation rules changed since the state was saved, the alerts will be grouped again")
+	}
+	c.rebuildGroups(state.Open)
+
+	log.Info("loaded stored correlation state", "num firing alerts", len(c.firing), "num open incidents", len(state.Open))
+}
+
+// SetConfig will apply the new correlation rules: the firing alerts are grouped again, the incidents which no longer
+// match a group are resolved and the new groups are notified with the next event
+func (c *correlator) SetConfig(cfg *config.Correlation) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	rules := enabledRules(cfg)
+	if reflect.DeepEqual(rules, c.rules) {
+		return
+	}
+
+	c.rules = rules
+	c.rebuildGroups(c.openIncidents())
+	c.save()
+}
+
+// rebuildGroups assigns the firing alerts to the groups of the current rules and restores the provided incidents
+func (c *correlator) rebuildGroups(open []OpenIncident) {
+	c.groups = make(map[string]*group)
+	c.memberOf = make(map[string]string)
+	for _, id := range sortedAlertIDs(c.firing) {
+		c.addMember(c.firing[id])
+	}
+
+	for _, incident := range open {
+		g, ok := c.groups[incident.Key]
+		if !ok {
+			// the group does not exist anymore
+			c.pendingResolved = append(c.pendingResolved, incident.Alert)
+			continue
+		}
+
+		incidentAlert := incident.Alert
+		g.incident = &incidentAlert
+		g.openedAt = incident.OpenedAt
+		if len(g.members) < g.rule.MinMembers {
+			// will be closed with the next event
+			c.changesOf(incident.Key)
+		}
+	}
+	for key, g := range c.groups {
+		if g.incident == nil && len(g.members) >= g.rule.MinMembers {
+			c.changesOf(key)
+		}
+	}
+}
+
+// groupKey returns the key of the group of the first rule for which the alert has all the labels
+func (c *correlator) groupKey(alert data.Alert) (string, string, config.CorrelationRule) {
+	if alert.ClientID == IncidentClientID {
+		return "", "", config.CorrelationRule{}
+	}
+
+	for _, rule := range c.rules {
+		values := make([]string, 0, len(rule.By))
+		for _, label := range rule.By {
+			value := alert.Label(label)
+			if value == "" {
+				break
+			}
+			values = append(values, fmt.Sprintf("%s=%s", label, value))
+		}
+		if len(values) != len(rule.By) {
+			continue
+		}
+
+		labels := strings.Join(values, ", ")
+
+		return rule.Name + "/" + labels, labels, rule
+	}
+
+	return "", "", config.CorrelationRule{}
+}
+
+// addMember adds the alert to its group, if any, and returns the group key and whether the alert is a new member
+func (c *correlator) addMember(alert data.Alert) (string, bool) {
+	key, labels, rule := c.groupKey(alert)
+	if key == "" {
+		return "", false
+	}
+
+	g, ok := c.groups[key]
+	if !ok {
+		g = &group{
+			rule:    rule,
+			labels:  labels,
+			members: make(map[string]data.Alert),
+		}
+		c.groups[key] = g
+	}
+
+	_, isMember := g.members[alert.ID]
+	g.members[alert.ID] = alert
+	c.memberOf[alert.ID] = key
+
+	return key, !isMember
+}
+
+func (c *correlator) removeMember(alert data.Alert) string {
+	key, ok := c.memberOf[alert.ID]
+	if !ok {
+		return ""
+	}
+
+	delete(c.memberOf, alert.ID)
+	g, ok := c.groups[key]
+	if ok {
+		delete(g.members, alert.ID)
+	}
+
+	return key
+}
+
+func (c *correlator) changesOf(key string) *changes {
+	groupChanges, ok := c.pendingChanges[key]
+	if !ok {
+		groupChanges = &changes{}
+		c.pendingChanges[key] = groupChanges
+	}
+
+	return groupChanges
+}
+
+// ProcessEvent will replace the alerts of the groups with enough members by a single incident alert for every group.
+// The incident is notified when it opens, when its members change and when it closes, with the remaining members
+// being notified as individual alerts again
+func (c *correlator) ProcessEvent(_ string, event data.NotificationMessage) data.NotificationMessage {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if len(c.rules) == 0 && len(c.groups) == 0 && len(c.pendingResolved) == 0 {
+		return event
+	}
+
+	c.updateMembers(event)
+
+	covered := 0
+	individual := make([]data.Alert, 0, len(event.Alerts))
+	for _, alert := range event.Alerts {
+		g, ok := c.groups[c.memberOf[alert.ID]]
+		if ok && (g.incident != nil || len(g.members) >= g.rule.MinMembers) {
+			covered++
+			continue
+		}
+
+		individual = append(individual, alert)
+	}
+
+	incidents, resolvedIncidents, blocks := c.evaluateChangedGroups(&individual)
+	c.save()
+
+	if covered == 0 && len(incidents) == 0 && len(resolvedIncidents) == 0 {
+		return event
+	}
+
+	return createEvent(event, individual, incidents, resolvedIncidents, blocks)
+}
+
+func (c *correlator) updateMembers(event data.NotificationMessage) {
+	for _, alert := range event.Alerts {
+		c.firing[alert.ID] = alert
+		key, joined := c.addMember(alert)
+		if key == "" {
+			continue
+		}
+
+		// the alerts still firing are passed on unchanged, only the changed ones update the incident of the group
+		if !joined && !alert.Changed {
+			continue
+		}
+		groupChanges := c.changesOf(key)
+		if joined {
+			groupChanges.joined = append(groupChanges.joined, alert)
+		}
+	}
+
+	for _, alert := range event.Resolved {
+		delete(c.firing, alert.ID)
+		key := c.removeMember(alert)
+		if key == "" {
+			continue
+		}
+
+		groupChanges := c.changesOf(key)
+		groupChanges.left = append(groupChanges.left, alert)
+	}
+}
+
+// evaluateChangedGroups opens, updates or closes the incidents of the changed groups. The members of the closed
+// incidents are added to the individual alerts
+func (c *correlator) evaluateChangedGroups(individual *[]data.Alert) ([]data.Alert, []data.Alert, []string) {
+	now := c.getTimeHandler()
+	incidents := make([]data.Alert, 0)
+	resolvedIncidents := c.pendingResolved
+	c.pendingResolved = nil
+	blocks := make([]string, 0)
+
+	for _, key := range sortedGroupKeys(c.pendingChanges) {
+		groupChanges := c.pendingChanges[key]
+		g, ok := c.groups[key]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case len(g.members) >= g.rule.MinMembers:
+			opened := g.incident == nil
+			if opened {
+				g.openedAt = now
+				log.Info("incident opened", "rule", g.rule.Name, "labels", g.labels, "num members", len(g.members))
+			}
+			incident := createIncidentAlert(key, g)
+			g.incident = &incident
+			incidents = append(incidents, incident)
+			blocks = append(blocks, formatIncident(incident, g, groupChanges, opened))
+		case g.incident != nil:
+			log.Info("incident closed", "rule", g.rule.Name, "labels", g.labels, "num members", len(g.members))
+			resolvedIncidents = append(resolvedIncidents, *g.incident)
+			blocks = append(blocks, formatClosedIncident(*g.incident, g))
+			g.incident = nil
+			for _, member := range sortedMembers(g) {
+				member.Changed = true
+				*individual = append(*individual, member)
+			}
+		}
+
+		if len(g.members) == 0 {
+			delete(c.groups, key)
+		}
+	}
+	c.pendingChanges = make(map[string]*changes)
+
+	return incidents, resolvedIncidents, blocks
+}
+
+func createIncidentAlert(key string, g *group) data.Alert {
+	members := sortedMembers(g)
+	incident := data.Alert{
+		ID:           data.NewAlertID(IncidentClientID, "", incidentCondition+"/"+key),
+		ClientID:     IncidentClientID,
+		Condition:    incidentCondition + "/" + key,
+		Level:        common.InfoEvent,
+		Changed:      true,
+		NodeName:     fmt.Sprintf("%s: %s", g.rule.Name, g.labels),
+		CurrentValue: float64(len(members)),
+		Threshold:    float64(g.rule.MinMembers),
+	}
+	for _, member := range members {
+		if member.Level > incident.Level {
+			incident.Level = member.Level
+		}
+	}
+
+	// the labels the group is defined by are common to all the members
+	first := members[0]
+	for _, label := range g.rule.By {
+		switch label {
+		case data.LabelIdentity:
+			incident.Identity = first.Identity
+		case data.LabelShard:
+			incident.Shard = first.Shard
+		case data.LabelProvider:
+			incident.Provider = first.Provider
+		case data.LabelTag:
+			incident.Tags = first.Tags
+		}
+	}
+	incident.Message = fmt.Sprintf("Incident %s: %s firing", incident.NodeName, countNodes(len(members)))
+
+	return incident
+}
+
+func formatIncident(incident data.Alert, g *group, groupChanges *changes, opened bool) string {
+	header := fmt.Sprintf("[%s] %s", incident.ID, incident.Message)
+	if opened {
+		header += " (opened)"
+	}
+	if !opened && len(groupChanges.joined) > 0 {
+		header += ", joined: " + displayNames(groupChanges.joined)
+	}
+	if len(groupChanges.left) > 0 {
+		header += ", left: " + displayNames(groupChanges.left)
+	}
+
+	lines := []string{header + "\n"}
+	for _, member := range sortedMembers(g) {
+		lines = append(lines, fmt.Sprintf("  - [%s] %s\n", member.ID, member.Message))
+	}
+
+	return strings.Join(lines, "")
+}
+
+func formatClosedIncident(incident data.Alert, g *group) string {
+	return fmt.Sprintf("[%s] Incident %s closed, %s still firing\n", incident.ID, incident.NodeName, countNodes(len(g.members)))
+}
+
+// createEvent builds the event to be notified out of the individual alerts and the incidents
+func createEvent(event data.NotificationMessage, individual []data.Alert, incidents []data.Alert, resolvedIncidents []data.Alert, blocks []string) data.NotificationMessage {
+	result := data.NotificationMessage{
+		Level: common.InfoEvent,
+	}
+	if event.Level < result.Level {
+		result.Level = event.Level
+	}
+
+	lines := make([]string, 0, len(individual)+len(blocks))
+	for _, alert := range individual {
+		if !alert.Changed {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("[%s] %s\n", alert.ID, alert.Message))
+	}
+	lines = append(lines, blocks...)
+
+	result.Alerts = append(individual, incidents...)
+	for _, alert := range result.Alerts {
+		if alert.Changed && alert.Level > result.Level {
+			result.Level = alert.Level
+		}
+	}
+	result.Message = strings.Join(lines, "")
+
+	result.Resolved = append(event.Resolved, resolvedIncidents...)
+	if len(result.Resolved) > 0 && result.Level < common.InfoEvent {
+		result.Level = common.InfoEvent
+	}
+
+	return result
+}
+
+// OpenIncidents returns the incidents which are open, the oldest first
+func (c *correlator) OpenIncidents() []OpenIncident {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	return c.openIncidents()
+}
+
+func (c *correlator) openIncidents() []OpenIncident {
+	open := make([]OpenIncident, 0)
+	for key, g := range c.groups {
+		if g.incident == nil {
+			continue
+		}
+
+		open = append(open, OpenIncident{
+			Key:      key,
+			OpenedAt: g.openedAt,
+			Alert:    *g.incident,
+		})
+	}
+	sort.Slice(open, func(i, j int) bool {
+		if open[i].OpenedAt.Equal(open[j].OpenedAt) {
+			return open[i].Key < open[j].Key
+		}
+
+		return open[i].OpenedAt.Before(open[j].OpenedAt)
+	})
+
+	return open
+}
+
+func (c *correlator) save() {
+	state := persistedState{
+		Rules:  c.rules,
+		Firing: make([]data.Alert, 0, len(c.firing)),
+		Open:   c.openIncidents(),
+	}
+	for _, id := range sortedAlertIDs(c.firing) {
+		state.Firing = append(state.Firing, c.firing[id])
+	}
+
+	err := c.storer.Put(storageKey, state)
+	if err != nil {
+		log.Warn("failed to save the correlation state", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *correlator) IsInterfaceNil() bool {
+	return c == nil
+}
+
+func sortedMembers(g *group) []data.Alert {
+	members := make([]data.Alert, 0, len(g.members))
+	for _, member := range g.members {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return strings.ToLower(members[i].DisplayName()) < strings.ToLower(members[j].DisplayName())
+	})
+
+	return members
+}
+
+func displayNames(alerts []data.Alert) string {
+	names := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		names = append(names, alert.DisplayName())
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+func countNodes(count int) string {
+	switch count {
+	case 0:
+		return "no nodes"
+	case 1:
+		return "1 node"
+	default:
+		return fmt.Sprintf("%d nodes", count)
+	}
+}
+
+func sortedAlertIDs(alerts map[string]data.Alert) []string {
+	ids := make([]string, 0, len(alerts))
+	for id := range alerts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func sortedGroupKeys(pendingChanges map[string]*changes) []string {
+	keys := make([]string, 0, len(pendingChanges))
+	for key := range pendingChanges {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
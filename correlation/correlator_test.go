@@ -0,0 +1,313 @@
+package correlation_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/correlation"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCorrelationConfig() *config.Correlation {
+	return &config.Correlation{
+		Enabled: true,
+		Rules: []config.CorrelationRule{
+			{
+				Name:       "provider outage",
+				By:         []string{data.LabelIdentity, data.LabelShard},
+				MinMembers: 2,
+			},
+		},
+	}
+}
+
+func createAlert(name string, identity string, shard int, level common.EventLevel) data.Alert {
+	return data.Alert{
+		ID:        data.NewAlertID("NodeRating", name, "temp-rating"),
+		ClientID:  "NodeRating",
+		Condition: "temp-rating",
+		Level:     level,
+		Message:   name + " temp rating decreased",
+		Changed:   true,
+		PubKey:    name,
+		NodeName:  name,
+		Identity:  identity,
+		Shard:     shard,
+	}
+}
+
+func createEvent(level common.EventLevel, alerts []data.Alert, resolved []data.Alert) data.NotificationMessage {
+	event := data.NotificationMessage{
+		Level:    level,
+		Alerts:   alerts,
+		Resolved: resolved,
+	}
+	for _, alert := range alerts {
+		event.Message += "[" + alert.ID + "] " + alert.Message + "\n"
+	}
+
+	return event
+}
+
+type correlatorHandler interface {
+	ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage
+	SetConfig(cfg *config.Correlation)
+	OpenIncidents() []correlation.OpenIncident
+}
+
+func createCorrelator(t *testing.T, cfg *config.Correlation) correlatorHandler {
+	c, err := correlation.NewCorrelator(correlation.ArgsCorrelator{
+		Config: cfg,
+		Storer: &mocks.StorerStub{},
+	})
+	require.Nil(t, err)
+
+	return c
+}
+
+func TestNewCorrelator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := correlation.NewCorrelator(correlation.ArgsCorrelator{Config: createCorrelationConfig()})
+		assert.Nil(t, c)
+		assert.Equal(t, correlation.ErrNilStorer, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := correlation.NewCorrelator(correlation.ArgsCorrelator{
+			Config: createCorrelationConfig(),
+			Storer: &mocks.StorerStub{},
+		})
+		require.Nil(t, err)
+		assert.False(t, c.IsInterfaceNil())
+	})
+}
+
+func TestCorrelator_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled correlation returns the event as it is", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createCorrelationConfig()
+		cfg.Enabled = false
+		c := createCorrelator(t, cfg)
+
+		event := createEvent(common.CriticalEvent, []data.Alert{
+			createAlert("node-1", "acme", 0, common.CriticalEvent),
+			createAlert("node-2", "acme", 0, common.CriticalEvent),
+		}, nil)
+		assert.Equal(t, event, c.ProcessEvent("NodeRating", event))
+	})
+
+	t.Run("alerts below the min members are notified individually", func(t *testing.T) {
+		t.Parallel()
+
+		c := createCorrelator(t, createCorrelationConfig())
+
+		event := createEvent(common.WarningEvent, []data.Alert{
+			createAlert("node-1", "acme", 0, common.WarningEvent),
+			createAlert("node-2", "acme", 1, common.WarningEvent),
+			createAlert("node-3", "", 0, common.WarningEvent),
+		}, nil)
+		assert.Equal(t, event, c.ProcessEvent("NodeRating", event))
+		assert.Empty(t, c.OpenIncidents())
+	})
+
+	t.Run("incident opens, updates and closes", func(t *testing.T) {
+		t.Parallel()
+
+		c := createCorrelator(t, createCorrelationConfig())
+		node1 := createAlert("node-1", "acme", 0, common.WarningEvent)
+		node2 := createAlert("node-2", "acme", 0, common.CriticalEvent)
+		node3 := createAlert("node-3", "acme", 0, common.WarningEvent)
+		other := createAlert("node-4", "other", 0, common.WarningEvent)
+
+		// node-1 alone is notified individually
+		result := c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{node1}, nil))
+		assert.Equal(t, []data.Alert{node1}, result.Alerts)
+
+		// node-2 opens the incident, the unrelated alert passes
+		result = c.ProcessEvent("NodeRating", createEvent(common.CriticalEvent, []data.Alert{node2, other}, nil))
+		require.Len(t, result.Alerts, 2)
+		assert.Equal(t, other, result.Alerts[0])
+		incident := result.Alerts[1]
+		assert.Equal(t, correlation.IncidentClientID, incident.ClientID)
+		assert.Equal(t, common.CriticalEvent, incident.Level)
+		assert.Equal(t, "provider outage: identity=acme, shard=0", incident.NodeName)
+		assert.Equal(t, "acme", incident.Identity)
+		assert.Empty(t, incident.PubKey)
+		assert.Equal(t, float64(2), incident.CurrentValue)
+		assert.Equal(t, common.CriticalEvent, result.Level)
+		expectedMessage := "[" + other.ID + "] node-4 temp rating decreased\n" +
+			"[" + incident.ID + "] Incident provider outage: identity=acme, shard=0: 2 nodes firing (opened)\n" +
+			"  - [" + node1.ID + "] node-1 temp rating decreased\n" +
+			"  - [" + node2.ID + "] node-2 temp rating decreased\n"
+		assert.Equal(t, expectedMessage, result.Message)
+
+		open := c.OpenIncidents()
+		require.Len(t, open, 1)
+		assert.Equal(t, incident, open[0].Alert)
+
+		// node-3 joins while node-1 resolves
+		result = c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{node3}, []data.Alert{node1}))
+		require.Len(t, result.Alerts, 1)
+		assert.Equal(t, incident.ID, result.Alerts[0].ID)
+		assert.Equal(t, []data.Alert{node1}, result.Resolved)
+		assert.True(t, strings.HasPrefix(result.Message,
+			"["+incident.ID+"] Incident provider outage: identity=acme, shard=0: 2 nodes firing, joined: node-3, left: node-1\n"))
+
+		// node-2 resolves, the incident closes and node-3 is notified on its own
+		result = c.ProcessEvent("NodeRating", createEvent(common.InfoEvent, nil, []data.Alert{node2}))
+		assert.Equal(t, []data.Alert{node3}, result.Alerts)
+		require.Len(t, result.Resolved, 2)
+		assert.Equal(t, node2, result.Resolved[0])
+		assert.Equal(t, incident.ID, result.Resolved[1].ID)
+		assert.Equal(t, common.WarningEvent, result.Level)
+		expectedMessage = "[" + node3.ID + "] node-3 temp rating decreased\n" +
+			"[" + incident.ID + "] Incident provider outage: identity=acme, shard=0 closed, 1 node still firing\n"
+		assert.Equal(t, expectedMessage, result.Message)
+		assert.Empty(t, c.OpenIncidents())
+	})
+
+	t.Run("alerts still firing do not notify the incident again", func(t *testing.T) {
+		t.Parallel()
+
+		c := createCorrelator(t, createCorrelationConfig())
+		node1 := createAlert("node-1", "acme", 0, common.WarningEvent)
+		node2 := createAlert("node-2", "acme", 0, common.WarningEvent)
+		result := c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{node1, node2}, nil))
+		require.Len(t, result.Alerts, 1)
+		incident := result.Alerts[0]
+
+		node1.Changed = false
+		node2.Changed = false
+		other := createAlert("node-3", "other", 0, common.WarningEvent)
+		other.Changed = false
+		result = c.ProcessEvent("NodeRating", data.NotificationMessage{
+			Level:  common.InfoEvent,
+			Alerts: []data.Alert{node1, node2, other},
+		})
+		assert.Equal(t, []data.Alert{other}, result.Alerts)
+		assert.Empty(t, result.Message)
+		assert.Equal(t, common.InfoEvent, result.Level)
+
+		// the remaining member is notified again when the incident closes
+		result = c.ProcessEvent("NodeRating", data.NotificationMessage{
+			Level:    common.InfoEvent,
+			Alerts:   []data.Alert{node2},
+			Resolved: []data.Alert{node1},
+		})
+		require.Len(t, result.Alerts, 1)
+		assert.Equal(t, node2.ID, result.Alerts[0].ID)
+		assert.True(t, result.Alerts[0].Changed)
+		assert.Equal(t, incident.ID, result.Resolved[1].ID)
+		assert.Equal(t, common.WarningEvent, result.Level)
+	})
+
+	t.Run("rules are applied in order", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createCorrelationConfig()
+		cfg.Rules = append(cfg.Rules, config.CorrelationRule{
+			Name:       "identity",
+			By:         []string{data.LabelIdentity},
+			MinMembers: 2,
+		})
+		c := createCorrelator(t, cfg)
+
+		result := c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{
+			createAlert("node-1", "acme", 0, common.WarningEvent),
+			createAlert("node-2", "acme", 1, common.WarningEvent),
+			createAlert("node-3", "acme", 1, common.WarningEvent),
+		}, nil))
+
+		// all of them match the first rule, so node-1 is alone in its shard group
+		require.Len(t, result.Alerts, 2)
+		assert.Equal(t, "node-1", result.Alerts[0].DisplayName())
+		assert.Equal(t, "provider outage: identity=acme, shard=1", result.Alerts[1].NodeName)
+
+		cfg.Rules = cfg.Rules[1:]
+		c = createCorrelator(t, cfg)
+		result = c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{
+			createAlert("node-1", "acme", 0, common.WarningEvent),
+			createAlert("node-2", "acme", 1, common.WarningEvent),
+		}, nil))
+		require.Len(t, result.Alerts, 1)
+		assert.Equal(t, "identity: identity=acme", result.Alerts[0].NodeName)
+	})
+}
+
+func TestCorrelator_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	c := createCorrelator(t, createCorrelationConfig())
+	result := c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{
+		createAlert("node-1", "acme", 0, common.WarningEvent),
+		createAlert("node-2", "acme", 1, common.WarningEvent),
+	}, nil))
+	assert.Len(t, result.Alerts, 2)
+
+	cfg := createCorrelationConfig()
+	cfg.Rules[0].By = []string{data.LabelIdentity}
+	c.SetConfig(cfg)
+
+	// the firing alerts are grouped again and the incident is notified with the next event
+	result = c.ProcessEvent("NodeRating", data.NotificationMessage{Level: common.NoEvent})
+	require.Len(t, result.Alerts, 1)
+	incident := result.Alerts[0]
+	assert.Equal(t, "provider outage: identity=acme", incident.NodeName)
+
+	c.SetConfig(nil)
+	result = c.ProcessEvent("NodeRating", data.NotificationMessage{Level: common.NoEvent})
+	assert.Empty(t, result.Alerts)
+	require.Len(t, result.Resolved, 1)
+	assert.Equal(t, incident.ID, result.Resolved[0].ID)
+	assert.Equal(t, common.InfoEvent, result.Level)
+}
+
+func TestCorrelator_Persistence(t *testing.T) {
+	t.Parallel()
+
+	storer, err := storage.NewJSONFileStorer(filepath.Join(t.TempDir(), "state.json"))
+	require.Nil(t, err)
+
+	args := correlation.ArgsCorrelator{
+		Config: createCorrelationConfig(),
+		Storer: storer,
+	}
+	c, _ := correlation.NewCorrelator(args)
+	c.SetGetTimeHandler(func() time.Time {
+		return time.Unix(1700000000, 0)
+	})
+	node1 := createAlert("node-1", "acme", 0, common.WarningEvent)
+	node2 := createAlert("node-2", "acme", 0, common.WarningEvent)
+	c.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{node1, node2}, nil))
+
+	reloaded, err := correlation.NewCorrelator(args)
+	require.Nil(t, err)
+	open := reloaded.OpenIncidents()
+	require.Len(t, open, 1)
+	assert.Equal(t, time.Unix(1700000000, 0).Unix(), open[0].OpenedAt.Unix())
+
+	// a member joining after the restart updates the same incident
+	result := reloaded.ProcessEvent("NodeRating", createEvent(common.WarningEvent, []data.Alert{
+		createAlert("node-3", "acme", 0, common.WarningEvent),
+	}, nil))
+	require.Len(t, result.Alerts, 1)
+	assert.Equal(t, open[0].Alert.ID, result.Alerts[0].ID)
+	assert.Equal(t, float64(3), result.Alerts[0].CurrentValue)
+}
@@ -0,0 +1,6 @@
+package correlation
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
@@ -0,0 +1,8 @@
+package correlation
+
+import "time"
+
+// SetGetTimeHandler -
+func (c *correlator) SetGetTimeHandler(handler func() time.Time) {
+	c.getTimeHandler = handler
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+)
+
+const alertIDLength = 8
+
+// Alert holds a condition detected by a client for a single node
+type Alert struct {
+	ID        string
+	ClientID  string
+	Condition string
+	Level     common.EventLevel
+	Message   string
+	// Changed is set when the alert brings new information since the previous check (it just fired, it changed
+	// its level or the value got worse) and should be notified. Alerts which are still firing without changes
+	// are reported with Changed false, so that the alerts state knows they are not resolved
+	Changed bool
+	// Flapping is set when the alert changes its state too often, so its state changes are no longer notified
+	Flapping bool
+	// OneShot is set for the alerts raised by a single check, as a sudden rating decrease, which are not reported
+	// again while the condition lasts. They are kept active for a while and their resolve is not notified, so the
+	// incidents they opened are not closed automatically
+	OneShot bool
+
+	PubKey   string
+	NodeName string
+	Alias    string
+	Identity string
+	Provider string
+	Shard    int
+	Tags     []string
+
+	CurrentValue  float64
+	PreviousValue float64
+	Threshold     float64
+}
+
+// NewDedupKey returns the stable key identifying the alerts raised by a client for a node condition
+func NewDedupKey(clientID string, pubKey string, condition string) string {
+	hash := sha256.Sum256([]byte(clientID + "/" + pubKey + "/" + condition))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// NewAlertID returns the short and stable identifier of an alert raised by a client for a node condition
+func NewAlertID(clientID string, pubKey string, condition string) string {
+	return NewDedupKey(clientID, pubKey, condition)[:alertIDLength]
+}
+
+// DisplayName returns the configured alias, if any, the node name or the public key otherwise
+func (a Alert) DisplayName() string {
+	if a.Alias != "" {
+		return a.Alias
+	}
+	if a.NodeName != "" {
+		return a.NodeName
+	}
+
+	return a.PubKey
+}
+
+// The labels of an alert which can be used to group and to match the alerts
+const (
+	LabelIdentity = "identity"
+	LabelShard    = "shard"
+	LabelProvider = "provider"
+	LabelTag      = "tag"
+)
+
+// GroupingLabels returns the names of the labels the alerts can be grouped by
+func GroupingLabels() []string {
+	return []string{LabelIdentity, LabelShard, LabelProvider, LabelTag}
+}
+
+// HasLabel returns true if the alert has the label with the provided value. A node with several tags has the tag
+// label with every one of them
+func (a Alert) HasLabel(name string, value string) bool {
+	if name != LabelTag || a.PubKey == "" {
+		return a.Label(name) == value
+	}
+
+	for _, tag := range a.Tags {
+		if tag == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Label returns the value of the label with the provided name, or an empty string if the alert does not have it.
+// The tags are returned sorted and joined by comma. The alerts which are not raised for a node have no labels
+func (a Alert) Label(name string) string {
+	if a.PubKey == "" {
+		return ""
+	}
+
+	switch name {
+	case LabelIdentity:
+		return a.Identity
+	case LabelShard:
+		return strconv.Itoa(a.Shard)
+	case LabelProvider:
+		return a.Provider
+	case LabelTag:
+		tags := append([]string{}, a.Tags...)
+		sort.Strings(tags)
+		return strings.Join(tags, ",")
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,65 @@
+package data
+
+import "github.com/multiversx/mx-chain-node-monitoring/common"
+
+// AlertSelector matches the alerts by their client, condition, level and labels. An empty criterion matches any alert
+type AlertSelector struct {
+	clientIDs  map[string]struct{}
+	conditions map[string]struct{}
+	levels     map[common.EventLevel]struct{}
+	labels     map[string]string
+}
+
+// NewAlertSelector creates a selector out of the provided criteria. The levels are provided by their names
+func NewAlertSelector(clientIDs []string, conditions []string, levels []string, labels map[string]string) (AlertSelector, error) {
+	s := AlertSelector{
+		clientIDs:  toSet(clientIDs),
+		conditions: toSet(conditions),
+		levels:     make(map[common.EventLevel]struct{}),
+		labels:     labels,
+	}
+	for _, name := range levels {
+		level, err := common.EventLevelFromString(name)
+		if err != nil {
+			return AlertSelector{}, err
+		}
+		s.levels[level] = struct{}{}
+	}
+
+	return s, nil
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// Matches returns true if the alert meets all the criteria of the selector
+func (s AlertSelector) Matches(alert Alert) bool {
+	if len(s.clientIDs) > 0 {
+		if _, ok := s.clientIDs[alert.ClientID]; !ok {
+			return false
+		}
+	}
+	if len(s.conditions) > 0 {
+		if _, ok := s.conditions[alert.Condition]; !ok {
+			return false
+		}
+	}
+	if len(s.levels) > 0 {
+		if _, ok := s.levels[alert.Level]; !ok {
+			return false
+		}
+	}
+	for label, value := range s.labels {
+		if !alert.HasLabel(label, value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAlertSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid level", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := data.NewAlertSelector(nil, nil, []string{"unknown"}, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := data.NewAlertSelector([]string{"NodeRating"}, []string{"ratingDecrease"}, []string{"critical"}, nil)
+		assert.Nil(t, err)
+	})
+}
+
+func TestAlertSelector_Matches(t *testing.T) {
+	t.Parallel()
+
+	alert := data.Alert{
+		ClientID:  "NodeRating",
+		Condition: "ratingDecrease",
+		Level:     common.CriticalEvent,
+		PubKey:    "key1",
+		Identity:  "acme",
+		Shard:     1,
+		Tags:      []string{"validators", "eu"},
+	}
+
+	t.Run("empty selector matches any alert", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := data.NewAlertSelector(nil, nil, nil, nil)
+		require.Nil(t, err)
+		assert.True(t, s.Matches(alert))
+	})
+
+	t.Run("client, condition and level", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := data.NewAlertSelector([]string{"NodeRating"}, []string{"ratingDecrease"}, []string{"critical"}, nil)
+		require.Nil(t, err)
+		assert.True(t, s.Matches(alert))
+
+		s, err = data.NewAlertSelector(nil, nil, []string{"warning"}, nil)
+		require.Nil(t, err)
+		assert.False(t, s.Matches(alert))
+
+		s, err = data.NewAlertSelector([]string{"Availability"}, nil, nil, nil)
+		require.Nil(t, err)
+		assert.False(t, s.Matches(alert))
+	})
+
+	t.Run("labels", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := data.NewAlertSelector(nil, nil, nil, map[string]string{data.LabelIdentity: "acme", data.LabelShard: "1"})
+		require.Nil(t, err)
+		assert.True(t, s.Matches(alert))
+
+		s, err = data.NewAlertSelector(nil, nil, nil, map[string]string{data.LabelShard: "2"})
+		require.Nil(t, err)
+		assert.False(t, s.Matches(alert))
+	})
+
+	t.Run("tag matches any of the node tags", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := data.NewAlertSelector(nil, nil, nil, map[string]string{data.LabelTag: "validators"})
+		require.Nil(t, err)
+		assert.True(t, s.Matches(alert))
+
+		s, err = data.NewAlertSelector(nil, nil, nil, map[string]string{data.LabelTag: "eu"})
+		require.Nil(t, err)
+		assert.True(t, s.Matches(alert))
+
+		s, err = data.NewAlertSelector(nil, nil, nil, map[string]string{data.LabelTag: "us"})
+		require.Nil(t, err)
+		assert.False(t, s.Matches(alert))
+
+		// the alerts which are not raised for a node have no labels
+		notForNode := alert
+		notForNode.PubKey = ""
+		s, err = data.NewAlertSelector(nil, nil, nil, map[string]string{data.LabelTag: "validators"})
+		require.Nil(t, err)
+		assert.False(t, s.Matches(notForNode))
+	})
+}
@@ -5,4 +5,8 @@ import "github.com/multiversx/mx-chain-node-monitoring/common"
 type NotificationMessage struct {
 	Message string
 	Level   common.EventLevel
+	// Alerts holds the per node conditions the message was built from, if the client provides them
+	Alerts []Alert
+	// Resolved holds the alerts which stopped firing since the previous check
+	Resolved []Alert
 }
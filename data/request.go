@@ -0,0 +1,10 @@
+package data
+
+// HTTPRequest holds the details of a http request sent by the notifiers which need more than a json post,
+// like custom methods, headers or bodies
+type HTTPRequest struct {
+	Method  string
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
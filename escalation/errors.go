@@ -0,0 +1,12 @@
+package escalation
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilSender signals that a nil sender has been provided
+var ErrNilSender = errors.New("nil sender")
+
+// ErrNilAlertsProvider signals that a nil alerts provider has been provided
+var ErrNilAlertsProvider = errors.New("nil alerts provider")
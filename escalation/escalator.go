@@ -0,0 +1,602 @@
+package escalation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+var log = logger.GetOrCreate("escalation")
+
+const (
+	storageKey    = "Escalation"
+	checkInterval = 10 * time.Second
+	// maxSendAttempts bounds the retries of a notification which failed, done on the next checks
+	maxSendAttempts = 6
+)
+
+// ArgsEscalator defines the arguments needed to create a new escalator
+type ArgsEscalator struct {
+	Config         *config.Escalation
+	Storer         clients.Storer
+	Sender         Sender
+	AlertsProvider AlertsProvider
+}
+
+// ActiveEscalation holds an alert which is notified through the steps of an escalation policy
+type ActiveEscalation struct {
+	Alert     data.Alert
+	Policy    string
+	StartedAt time.Time
+	// NextStep is the index of the first policy step which has not been notified yet
+	NextStep int
+	// Notifiers holds the notifiers reached by the steps already notified, which receive the updates and the resolve
+	Notifiers []string
+}
+
+type policy struct {
+	name     string
+	selector data.AlertSelector
+	steps    []config.EscalationStep
+}
+
+type notification struct {
+	notifiers []string
+	msg       data.NotificationMessage
+	attempts  int
+}
+
+type escalator struct {
+	policies       []policy
+	escalations    map[string]*ActiveEscalation
+	pending        []notification
+	storer         clients.Storer
+	sender         Sender
+	alertsProvider AlertsProvider
+	mut            sync.Mutex
+	wakeUp         chan struct{}
+	getTimeHandler func() time.Time
+	cancelFunc     func()
+}
+
+// NewEscalator will create a component which routes the changed alerts matched by an escalation policy through the
+// policy steps instead of all the notifiers. Every step notifies its notifiers once its delay passed since the alert
+// fired, until the alert is acknowledged or resolved
+func NewEscalator(args ArgsEscalator) (*escalator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := createPolicies(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &escalator{
+		policies:       policies,
+		escalations:    make(map[string]*ActiveEscalation),
+		storer:         args.Storer,
+		sender:         args.Sender,
+		alertsProvider: args.AlertsProvider,
+		wakeUp:         make(chan struct{}, 1),
+		getTimeHandler: time.Now,
+	}
+	e.load()
+
+	return e, nil
+}
+
+func checkArgs(args ArgsEscalator) error {
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Sender) {
+		return ErrNilSender
+	}
+	if check.IfNil(args.AlertsProvider) {
+		return ErrNilAlertsProvider
+	}
+
+	return nil
+}
+
+func createPolicies(cfg *config.Escalation) ([]policy, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+
+	policies := make([]policy, 0, len(cfg.Policies))
+	for _, policyCfg := range cfg.Policies {
+		selector, err := data.NewAlertSelector(policyCfg.Match.ClientIDs, policyCfg.Match.Conditions, policyCfg.Match.Levels, policyCfg.Match.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policyCfg.Name, err)
+		}
+
+		policies = append(policies, policy{
+			name:     policyCfg.Name,
+			selector: selector,
+			steps:    policyCfg.Steps,
+		})
+	}
+
+	return policies, nil
+}
+
+func (e *escalator) load() {
+	escalations := make(map[string]*ActiveEscalation)
+	err := e.storer.Get(storageKey, &escalations)
+	if err != nil {
+		log.Debug("no stored escalation state will be used", "reason", err.Error())
+		return
+	}
+
+	e.escalations = escalations
+	log.Info("loaded stored escalation state", "num escalations", len(e.escalations))
+}
+
+// SetConfig will apply the new escalation policies. The escalations in progress continue with the new steps of their
+// policy, while the ones whose policy has been removed are stopped
+func (e *escalator) SetConfig(cfg *config.Escalation) error {
+	policies, err := createPolicies(cfg)
+	if err != nil {
+		return err
+	}
+
+	e.mut.Lock()
+	e.policies = policies
+	e.mut.Unlock()
+
+	e.wake()
+
+	return nil
+}
+
+// ProcessEvent will keep the changed alerts matched by a policy out of the event, as they are notified by the policy
+// steps. The changes and the resolves of the alerts already escalated are queued for the notifiers reached so far
+func (e *escalator) ProcessEvent(_ string, event data.NotificationMessage) data.NotificationMessage {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+
+	if len(e.policies) == 0 && len(e.escalations) == 0 {
+		return event
+	}
+
+	now := e.getTimeHandler()
+	modified := false
+	updates := newNotificationsBatch()
+	alerts := make([]data.Alert, 0, len(event.Alerts))
+	for _, alert := range event.Alerts {
+		escalation, ok := e.escalations[alert.ID]
+		if !ok {
+			p, found := e.matchingPolicy(alert)
+			if !alert.Changed || !found {
+				alerts = append(alerts, alert)
+				continue
+			}
+
+			escalation = &ActiveEscalation{
+				Policy:    p.name,
+				StartedAt: now,
+			}
+			e.escalations[alert.ID] = escalation
+			log.Info("alert escalation started", "alert", alert.ID, "node", alert.DisplayName(), "policy", p.name)
+		} else if alert.Changed {
+			updates.addAlert(escalation.Notifiers, alert)
+		}
+
+		escalation.Alert = alert
+		if alert.Changed {
+			// kept as unchanged, so it is still known as firing by the next handlers
+			alert.Changed = false
+			modified = true
+		}
+		alerts = append(alerts, alert)
+	}
+
+	resolved := make([]data.Alert, 0, len(event.Resolved))
+	for _, alert := range event.Resolved {
+		escalation, ok := e.escalations[alert.ID]
+		if !ok {
+			resolved = append(resolved, alert)
+			continue
+		}
+
+		delete(e.escalations, alert.ID)
+		if !alert.OneShot {
+			updates.addResolved(escalation.Notifiers, alert)
+		}
+		log.Info("alert escalation stopped, the alert resolved", "alert", alert.ID, "node", alert.DisplayName(), "policy", escalation.Policy)
+		modified = true
+	}
+
+	if !modified {
+		return event
+	}
+
+	e.pending = append(e.pending, updates.notifications()...)
+	e.save()
+	e.wake()
+
+	return createEvent(event, alerts, resolved)
+}
+
+func (e *escalator) matchingPolicy(alert data.Alert) (policy, bool) {
+	for _, p := range e.policies {
+		if p.selector.Matches(alert) {
+			return p, true
+		}
+	}
+
+	return policy{}, false
+}
+
+func (e *escalator) policy(name string) (policy, bool) {
+	for _, p := range e.policies {
+		if p.name == name {
+			return p, true
+		}
+	}
+
+	return policy{}, false
+}
+
+// wake triggers a check without waiting for the next tick
+func (e *escalator) wake() {
+	select {
+	case e.wakeUp <- struct{}{}:
+	default:
+	}
+}
+
+// Start will notify the escalation steps once they are due, on a separate go routine
+func (e *escalator) Start() {
+	var ctx context.Context
+	ctx, e.cancelFunc = context.WithCancel(context.Background())
+
+	go e.run(ctx)
+}
+
+func (e *escalator) run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	e.check()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("escalator is stopping...")
+			return
+		case <-ticker.C:
+			e.check()
+		case <-e.wakeUp:
+			e.check()
+		}
+	}
+}
+
+// check stops the escalations of the acknowledged alerts and sends the queued updates together with the due steps,
+// grouped by policy step. The muted alerts are not sent and their due steps wait until they are no longer muted,
+// while the notifications which failed are queued again, to be retried on the next checks
+func (e *escalator) check() {
+	acknowledged := make(map[string]string)
+	for _, activeAlert := range e.alertsProvider.ActiveAlerts() {
+		if activeAlert.Acknowledged {
+			acknowledged[activeAlert.ID] = activeAlert.AcknowledgedBy
+		}
+	}
+
+	e.mut.Lock()
+	now := e.getTimeHandler()
+	notifications := e.pending
+	e.pending = nil
+
+	modified := false
+	steps := newNotificationsBatch()
+	for _, id := range e.sortedIDs() {
+		escalation := e.escalations[id]
+		by, ok := acknowledged[id]
+		if ok {
+			delete(e.escalations, id)
+			log.Info("alert escalation stopped, the alert has been acknowledged", "alert", id, "by", by, "policy", escalation.Policy)
+			modified = true
+			continue
+		}
+
+		p, ok := e.policy(escalation.Policy)
+		if !ok {
+			delete(e.escalations, id)
+			log.Info("alert escalation stopped, the policy has been removed", "alert", id, "policy", escalation.Policy)
+			modified = true
+			continue
+		}
+
+		if e.alertsProvider.IsMuted(escalation.Alert) {
+			continue
+		}
+
+		for escalation.NextStep < len(p.steps) {
+			step := p.steps[escalation.NextStep]
+			if now.Before(escalation.StartedAt.Add(stepDelay(step))) {
+				break
+			}
+
+			key := fmt.Sprintf("%s/%d", p.name, escalation.NextStep)
+			steps.addStepAlert(key, step.Notifiers, escalation.Alert, stepHeader(p, escalation.NextStep))
+			escalation.Notifiers = mergeNotifiers(escalation.Notifiers, step.Notifiers)
+			escalation.NextStep++
+			modified = true
+		}
+	}
+	notifications = append(notifications, steps.notifications()...)
+	if modified {
+		e.save()
+	}
+	e.mut.Unlock()
+
+	failed := make([]notification, 0)
+	for _, n := range notifications {
+		n, ok := e.removeMutedAlerts(n)
+		if !ok {
+			continue
+		}
+
+		err := e.sender.SendAlerts(n.notifiers, n.msg)
+		if err == nil {
+			log.Info("escalation sent", "notifiers", strings.Join(n.notifiers, ", "), "num alerts", len(n.msg.Alerts), "num resolved", len(n.msg.Resolved))
+			continue
+		}
+
+		n.attempts++
+		if n.attempts >= maxSendAttempts {
+			log.Error("failed to send the escalation, dropped", "notifiers", strings.Join(n.notifiers, ", "), "num attempts", n.attempts, "error", err.Error())
+			continue
+		}
+
+		log.Warn("failed to send the escalation, will retry", "notifiers", strings.Join(n.notifiers, ", "), "num attempts", n.attempts, "error", err.Error())
+		failed = append(failed, n)
+	}
+	if len(failed) == 0 {
+		return
+	}
+
+	e.mut.Lock()
+	e.pending = append(failed, e.pending...)
+	e.mut.Unlock()
+}
+
+// removeMutedAlerts removes from the notification the alerts which have been acknowledged or whose nodes have been
+// silenced since it was queued, together with their lines. False is returned if nothing is left to be sent
+func (e *escalator) removeMutedAlerts(n notification) (notification, bool) {
+	alerts := make([]data.Alert, 0, len(n.msg.Alerts))
+	mutedLines := make([]string, 0)
+	for _, alert := range n.msg.Alerts {
+		if e.alertsProvider.IsMuted(alert) {
+			log.Debug("escalated alert muted", "alert", alert.ID, "node", alert.DisplayName())
+			mutedLines = append(mutedLines, fmt.Sprintf("[%s] ", alert.ID))
+			continue
+		}
+
+		alerts = append(alerts, alert)
+	}
+	if len(mutedLines) == 0 {
+		return n, true
+	}
+	if len(alerts) == 0 && len(n.msg.Resolved) == 0 {
+		return notification{}, false
+	}
+
+	n.msg.Alerts = alerts
+	n.msg.Message = removeLines(n.msg.Message, mutedLines)
+	n.msg.Level = common.InfoEvent
+	for _, alert := range alerts {
+		if alert.Level > n.msg.Level {
+			n.msg.Level = alert.Level
+		}
+	}
+
+	return n, true
+}
+
+// removeLines removes the lines of the message starting with any of the provided prefixes
+func removeLines(message string, prefixes []string) string {
+	lines := strings.SplitAfter(message, "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		muted := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(line, prefix) {
+				muted = true
+				break
+			}
+		}
+		if !muted {
+			kept = append(kept, line)
+		}
+	}
+
+	return strings.Join(kept, "")
+}
+
+func stepDelay(step config.EscalationStep) time.Duration {
+	return time.Duration(step.DelaySec) * time.Second
+}
+
+func stepHeader(p policy, index int) string {
+	header := fmt.Sprintf("Escalation policy %s, step %d of %d", p.name, index+1, len(p.steps))
+	delay := stepDelay(p.steps[index])
+	if delay > 0 {
+		header += fmt.Sprintf(", not acknowledged for %s", delay)
+	}
+
+	return header + "\n"
+}
+
+func mergeNotifiers(notifiers []string, newNotifiers []string) []string {
+	result := append([]string{}, notifiers...)
+	for _, name := range newNotifiers {
+		found := false
+		for _, existing := range result {
+			if existing == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
+
+// Escalations returns the escalations in progress
+func (e *escalator) Escalations() []ActiveEscalation {
+	e.mut.Lock()
+	defer e.mut.Unlock()
+
+	escalations := make([]ActiveEscalation, 0, len(e.escalations))
+	for _, id := range e.sortedIDs() {
+		escalations = append(escalations, *e.escalations[id])
+	}
+
+	return escalations
+}
+
+func (e *escalator) sortedIDs() []string {
+	ids := make([]string, 0, len(e.escalations))
+	for id := range e.escalations {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (e *escalator) save() {
+	err := e.storer.Put(storageKey, e.escalations)
+	if err != nil {
+		log.Warn("failed to save the escalation state", "error", err.Error())
+	}
+}
+
+// Close will stop the escalation checks
+func (e *escalator) Close() error {
+	if e.cancelFunc != nil {
+		e.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *escalator) IsInterfaceNil() bool {
+	return e == nil
+}
+
+// notificationsBatch groups the alerts to be sent by key, keeping the order in which the keys were added
+type notificationsBatch struct {
+	keys   []string
+	groups map[string]*notification
+}
+
+func newNotificationsBatch() *notificationsBatch {
+	return &notificationsBatch{
+		groups: make(map[string]*notification),
+	}
+}
+
+func (nb *notificationsBatch) group(key string, notifiers []string, header string) *notification {
+	n, ok := nb.groups[key]
+	if !ok {
+		n = &notification{
+			notifiers: append([]string{}, notifiers...),
+			msg: data.NotificationMessage{
+				Level:   common.InfoEvent,
+				Message: header,
+			},
+		}
+		nb.groups[key] = n
+		nb.keys = append(nb.keys, key)
+	}
+
+	return n
+}
+
+func (nb *notificationsBatch) addStepAlert(key string, notifiers []string, alert data.Alert, header string) {
+	alert.Changed = true
+	n := nb.group(key, notifiers, header)
+	n.msg.Alerts = append(n.msg.Alerts, alert)
+	n.msg.Message += fmt.Sprintf("[%s] %s\n", alert.ID, alert.Message)
+	if alert.Level > n.msg.Level {
+		n.msg.Level = alert.Level
+	}
+}
+
+// addAlert adds the change of an escalated alert, if any notifier has been reached already
+func (nb *notificationsBatch) addAlert(notifiers []string, alert data.Alert) {
+	if len(notifiers) == 0 {
+		return
+	}
+
+	nb.addStepAlert(strings.Join(notifiers, ","), notifiers, alert, "")
+}
+
+// addResolved adds the resolve of an escalated alert, if any notifier has been reached already
+func (nb *notificationsBatch) addResolved(notifiers []string, alert data.Alert) {
+	if len(notifiers) == 0 {
+		return
+	}
+
+	n := nb.group(strings.Join(notifiers, ","), notifiers, "")
+	n.msg.Resolved = append(n.msg.Resolved, alert)
+}
+
+func (nb *notificationsBatch) notifications() []notification {
+	result := make([]notification, 0, len(nb.keys))
+	for _, key := range nb.keys {
+		result = append(result, *nb.groups[key])
+	}
+
+	return result
+}
+
+// createEvent builds the event to be notified out of the alerts which are not escalated
+func createEvent(event data.NotificationMessage, alerts []data.Alert, resolved []data.Alert) data.NotificationMessage {
+	result := data.NotificationMessage{
+		Level:    common.InfoEvent,
+		Alerts:   alerts,
+		Resolved: resolved,
+	}
+	if event.Level < result.Level {
+		result.Level = event.Level
+	}
+
+	lines := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		if !alert.Changed {
+			continue
+		}
+		if alert.Level > result.Level {
+			result.Level = alert.Level
+		}
+		lines = append(lines, fmt.Sprintf("[%s] %s\n", alert.ID, alert.Message))
+	}
+	result.Message = strings.Join(lines, "")
+
+	if len(resolved) > 0 && result.Level < common.InfoEvent {
+		result.Level = common.InfoEvent
+	}
+
+	return result
+}
@@ -0,0 +1,576 @@
+package escalation_test
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/escalation"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "NodeRating"
+
+// alertsProviderStub implements escalation.AlertsProvider
+type alertsProviderStub struct {
+	activeAlertsCalled func() []alerts.ActiveAlert
+	isMutedCalled      func(alert data.Alert) bool
+}
+
+func (aps *alertsProviderStub) ActiveAlerts() []alerts.ActiveAlert {
+	if aps.activeAlertsCalled != nil {
+		return aps.activeAlertsCalled()
+	}
+
+	return nil
+}
+
+func (aps *alertsProviderStub) IsMuted(alert data.Alert) bool {
+	if aps.isMutedCalled != nil {
+		return aps.isMutedCalled(alert)
+	}
+
+	return false
+}
+
+func (aps *alertsProviderStub) IsInterfaceNil() bool {
+	return aps == nil
+}
+
+type sentReport struct {
+	notifiers []string
+	msg       data.NotificationMessage
+}
+
+// reportsRecorder records the alerts sent through a mocks.AlertsSenderStub
+type reportsRecorder struct {
+	mut     sync.Mutex
+	reports []sentReport
+}
+
+func (rr *reportsRecorder) sender() *mocks.AlertsSenderStub {
+	return &mocks.AlertsSenderStub{
+		SendAlertsCalled: func(notifierNames []string, msg data.NotificationMessage) error {
+			rr.mut.Lock()
+			rr.reports = append(rr.reports, sentReport{notifiers: notifierNames, msg: msg})
+			rr.mut.Unlock()
+			return nil
+		},
+	}
+}
+
+func (rr *reportsRecorder) take() []sentReport {
+	rr.mut.Lock()
+	defer rr.mut.Unlock()
+
+	reports := rr.reports
+	rr.reports = nil
+
+	return reports
+}
+
+func createEscalationConfig() *config.Escalation {
+	return &config.Escalation{
+		Enabled: true,
+		Policies: []config.EscalationPolicy{
+			{
+				Name:  "critical",
+				Match: config.AlertSelector{Levels: []string{"critical"}},
+				Steps: []config.EscalationStep{
+					{DelaySec: 0, Notifiers: []string{"Slack"}},
+					{DelaySec: 900, Notifiers: []string{"Telegram"}},
+					{DelaySec: 1800, Notifiers: []string{"Email", "Slack"}},
+				},
+			},
+		},
+	}
+}
+
+func createAlert(name string, level common.EventLevel) data.Alert {
+	return data.Alert{
+		ID:        data.NewAlertID(testClientID, name, "tempRatingThreshold"),
+		ClientID:  testClientID,
+		Condition: "tempRatingThreshold",
+		Level:     level,
+		Message:   name + " temp rating below threshold",
+		Changed:   true,
+		PubKey:    name,
+		NodeName:  name,
+	}
+}
+
+func createEvent(alerts []data.Alert, resolved []data.Alert) data.NotificationMessage {
+	event := data.NotificationMessage{
+		Level:    common.InfoEvent,
+		Alerts:   alerts,
+		Resolved: resolved,
+	}
+	for _, alert := range alerts {
+		if alert.Level > event.Level {
+			event.Level = alert.Level
+		}
+		event.Message += "[" + alert.ID + "] " + alert.Message + "\n"
+	}
+
+	return event
+}
+
+type escalatorHandler interface {
+	ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage
+	SetConfig(cfg *config.Escalation) error
+	Escalations() []escalation.ActiveEscalation
+	SetGetTimeHandler(handler func() time.Time)
+	Check()
+}
+
+func createMockArgs() escalation.ArgsEscalator {
+	return escalation.ArgsEscalator{
+		Config:         createEscalationConfig(),
+		Storer:         &mocks.StorerStub{},
+		Sender:         &mocks.AlertsSenderStub{},
+		AlertsProvider: &alertsProviderStub{},
+	}
+}
+
+func createEscalator(t *testing.T, args escalation.ArgsEscalator, now *time.Time) escalatorHandler {
+	e, err := escalation.NewEscalator(args)
+	require.Nil(t, err)
+	e.SetGetTimeHandler(func() time.Time {
+		return *now
+	})
+
+	return e
+}
+
+func TestNewEscalator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Storer = nil
+
+		e, err := escalation.NewEscalator(args)
+		assert.Nil(t, e)
+		assert.Equal(t, escalation.ErrNilStorer, err)
+	})
+
+	t.Run("nil sender", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Sender = nil
+
+		e, err := escalation.NewEscalator(args)
+		assert.Nil(t, e)
+		assert.Equal(t, escalation.ErrNilSender, err)
+	})
+
+	t.Run("nil alerts provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AlertsProvider = nil
+
+		e, err := escalation.NewEscalator(args)
+		assert.Nil(t, e)
+		assert.Equal(t, escalation.ErrNilAlertsProvider, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		e, err := escalation.NewEscalator(createMockArgs())
+		require.Nil(t, err)
+		assert.False(t, e.IsInterfaceNil())
+	})
+}
+
+func TestEscalator_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled escalation returns the event as it is", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Config.Enabled = false
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		event := createEvent([]data.Alert{createAlert("node-1", common.CriticalEvent)}, nil)
+		assert.Equal(t, event, e.ProcessEvent(testClientID, event))
+	})
+
+	t.Run("matched alerts are kept out of the event", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, createMockArgs(), &now)
+
+		critical := createAlert("node-1", common.CriticalEvent)
+		warning := createAlert("node-2", common.WarningEvent)
+		result := e.ProcessEvent(testClientID, createEvent([]data.Alert{critical, warning}, nil))
+		require.Len(t, result.Alerts, 2)
+		assert.False(t, result.Alerts[0].Changed)
+		assert.Equal(t, warning, result.Alerts[1])
+		assert.Equal(t, common.WarningEvent, result.Level)
+		assert.Equal(t, "["+warning.ID+"] "+warning.Message+"\n", result.Message)
+
+		escalations := e.Escalations()
+		require.Len(t, escalations, 1)
+		assert.Equal(t, critical, escalations[0].Alert)
+		assert.Equal(t, "critical", escalations[0].Policy)
+		assert.Equal(t, now, escalations[0].StartedAt)
+	})
+}
+
+func TestEscalator_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("steps are notified until the alert resolves", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		args := createMockArgs()
+		args.Sender = recorder.sender()
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		alert1 := createAlert("node-1", common.CriticalEvent)
+		alert2 := createAlert("node-2", common.CriticalEvent)
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert1, alert2}, nil))
+		e.Check()
+		reports := recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Slack"}, reports[0].notifiers)
+		assert.Equal(t, common.CriticalEvent, reports[0].msg.Level)
+		assert.Equal(t, []data.Alert{alert1, alert2}, reports[0].msg.Alerts)
+		assert.Equal(t, "Escalation policy critical, step 1 of 3\n"+
+			"["+alert1.ID+"] "+alert1.Message+"\n"+
+			"["+alert2.ID+"] "+alert2.Message+"\n", reports[0].msg.Message)
+
+		now = now.Add(10 * time.Minute)
+		e.Check()
+		assert.Empty(t, recorder.take())
+
+		now = now.Add(5 * time.Minute)
+		e.Check()
+		reports = recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Telegram"}, reports[0].notifiers)
+		assert.Equal(t, "Escalation policy critical, step 2 of 3, not acknowledged for 15m0s\n"+
+			"["+alert1.ID+"] "+alert1.Message+"\n"+
+			"["+alert2.ID+"] "+alert2.Message+"\n", reports[0].msg.Message)
+
+		// the resolve is sent to the notifiers reached so far
+		e.ProcessEvent(testClientID, createEvent(nil, []data.Alert{alert1}))
+		e.Check()
+		reports = recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Slack", "Telegram"}, reports[0].notifiers)
+		assert.Equal(t, []data.Alert{alert1}, reports[0].msg.Resolved)
+		assert.Equal(t, common.InfoEvent, reports[0].msg.Level)
+
+		now = now.Add(15 * time.Minute)
+		e.Check()
+		reports = recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Email", "Slack"}, reports[0].notifiers)
+		assert.Equal(t, []data.Alert{alert2}, reports[0].msg.Alerts)
+
+		// no more steps, the alert is kept until it resolves
+		now = now.Add(time.Hour)
+		e.Check()
+		assert.Empty(t, recorder.take())
+		require.Len(t, e.Escalations(), 1)
+		assert.Equal(t, []string{"Slack", "Telegram", "Email"}, e.Escalations()[0].Notifiers)
+	})
+
+	t.Run("acknowledged alert stops escalating", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		args := createMockArgs()
+		args.Sender = recorder.sender()
+		alert := createAlert("node-1", common.CriticalEvent)
+		acknowledged := false
+		args.AlertsProvider = &alertsProviderStub{
+			activeAlertsCalled: func() []alerts.ActiveAlert {
+				return []alerts.ActiveAlert{{Alert: alert, Acknowledged: acknowledged, AcknowledgedBy: "operator"}}
+			},
+		}
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+		e.Check()
+		require.Len(t, recorder.take(), 1)
+
+		acknowledged = true
+		now = now.Add(time.Hour)
+		e.Check()
+		assert.Empty(t, recorder.take())
+		assert.Empty(t, e.Escalations())
+
+		// the resolve passes as any other alert
+		result := e.ProcessEvent(testClientID, createEvent(nil, []data.Alert{alert}))
+		assert.Equal(t, []data.Alert{alert}, result.Resolved)
+	})
+
+	t.Run("resolve of a one-shot alert stops escalating without being sent", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		args := createMockArgs()
+		args.Sender = recorder.sender()
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		alert := createAlert("node-1", common.CriticalEvent)
+		alert.OneShot = true
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+		e.Check()
+		require.Len(t, recorder.take(), 1)
+
+		e.ProcessEvent(testClientID, createEvent(nil, []data.Alert{alert}))
+		e.Check()
+		assert.Empty(t, recorder.take())
+		assert.Empty(t, e.Escalations())
+	})
+
+	t.Run("changes of the escalated alerts are sent to the reached notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		args := createMockArgs()
+		args.Sender = recorder.sender()
+		args.Config.Policies[0].Steps[0].DelaySec = 60
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		alert := createAlert("node-1", common.CriticalEvent)
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+
+		// not notified by any step yet
+		alert.Message = "node-1 temp rating dropped again"
+		result := e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+		assert.Empty(t, result.Message)
+		e.Check()
+		assert.Empty(t, recorder.take())
+
+		now = now.Add(time.Minute)
+		e.Check()
+		require.Len(t, recorder.take(), 1)
+
+		alert.Message = "node-1 temp rating dropped once more"
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+		e.Check()
+		reports := recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Slack"}, reports[0].notifiers)
+		assert.Equal(t, "["+alert.ID+"] node-1 temp rating dropped once more\n", reports[0].msg.Message)
+	})
+}
+
+func TestEscalator_CheckMutedAlerts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("updates queued before the alert was acknowledged are not sent", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		args := createMockArgs()
+		args.Sender = recorder.sender()
+		alert := createAlert("node-1", common.CriticalEvent)
+		acknowledged := false
+		args.AlertsProvider = &alertsProviderStub{
+			activeAlertsCalled: func() []alerts.ActiveAlert {
+				return []alerts.ActiveAlert{{Alert: alert, Acknowledged: acknowledged, AcknowledgedBy: "operator"}}
+			},
+			isMutedCalled: func(alert data.Alert) bool {
+				return acknowledged
+			},
+		}
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+		e.Check()
+		require.Len(t, recorder.take(), 1)
+
+		changed := alert
+		changed.Message = "node-1 temp rating dropped again"
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{changed}, nil))
+		acknowledged = true
+		e.Check()
+		assert.Empty(t, recorder.take())
+		assert.Empty(t, e.Escalations())
+	})
+
+	t.Run("steps of the silenced alerts wait until the silence expires", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		args := createMockArgs()
+		args.Sender = recorder.sender()
+		alert1 := createAlert("node-1", common.CriticalEvent)
+		alert2 := createAlert("node-2", common.CriticalEvent)
+		silenced := true
+		args.AlertsProvider = &alertsProviderStub{
+			isMutedCalled: func(alert data.Alert) bool {
+				return silenced && alert.PubKey == "node-2"
+			},
+		}
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert1, alert2}, nil))
+		e.Check()
+		reports := recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []data.Alert{alert1}, reports[0].msg.Alerts)
+		assert.Equal(t, "Escalation policy critical, step 1 of 3\n"+
+			"["+alert1.ID+"] "+alert1.Message+"\n", reports[0].msg.Message)
+
+		// the update of a silenced alert is removed from the queued notification
+		changed1 := alert1
+		changed1.Message = "node-1 temp rating dropped again"
+		changed2 := alert2
+		changed2.Message = "node-2 temp rating dropped again"
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{changed1, changed2}, nil))
+		e.Check()
+		reports = recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []data.Alert{changed1}, reports[0].msg.Alerts)
+		assert.Equal(t, "["+alert1.ID+"] node-1 temp rating dropped again\n", reports[0].msg.Message)
+
+		silenced = false
+		e.Check()
+		reports = recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Slack"}, reports[0].notifiers)
+		assert.Equal(t, []data.Alert{changed2}, reports[0].msg.Alerts)
+	})
+}
+
+func TestEscalator_CheckSendFailures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed step is sent again on the next check", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &reportsRecorder{}
+		recordingSender := recorder.sender()
+		sendErr := errors.New("rate limited")
+		numCalls := 0
+		args := createMockArgs()
+		args.Sender = &mocks.AlertsSenderStub{
+			SendAlertsCalled: func(notifierNames []string, msg data.NotificationMessage) error {
+				numCalls++
+				if numCalls == 1 {
+					return sendErr
+				}
+				return recordingSender.SendAlerts(notifierNames, msg)
+			},
+		}
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		alert := createAlert("node-1", common.CriticalEvent)
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+		e.Check()
+		assert.Empty(t, recorder.take())
+
+		e.Check()
+		reports := recorder.take()
+		require.Len(t, reports, 1)
+		assert.Equal(t, []string{"Slack"}, reports[0].notifiers)
+		assert.Equal(t, []data.Alert{alert}, reports[0].msg.Alerts)
+
+		e.Check()
+		assert.Empty(t, recorder.take())
+	})
+
+	t.Run("notification is dropped after the maximum attempts", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockArgs()
+		args.Sender = &mocks.AlertsSenderStub{
+			SendAlertsCalled: func(notifierNames []string, msg data.NotificationMessage) error {
+				numCalls++
+				return errors.New("notifier not enabled")
+			},
+		}
+		now := time.Unix(1700000000, 0)
+		e := createEscalator(t, args, &now)
+
+		e.ProcessEvent(testClientID, createEvent([]data.Alert{createAlert("node-1", common.CriticalEvent)}, nil))
+		for i := 0; i < escalation.MaxSendAttempts+2; i++ {
+			e.Check()
+		}
+		assert.Equal(t, escalation.MaxSendAttempts, numCalls)
+	})
+}
+
+func TestEscalator_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	e := createEscalator(t, createMockArgs(), &now)
+	alert := createAlert("node-1", common.CriticalEvent)
+	e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+	require.Len(t, e.Escalations(), 1)
+
+	cfg := createEscalationConfig()
+	cfg.Policies[0].Match.Levels = []string{"fatal"}
+	assert.NotNil(t, e.SetConfig(cfg))
+
+	require.Nil(t, e.SetConfig(nil))
+	e.Check()
+	assert.Empty(t, e.Escalations())
+
+	result := e.ProcessEvent(testClientID, createEvent(nil, []data.Alert{alert}))
+	assert.Equal(t, []data.Alert{alert}, result.Resolved)
+}
+
+func TestEscalator_Persistence(t *testing.T) {
+	t.Parallel()
+
+	storer, err := storage.NewJSONFileStorer(filepath.Join(t.TempDir(), "state.json"))
+	require.Nil(t, err)
+
+	recorder := &reportsRecorder{}
+	args := createMockArgs()
+	args.Storer = storer
+	args.Sender = recorder.sender()
+	now := time.Unix(1700000000, 0)
+	e := createEscalator(t, args, &now)
+	alert := createAlert("node-1", common.CriticalEvent)
+	e.ProcessEvent(testClientID, createEvent([]data.Alert{alert}, nil))
+	e.Check()
+	require.Len(t, recorder.take(), 1)
+
+	reloaded := createEscalator(t, args, &now)
+	escalations := reloaded.Escalations()
+	require.Len(t, escalations, 1)
+	assert.Equal(t, 1, escalations[0].NextStep)
+	assert.True(t, now.Equal(escalations[0].StartedAt))
+
+	// the escalation continues from the step reached before the restart
+	now = now.Add(15 * time.Minute)
+	reloaded.Check()
+	reports := recorder.take()
+	require.Len(t, reports, 1)
+	assert.Equal(t, []string{"Telegram"}, reports[0].notifiers)
+}
@@ -0,0 +1,16 @@
+package escalation
+
+import "time"
+
+// SetGetTimeHandler -
+func (e *escalator) SetGetTimeHandler(handler func() time.Time) {
+	e.getTimeHandler = handler
+}
+
+// Check -
+func (e *escalator) Check() {
+	e.check()
+}
+
+// MaxSendAttempts -
+const MaxSendAttempts = maxSendAttempts
@@ -0,0 +1,21 @@
+package escalation
+
+import (
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+// Sender defines the behaviour of a component able to send the alerts through the notifiers with the provided names,
+// with the same throttling and grouping as the alerts sent to all the notifiers
+type Sender interface {
+	SendAlerts(notifierNames []string, msg data.NotificationMessage) error
+	IsInterfaceNil() bool
+}
+
+// AlertsProvider defines the behaviour of a component able to provide the active alerts and their acknowledgements,
+// and to tell whether the notifications of an alert are muted, as the ones of the silenced nodes are
+type AlertsProvider interface {
+	ActiveAlerts() []alerts.ActiveAlert
+	IsMuted(alert data.Alert) bool
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,8 @@
+package hysteresis
+
+import "time"
+
+// SetGetTimeHandler -
+func (h *hysteresis) SetGetTimeHandler(handler func() time.Time) {
+	h.getTimeHandler = handler
+}
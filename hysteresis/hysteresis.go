@@ -0,0 +1,353 @@
+package hysteresis
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+var log = logger.GetOrCreate("hysteresis")
+
+const storageKey = "Hysteresis"
+
+// ArgsHysteresis defines the arguments needed to create a new hysteresis component
+type ArgsHysteresis struct {
+	Config *config.Hysteresis
+	Storer clients.Storer
+}
+
+// AlertState holds the recent evaluations of an alert condition and its confirmed state
+type AlertState struct {
+	// Alert is the alert raised by the last evaluation in which the condition held
+	Alert data.Alert
+	// Evaluations holds whether the condition held in the recent evaluations, the newest last
+	Evaluations []bool
+	Firing      bool
+	Flapping    bool
+	// Changes holds the times of the confirmed state changes within the flapping window
+	Changes []time.Time
+}
+
+type hysteresis struct {
+	config         *config.Hysteresis
+	states         map[string]*AlertState
+	storer         clients.Storer
+	mut            sync.Mutex
+	getTimeHandler func() time.Time
+}
+
+// NewHysteresis will create a component which confirms the alerts state changes: a condition should hold in N of
+// the last M evaluations before its alert fires and it should be clear in N of the last M evaluations before its
+// alert resolves. An alert changing its state too often is notified once as flapping and kept firing until it
+// settles, instead of being notified at every change
+func NewHysteresis(args ArgsHysteresis) (*hysteresis, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	h := &hysteresis{
+		config:         enabledConfig(args.Config),
+		states:         make(map[string]*AlertState),
+		storer:         args.Storer,
+		getTimeHandler: time.Now,
+	}
+	if h.config != nil {
+		h.load()
+	}
+
+	return h, nil
+}
+
+func enabledConfig(cfg *config.Hysteresis) *config.Hysteresis {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	return cfg
+}
+
+func (h *hysteresis) load() {
+	states := make(map[string]*AlertState)
+	err := h.storer.Get(storageKey, &states)
+	if err != nil {
+		log.Debug("no stored hysteresis state will be used", "reason", err.Error())
+		return
+	}
+
+	h.states = states
+	log.Info("loaded stored hysteresis state", "num alerts", len(h.states))
+}
+
+// SetConfig will apply the new hysteresis rules. The evaluations already recorded are kept, so that the alerts
+// are not notified again; they are dropped if the hysteresis is disabled
+func (h *hysteresis) SetConfig(cfg *config.Hysteresis) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	h.config = enabledConfig(cfg)
+	if h.config == nil {
+		h.states = make(map[string]*AlertState)
+		h.save()
+	}
+}
+
+// ProcessEvent will record an evaluation for every alert of the client and will return the event holding the
+// confirmed alerts only. The confirmed alerts which are pending to resolve are kept as unchanged, so that they
+// are not resolved, while the alerts pending to fire are removed
+func (h *hysteresis) ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	if h.config == nil {
+		return event
+	}
+
+	now := h.getTimeHandler()
+	evaluated := make(map[string]struct{}, len(event.Alerts))
+	alerts := make([]data.Alert, 0, len(event.Alerts))
+	for _, alert := range event.Alerts {
+		evaluated[alert.ID] = struct{}{}
+
+		state, ok := h.states[alert.ID]
+		if !ok {
+			state = h.newState(alert)
+			h.states[alert.ID] = state
+		}
+
+		confirmed, ok := h.evaluate(state, true, alert, now)
+		if ok {
+			alerts = append(alerts, confirmed)
+		}
+	}
+
+	// the alerts of the client which are missing from the event are clear in this evaluation
+	for _, id := range h.sortedIDs() {
+		state := h.states[id]
+		if _, ok := evaluated[id]; ok || state.Alert.ClientID != clientID {
+			continue
+		}
+
+		confirmed, ok := h.evaluate(state, false, state.Alert, now)
+		if ok {
+			alerts = append(alerts, confirmed)
+		}
+		if h.isIdle(state) {
+			delete(h.states, id)
+		}
+	}
+
+	h.save()
+
+	return createEvent(alerts)
+}
+
+// newState creates the state of an alert seen for the first time. An alert which is not changed has already been
+// notified, as the client raised it before, so it is considered confirmed
+func (h *hysteresis) newState(alert data.Alert) *AlertState {
+	state := &AlertState{
+		Alert: alert,
+	}
+	if !alert.Changed {
+		rule := h.rule(alert.Condition)
+		state.Firing = true
+		state.Evaluations = make([]bool, rule.FireM)
+		for i := range state.Evaluations {
+			state.Evaluations[i] = true
+		}
+	}
+
+	return state
+}
+
+// evaluate records the evaluation, confirms the state changes and returns the alert to be passed on, if any
+func (h *hysteresis) evaluate(state *AlertState, holds bool, alert data.Alert, now time.Time) (data.Alert, bool) {
+	rule := h.rule(state.Alert.Condition)
+	state.Evaluations = append(state.Evaluations, holds)
+	maxEvaluations := rule.FireM
+	if rule.ResolveM > maxEvaluations {
+		maxEvaluations = rule.ResolveM
+	}
+	if len(state.Evaluations) > maxEvaluations {
+		state.Evaluations = state.Evaluations[len(state.Evaluations)-maxEvaluations:]
+	}
+	if holds {
+		state.Alert = alert
+	}
+
+	changed := false
+	switch {
+	case !state.Firing && count(state.Evaluations, rule.FireM, true) >= rule.FireN:
+		state.Firing = true
+		changed = true
+	case state.Firing && count(state.Evaluations, rule.ResolveM, false) >= rule.ResolveN:
+		state.Firing = false
+		changed = true
+	}
+	if h.config.FlappingMaxChanges > 0 {
+		if changed {
+			state.Changes = append(state.Changes, now)
+		}
+		flappingAlert, ok, handled := h.checkFlapping(state, now)
+		if handled {
+			return flappingAlert, ok
+		}
+	}
+
+	if !state.Firing {
+		return data.Alert{}, false
+	}
+
+	confirmed := state.Alert
+	switch {
+	case changed:
+		confirmed.Changed = true
+	case !holds:
+		// pending to resolve
+		confirmed.Changed = false
+	}
+
+	return confirmed, true
+}
+
+// checkFlapping marks the alert as flapping once it changed its state too often within the window and clears the
+// mark after a window without changes. It returns true as the last value if the flapping state decided the result
+func (h *hysteresis) checkFlapping(state *AlertState, now time.Time) (data.Alert, bool, bool) {
+	window := time.Duration(h.config.FlappingWindowSec) * time.Second
+	recent := make([]time.Time, 0, len(state.Changes))
+	for _, changeTime := range state.Changes {
+		if now.Sub(changeTime) < window {
+			recent = append(recent, changeTime)
+		}
+	}
+	state.Changes = recent
+
+	switch {
+	case !state.Flapping && len(state.Changes) >= h.config.FlappingMaxChanges:
+		state.Flapping = true
+		log.Info("alert is flapping", "alert", state.Alert.ID, "node", state.Alert.DisplayName(), "num changes", len(state.Changes))
+		return h.flappingAlert(state, true), true, true
+	case state.Flapping && len(state.Changes) == 0:
+		state.Flapping = false
+		log.Info("alert is no longer flapping", "alert", state.Alert.ID, "node", state.Alert.DisplayName(), "firing", state.Firing)
+		if !state.Firing {
+			return data.Alert{}, false, true
+		}
+
+		settled := state.Alert
+		settled.Changed = true
+		return settled, true, true
+	case state.Flapping:
+		return h.flappingAlert(state, false), true, true
+	default:
+		return data.Alert{}, false, false
+	}
+}
+
+func (h *hysteresis) flappingAlert(state *AlertState, changed bool) data.Alert {
+	alert := state.Alert
+	alert.Changed = changed
+	alert.Flapping = true
+	alert.Message = fmt.Sprintf("%s (flapping: changed state %d times in the last %s)",
+		alert.Message, len(state.Changes), time.Duration(h.config.FlappingWindowSec)*time.Second)
+
+	return alert
+}
+
+func (h *hysteresis) rule(condition string) config.HysteresisRule {
+	rule, ok := h.config.Conditions[condition]
+	if ok {
+		return rule
+	}
+
+	return h.config.Default
+}
+
+// isIdle returns true if the alert state holds no information which might change its next evaluations
+func (h *hysteresis) isIdle(state *AlertState) bool {
+	if state.Firing || state.Flapping || len(state.Changes) > 0 {
+		return false
+	}
+
+	// the oldest evaluation drops out of the window with the next evaluation
+	rule := h.rule(state.Alert.Condition)
+
+	return count(state.Evaluations, rule.FireM-1, true) == 0
+}
+
+// count returns how many of the last evaluations have the provided value
+func count(evaluations []bool, last int, value bool) int {
+	if last > len(evaluations) {
+		last = len(evaluations)
+	}
+
+	counter := 0
+	for _, evaluation := range evaluations[len(evaluations)-last:] {
+		if evaluation == value {
+			counter++
+		}
+	}
+
+	return counter
+}
+
+// AlertsStates returns the states of the alerts which are evaluated
+func (h *hysteresis) AlertsStates() []AlertState {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+
+	states := make([]AlertState, 0, len(h.states))
+	for _, id := range h.sortedIDs() {
+		states = append(states, *h.states[id])
+	}
+
+	return states
+}
+
+func (h *hysteresis) sortedIDs() []string {
+	ids := make([]string, 0, len(h.states))
+	for id := range h.states {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (h *hysteresis) save() {
+	err := h.storer.Put(storageKey, h.states)
+	if err != nil {
+		log.Warn("failed to save the hysteresis state", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *hysteresis) IsInterfaceNil() bool {
+	return h == nil
+}
+
+// createEvent builds the event holding the confirmed alerts, as the client would have raised it
+func createEvent(alerts []data.Alert) data.NotificationMessage {
+	result := data.NotificationMessage{
+		Level:  common.NoEvent,
+		Alerts: alerts,
+	}
+	for _, alert := range alerts {
+		if !alert.Changed {
+			continue
+		}
+		if alert.Level > result.Level {
+			result.Level = alert.Level
+		}
+		result.Message += alert.Message + "\n"
+	}
+
+	return result
+}
@@ -0,0 +1,244 @@
+package hysteresis_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/hysteresis"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "NodeRating"
+
+func createHysteresisConfig() *config.Hysteresis {
+	return &config.Hysteresis{
+		Enabled: true,
+		Default: config.HysteresisRule{FireN: 2, FireM: 3, ResolveN: 2, ResolveM: 2},
+		Conditions: map[string]config.HysteresisRule{
+			"ratingDecrease": {FireN: 1, FireM: 1, ResolveN: 1, ResolveM: 1},
+		},
+	}
+}
+
+func createAlert(name string, condition string, changed bool) data.Alert {
+	return data.Alert{
+		ID:        data.NewAlertID(testClientID, name, condition),
+		ClientID:  testClientID,
+		Condition: condition,
+		Level:     common.WarningEvent,
+		Message:   name + " " + condition,
+		Changed:   changed,
+		PubKey:    name,
+		NodeName:  name,
+	}
+}
+
+func createEvent(alerts ...data.Alert) data.NotificationMessage {
+	event := data.NotificationMessage{
+		Level:  common.NoEvent,
+		Alerts: alerts,
+	}
+	for _, alert := range alerts {
+		if alert.Changed {
+			event.Level = alert.Level
+			event.Message += alert.Message + "\n"
+		}
+	}
+
+	return event
+}
+
+type hysteresisHandler interface {
+	ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage
+	SetConfig(cfg *config.Hysteresis)
+	AlertsStates() []hysteresis.AlertState
+	SetGetTimeHandler(handler func() time.Time)
+}
+
+func createHysteresis(t *testing.T, cfg *config.Hysteresis) hysteresisHandler {
+	h, err := hysteresis.NewHysteresis(hysteresis.ArgsHysteresis{
+		Config: cfg,
+		Storer: &mocks.StorerStub{},
+	})
+	require.Nil(t, err)
+
+	return h
+}
+
+// evaluate processes an event with the provided alerts and returns the ids of the alerts passed on and whether they are changed
+func evaluate(h hysteresisHandler, alerts ...data.Alert) map[string]bool {
+	result := h.ProcessEvent(testClientID, createEvent(alerts...))
+	passed := make(map[string]bool)
+	for _, alert := range result.Alerts {
+		passed[alert.ID] = alert.Changed
+	}
+
+	return passed
+}
+
+func TestNewHysteresis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		h, err := hysteresis.NewHysteresis(hysteresis.ArgsHysteresis{Config: createHysteresisConfig()})
+		assert.Nil(t, h)
+		assert.Equal(t, hysteresis.ErrNilStorer, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		h, err := hysteresis.NewHysteresis(hysteresis.ArgsHysteresis{
+			Config: createHysteresisConfig(),
+			Storer: &mocks.StorerStub{},
+		})
+		require.Nil(t, err)
+		assert.False(t, h.IsInterfaceNil())
+	})
+}
+
+func TestHysteresis_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled hysteresis returns the event as it is", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createHysteresisConfig()
+		cfg.Enabled = false
+		h := createHysteresis(t, cfg)
+
+		event := createEvent(createAlert("node-1", "tempRatingThreshold", true))
+		assert.Equal(t, event, h.ProcessEvent(testClientID, event))
+	})
+
+	t.Run("alert fires in N of M evaluations and resolves in N of M", func(t *testing.T) {
+		t.Parallel()
+
+		h := createHysteresis(t, createHysteresisConfig())
+		firing := createAlert("node-1", "tempRatingThreshold", true)
+		unchanged := createAlert("node-1", "tempRatingThreshold", false)
+
+		// pending to fire
+		assert.Empty(t, evaluate(h, firing))
+		assert.Empty(t, evaluate(h))
+		// 2 of the last 3 evaluations
+		assert.Equal(t, map[string]bool{firing.ID: true}, evaluate(h, unchanged))
+		assert.Equal(t, map[string]bool{firing.ID: false}, evaluate(h, unchanged))
+
+		// pending to resolve, kept unchanged so it is not resolved
+		assert.Equal(t, map[string]bool{firing.ID: false}, evaluate(h))
+		assert.Equal(t, map[string]bool{firing.ID: false}, evaluate(h, unchanged))
+		assert.Equal(t, map[string]bool{firing.ID: false}, evaluate(h))
+		// clear in 2 of the last 2 evaluations
+		assert.Empty(t, evaluate(h))
+		assert.Empty(t, h.AlertsStates())
+	})
+
+	t.Run("changed alerts are passed as they are once confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		h := createHysteresis(t, createHysteresisConfig())
+		decrease := createAlert("node-1", "ratingDecrease", true)
+		threshold := createAlert("node-2", "tempRatingThreshold", false)
+
+		// an unchanged alert seen for the first time has already been notified
+		result := h.ProcessEvent(testClientID, createEvent(decrease, threshold))
+		assert.Equal(t, []data.Alert{decrease, threshold}, result.Alerts)
+		assert.Equal(t, common.WarningEvent, result.Level)
+		assert.Equal(t, "node-1 ratingDecrease\n", result.Message)
+
+		threshold.Changed = true
+		threshold.Level = common.CriticalEvent
+		result = h.ProcessEvent(testClientID, createEvent(threshold))
+		assert.Equal(t, []data.Alert{threshold}, result.Alerts)
+		assert.Equal(t, common.CriticalEvent, result.Level)
+	})
+
+	t.Run("flapping alert is notified once", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createHysteresisConfig()
+		cfg.Default = config.HysteresisRule{FireN: 1, FireM: 1, ResolveN: 1, ResolveM: 1}
+		cfg.FlappingWindowSec = 600
+		cfg.FlappingMaxChanges = 4
+		h := createHysteresis(t, cfg)
+		now := time.Unix(1700000000, 0)
+		h.SetGetTimeHandler(func() time.Time {
+			return now
+		})
+
+		alert := createAlert("node-1", "online", true)
+		assert.Equal(t, map[string]bool{alert.ID: true}, evaluate(h, alert))
+		assert.Empty(t, evaluate(h))
+		assert.Equal(t, map[string]bool{alert.ID: true}, evaluate(h, alert))
+
+		// the 4th change marks the alert as flapping and keeps it firing
+		result := h.ProcessEvent(testClientID, createEvent())
+		require.Len(t, result.Alerts, 1)
+		assert.True(t, result.Alerts[0].Flapping)
+		assert.True(t, result.Alerts[0].Changed)
+		assert.Equal(t, "node-1 online (flapping: changed state 4 times in the last 10m0s)", result.Alerts[0].Message)
+		assert.Equal(t, common.WarningEvent, result.Level)
+
+		// no more notifications while flapping
+		for i := 0; i < 3; i++ {
+			now = now.Add(time.Minute)
+			assert.Equal(t, map[string]bool{alert.ID: false}, evaluate(h, alert))
+			assert.Equal(t, map[string]bool{alert.ID: false}, evaluate(h))
+		}
+
+		// settled after a window without changes, on the resolved state
+		now = now.Add(10 * time.Minute)
+		assert.Empty(t, evaluate(h))
+		assert.Empty(t, h.AlertsStates())
+	})
+}
+
+func TestHysteresis_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	h := createHysteresis(t, createHysteresisConfig())
+	alert := createAlert("node-1", "tempRatingThreshold", true)
+	evaluate(h, alert)
+	require.Len(t, h.AlertsStates(), 1)
+
+	h.SetConfig(nil)
+	assert.Empty(t, h.AlertsStates())
+	assert.Equal(t, map[string]bool{alert.ID: true}, evaluate(h, alert))
+}
+
+func TestHysteresis_Persistence(t *testing.T) {
+	t.Parallel()
+
+	storer, err := storage.NewJSONFileStorer(filepath.Join(t.TempDir(), "state.json"))
+	require.Nil(t, err)
+
+	args := hysteresis.ArgsHysteresis{
+		Config: createHysteresisConfig(),
+		Storer: storer,
+	}
+	h, _ := hysteresis.NewHysteresis(args)
+	alert := createAlert("node-1", "tempRatingThreshold", true)
+	h.ProcessEvent(testClientID, createEvent(alert))
+
+	reloaded, err := hysteresis.NewHysteresis(args)
+	require.Nil(t, err)
+	states := reloaded.AlertsStates()
+	require.Len(t, states, 1)
+	assert.Equal(t, []bool{true}, states[0].Evaluations)
+
+	// the evaluation before the restart counts
+	alert.Changed = false
+	result := reloaded.ProcessEvent(testClientID, createEvent(alert))
+	require.Len(t, result.Alerts, 1)
+	assert.True(t, result.Alerts[0].Changed)
+}
@@ -0,0 +1,347 @@
+package inhibition
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+var log = logger.GetOrCreate("inhibition")
+
+const storageKey = "Inhibition"
+
+// ArgsInhibitor defines the arguments needed to create a new inhibitor
+type ArgsInhibitor struct {
+	Config *config.Inhibition
+	Storer clients.Storer
+}
+
+// InhibitedAlert holds a firing alert which is not notified because of another firing alert
+type InhibitedAlert struct {
+	Alert data.Alert
+	// Notified is set if the alert had been notified before being inhibited, so its resolve is still notified
+	Notified bool
+}
+
+// persistedState is the inhibition state kept in the state file
+type persistedState struct {
+	Firing    []data.Alert
+	Inhibited []InhibitedAlert
+}
+
+type rule struct {
+	name   string
+	source data.AlertSelector
+	target data.AlertSelector
+	equal  []string
+}
+
+type inhibitor struct {
+	rules     []rule
+	firing    map[string]data.Alert
+	inhibited map[string]InhibitedAlert
+	storer    clients.Storer
+	mut       sync.Mutex
+}
+
+// NewInhibitor will create a component which suppresses the alerts matching the target selector of a rule while
+// an alert matching its source selector is firing. The suppressed alerts are notified once they are no longer
+// inhibited, if they are still firing
+func NewInhibitor(args ArgsInhibitor) (*inhibitor, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	rules, err := createRules(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &inhibitor{
+		rules:     rules,
+		firing:    make(map[string]data.Alert),
+		inhibited: make(map[string]InhibitedAlert),
+		storer:    args.Storer,
+	}
+	i.load()
+
+	return i, nil
+}
+
+func createRules(cfg *config.Inhibition) ([]rule, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+
+	rules := make([]rule, 0, len(cfg.Rules))
+	for _, ruleCfg := range cfg.Rules {
+		source, err := createSelector(ruleCfg.Source)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s source: %w", ruleCfg.Name, err)
+		}
+		target, err := createSelector(ruleCfg.Target)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s target: %w", ruleCfg.Name, err)
+		}
+
+		rules = append(rules, rule{
+			name:   ruleCfg.Name,
+			source: source,
+			target: target,
+			equal:  ruleCfg.Equal,
+		})
+	}
+
+	return rules, nil
+}
+
+func createSelector(cfg config.AlertSelector) (data.AlertSelector, error) {
+	return data.NewAlertSelector(cfg.ClientIDs, cfg.Conditions, cfg.Levels, cfg.Labels)
+}
+
+func (i *inhibitor) load() {
+	state := persistedState{}
+	err := i.storer.Get(storageKey, &state)
+	if err != nil {
+		log.Debug("no stored inhibition state will be used", "reason", err.Error())
+		return
+	}
+
+	for _, alert := range state.Firing {
+		i.firing[alert.ID] = alert
+	}
+	for _, inhibitedAlert := range state.Inhibited {
+		i.inhibited[inhibitedAlert.Alert.ID] = inhibitedAlert
+	}
+
+	log.Info("loaded stored inhibition state", "num firing alerts", len(i.firing), "num inhibited alerts", len(i.inhibited))
+}
+
+// SetConfig will apply the new inhibition rules. The alerts which are no longer inhibited are notified with the
+// next event, while the alerts already notified are not affected by the new rules until they change
+func (i *inhibitor) SetConfig(cfg *config.Inhibition) error {
+	rules, err := createRules(cfg)
+	if err != nil {
+		return err
+	}
+
+	i.mut.Lock()
+	i.rules = rules
+	i.mut.Unlock()
+
+	return nil
+}
+
+// ProcessEvent will remove from the event the alerts inhibited by other firing alerts, together with the resolves
+// of the inhibited alerts which have never been notified, and will add the alerts which are no longer inhibited
+func (i *inhibitor) ProcessEvent(_ string, event data.NotificationMessage) data.NotificationMessage {
+	i.mut.Lock()
+	defer i.mut.Unlock()
+
+	if len(event.Alerts) == 0 && len(event.Resolved) == 0 && len(i.inhibited) == 0 {
+		return event
+	}
+
+	// the alerts which were firing and were not inhibited have already been notified
+	notified := make(map[string]bool, len(event.Alerts))
+	for _, alert := range event.Alerts {
+		_, wasFiring := i.firing[alert.ID]
+		_, wasInhibited := i.inhibited[alert.ID]
+		notified[alert.ID] = wasFiring && !wasInhibited
+		i.firing[alert.ID] = alert
+	}
+	for _, alert := range event.Resolved {
+		delete(i.firing, alert.ID)
+	}
+
+	modified := false
+	alerts := make([]data.Alert, 0, len(event.Alerts))
+	for _, alert := range event.Alerts {
+		ruleName, inhibited := i.inhibitedBy(alert)
+		if !inhibited {
+			if _, wasInhibited := i.inhibited[alert.ID]; wasInhibited {
+				// released while still firing, so it is notified even if it did not change meanwhile
+				delete(i.inhibited, alert.ID)
+				alert.Changed = true
+				modified = true
+			}
+			alerts = append(alerts, alert)
+			continue
+		}
+
+		previous := i.inhibited[alert.ID]
+		i.inhibited[alert.ID] = InhibitedAlert{
+			Alert:    alert,
+			Notified: previous.Notified || notified[alert.ID],
+		}
+		log.Debug("alert inhibited", "alert", alert.ID, "node", alert.DisplayName(), "rule", ruleName)
+		modified = true
+	}
+
+	resolved := make([]data.Alert, 0, len(event.Resolved))
+	for _, alert := range event.Resolved {
+		inhibitedAlert, ok := i.inhibited[alert.ID]
+		if !ok {
+			resolved = append(resolved, alert)
+			continue
+		}
+
+		delete(i.inhibited, alert.ID)
+		if inhibitedAlert.Notified {
+			resolved = append(resolved, alert)
+			continue
+		}
+		modified = true
+	}
+
+	released := i.releaseAlerts()
+	if len(released) > 0 {
+		alerts = append(alerts, released...)
+		modified = true
+	}
+
+	i.save()
+
+	if !modified {
+		return event
+	}
+
+	return createEvent(event, alerts, resolved)
+}
+
+// inhibitedBy returns the name of the first rule for which a firing alert inhibits the provided one
+func (i *inhibitor) inhibitedBy(target data.Alert) (string, bool) {
+	for _, r := range i.rules {
+		if !r.target.Matches(target) {
+			continue
+		}
+
+		for _, source := range i.firing {
+			if source.ID == target.ID || !r.source.Matches(source) {
+				continue
+			}
+			if haveEqualLabels(source, target, r.equal) {
+				return r.name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func haveEqualLabels(source data.Alert, target data.Alert, labels []string) bool {
+	for _, label := range labels {
+		if source.Label(label) != target.Label(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// releaseAlerts returns the inhibited alerts which are no longer inhibited, to be notified
+func (i *inhibitor) releaseAlerts() []data.Alert {
+	released := make([]data.Alert, 0)
+	for _, id := range sortedIDs(i.inhibited) {
+		inhibitedAlert := i.inhibited[id]
+		_, stillInhibited := i.inhibitedBy(inhibitedAlert.Alert)
+		if stillInhibited {
+			continue
+		}
+
+		delete(i.inhibited, id)
+		alert := inhibitedAlert.Alert
+		alert.Changed = true
+		released = append(released, alert)
+		log.Info("alert no longer inhibited", "alert", alert.ID, "node", alert.DisplayName())
+	}
+
+	return released
+}
+
+// InhibitedAlerts returns the alerts which are currently inhibited
+func (i *inhibitor) InhibitedAlerts() []InhibitedAlert {
+	i.mut.Lock()
+	defer i.mut.Unlock()
+
+	inhibited := make([]InhibitedAlert, 0, len(i.inhibited))
+	for _, id := range sortedIDs(i.inhibited) {
+		inhibited = append(inhibited, i.inhibited[id])
+	}
+
+	return inhibited
+}
+
+func (i *inhibitor) save() {
+	state := persistedState{
+		Firing:    make([]data.Alert, 0, len(i.firing)),
+		Inhibited: make([]InhibitedAlert, 0, len(i.inhibited)),
+	}
+	for _, alert := range i.firing {
+		state.Firing = append(state.Firing, alert)
+	}
+	sort.Slice(state.Firing, func(a, b int) bool {
+		return state.Firing[a].ID < state.Firing[b].ID
+	})
+	for _, id := range sortedIDs(i.inhibited) {
+		state.Inhibited = append(state.Inhibited, i.inhibited[id])
+	}
+
+	err := i.storer.Put(storageKey, state)
+	if err != nil {
+		log.Warn("failed to save the inhibition state", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *inhibitor) IsInterfaceNil() bool {
+	return i == nil
+}
+
+// createEvent builds the event to be notified out of the alerts which are not inhibited
+func createEvent(event data.NotificationMessage, alerts []data.Alert, resolved []data.Alert) data.NotificationMessage {
+	result := data.NotificationMessage{
+		Level:    common.InfoEvent,
+		Alerts:   alerts,
+		Resolved: resolved,
+	}
+	if event.Level < result.Level {
+		result.Level = event.Level
+	}
+
+	lines := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		if !alert.Changed {
+			continue
+		}
+		if alert.Level > result.Level {
+			result.Level = alert.Level
+		}
+		lines = append(lines, fmt.Sprintf("[%s] %s\n", alert.ID, alert.Message))
+	}
+	result.Message = strings.Join(lines, "")
+
+	if len(resolved) > 0 && result.Level < common.InfoEvent {
+		result.Level = common.InfoEvent
+	}
+
+	return result
+}
+
+func sortedIDs(inhibited map[string]InhibitedAlert) []string {
+	ids := make([]string, 0, len(inhibited))
+	for id := range inhibited {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
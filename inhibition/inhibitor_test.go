@@ -0,0 +1,243 @@
+package inhibition_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/inhibition"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createInhibitionConfig() *config.Inhibition {
+	return &config.Inhibition{
+		Enabled: true,
+		Rules: []config.InhibitionRule{
+			{
+				Name:   "api unreachable",
+				Source: config.AlertSelector{ClientIDs: []string{process.AvailabilityClientID}},
+				Target: config.AlertSelector{ClientIDs: []string{"NodeRating"}},
+			},
+			{
+				Name:   "shard stalled",
+				Source: config.AlertSelector{Conditions: []string{"shardStalled"}},
+				Target: config.AlertSelector{Conditions: []string{"nonceStalled"}},
+				Equal:  []string{data.LabelShard},
+			},
+		},
+	}
+}
+
+func createUnreachableAlert() data.Alert {
+	return data.Alert{
+		ID:        data.NewAlertID(process.AvailabilityClientID, "", "NodeRating"),
+		ClientID:  process.AvailabilityClientID,
+		Condition: "NodeRating",
+		Level:     common.CriticalEvent,
+		Message:   "NodeRating client failed to fetch its data",
+		Changed:   true,
+		NodeName:  "NodeRating",
+	}
+}
+
+func createNodeAlert(name string, clientID string, condition string, shard int) data.Alert {
+	return data.Alert{
+		ID:        data.NewAlertID(clientID, name, condition),
+		ClientID:  clientID,
+		Condition: condition,
+		Level:     common.WarningEvent,
+		Message:   name + " " + condition,
+		Changed:   true,
+		PubKey:    name,
+		NodeName:  name,
+		Shard:     shard,
+	}
+}
+
+func createEvent(alerts []data.Alert, resolved []data.Alert) data.NotificationMessage {
+	event := data.NotificationMessage{
+		Level:    common.InfoEvent,
+		Alerts:   alerts,
+		Resolved: resolved,
+	}
+	for _, alert := range alerts {
+		if alert.Level > event.Level {
+			event.Level = alert.Level
+		}
+		event.Message += "[" + alert.ID + "] " + alert.Message + "\n"
+	}
+
+	return event
+}
+
+type inhibitorHandler interface {
+	ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage
+	SetConfig(cfg *config.Inhibition) error
+	InhibitedAlerts() []inhibition.InhibitedAlert
+}
+
+func createInhibitor(t *testing.T, cfg *config.Inhibition) inhibitorHandler {
+	i, err := inhibition.NewInhibitor(inhibition.ArgsInhibitor{
+		Config: cfg,
+		Storer: &mocks.StorerStub{},
+	})
+	require.Nil(t, err)
+
+	return i
+}
+
+func TestNewInhibitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		i, err := inhibition.NewInhibitor(inhibition.ArgsInhibitor{Config: createInhibitionConfig()})
+		assert.Nil(t, i)
+		assert.Equal(t, inhibition.ErrNilStorer, err)
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createInhibitionConfig()
+		cfg.Rules[0].Target.Levels = []string{"fatal"}
+		i, err := inhibition.NewInhibitor(inhibition.ArgsInhibitor{
+			Config: cfg,
+			Storer: &mocks.StorerStub{},
+		})
+		assert.Nil(t, i)
+		assert.True(t, errors.Is(err, common.ErrInvalidValue))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		i, err := inhibition.NewInhibitor(inhibition.ArgsInhibitor{
+			Config: createInhibitionConfig(),
+			Storer: &mocks.StorerStub{},
+		})
+		require.Nil(t, err)
+		assert.False(t, i.IsInterfaceNil())
+	})
+}
+
+func TestInhibitor_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled inhibition returns the event as it is", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createInhibitionConfig()
+		cfg.Enabled = false
+		i := createInhibitor(t, cfg)
+
+		i.ProcessEvent(process.AvailabilityClientID, createEvent([]data.Alert{createUnreachableAlert()}, nil))
+		event := createEvent([]data.Alert{createNodeAlert("node-1", "NodeRating", "ratingDecrease", 0)}, nil)
+		assert.Equal(t, event, i.ProcessEvent("NodeRating", event))
+	})
+
+	t.Run("alerts are inhibited while the source fires", func(t *testing.T) {
+		t.Parallel()
+
+		i := createInhibitor(t, createInhibitionConfig())
+		unreachable := createUnreachableAlert()
+		notified := createNodeAlert("node-1", "NodeRating", "ratingDecrease", 0)
+		suppressed := createNodeAlert("node-2", "NodeRating", "ratingDecrease", 0)
+		neverNotified := createNodeAlert("node-3", "NodeRating", "ratingDecrease", 0)
+
+		event := createEvent([]data.Alert{notified}, nil)
+		assert.Equal(t, event, i.ProcessEvent("NodeRating", event))
+
+		// the source itself is not inhibited
+		event = createEvent([]data.Alert{unreachable}, nil)
+		assert.Equal(t, event, i.ProcessEvent(process.AvailabilityClientID, event))
+
+		notified.Level = common.CriticalEvent
+		result := i.ProcessEvent("NodeRating", createEvent([]data.Alert{notified, suppressed, neverNotified}, nil))
+		assert.Empty(t, result.Alerts)
+		assert.Empty(t, result.Message)
+		assert.Equal(t, common.InfoEvent, result.Level)
+		require.Len(t, i.InhibitedAlerts(), 3)
+
+		// the resolve of a notified alert passes, the one of an alert never notified is dropped
+		result = i.ProcessEvent("NodeRating", createEvent(nil, []data.Alert{notified, neverNotified}))
+		assert.Equal(t, []data.Alert{notified}, result.Resolved)
+		assert.Equal(t, common.InfoEvent, result.Level)
+
+		// once the source resolves, the alert still firing is notified
+		result = i.ProcessEvent(process.AvailabilityClientID, createEvent(nil, []data.Alert{unreachable}))
+		assert.Equal(t, []data.Alert{unreachable}, result.Resolved)
+		require.Len(t, result.Alerts, 1)
+		assert.Equal(t, suppressed.ID, result.Alerts[0].ID)
+		assert.True(t, result.Alerts[0].Changed)
+		assert.Equal(t, common.WarningEvent, result.Level)
+		assert.Equal(t, "["+suppressed.ID+"] node-2 ratingDecrease\n", result.Message)
+		assert.Empty(t, i.InhibitedAlerts())
+	})
+
+	t.Run("equal labels", func(t *testing.T) {
+		t.Parallel()
+
+		i := createInhibitor(t, createInhibitionConfig())
+		stalled := createNodeAlert("meta", "Network", "shardStalled", 1)
+		i.ProcessEvent("Network", createEvent([]data.Alert{stalled}, nil))
+
+		sameShard := createNodeAlert("node-1", "Nonce", "nonceStalled", 1)
+		otherShard := createNodeAlert("node-2", "Nonce", "nonceStalled", 2)
+		result := i.ProcessEvent("Nonce", createEvent([]data.Alert{sameShard, otherShard}, nil))
+		assert.Equal(t, []data.Alert{otherShard}, result.Alerts)
+		require.Len(t, i.InhibitedAlerts(), 1)
+		assert.Equal(t, sameShard, i.InhibitedAlerts()[0].Alert)
+	})
+}
+
+func TestInhibitor_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	i := createInhibitor(t, createInhibitionConfig())
+	i.ProcessEvent(process.AvailabilityClientID, createEvent([]data.Alert{createUnreachableAlert()}, nil))
+	alert := createNodeAlert("node-1", "NodeRating", "ratingDecrease", 0)
+	result := i.ProcessEvent("NodeRating", createEvent([]data.Alert{alert}, nil))
+	assert.Empty(t, result.Alerts)
+
+	cfg := createInhibitionConfig()
+	cfg.Rules[0].Source.Levels = []string{"fatal"}
+	assert.True(t, errors.Is(i.SetConfig(cfg), common.ErrInvalidValue))
+
+	require.Nil(t, i.SetConfig(nil))
+	result = i.ProcessEvent("NodeRating", data.NotificationMessage{Level: common.NoEvent})
+	assert.Equal(t, []data.Alert{alert}, result.Alerts)
+}
+
+func TestInhibitor_Persistence(t *testing.T) {
+	t.Parallel()
+
+	storer, err := storage.NewJSONFileStorer(filepath.Join(t.TempDir(), "state.json"))
+	require.Nil(t, err)
+
+	args := inhibition.ArgsInhibitor{
+		Config: createInhibitionConfig(),
+		Storer: storer,
+	}
+	i, _ := inhibition.NewInhibitor(args)
+	unreachable := createUnreachableAlert()
+	alert := createNodeAlert("node-1", "NodeRating", "ratingDecrease", 0)
+	i.ProcessEvent(process.AvailabilityClientID, createEvent([]data.Alert{unreachable}, nil))
+	i.ProcessEvent("NodeRating", createEvent([]data.Alert{alert}, nil))
+
+	reloaded, err := inhibition.NewInhibitor(args)
+	require.Nil(t, err)
+	require.Len(t, reloaded.InhibitedAlerts(), 1)
+
+	result := reloaded.ProcessEvent(process.AvailabilityClientID, createEvent(nil, []data.Alert{unreachable}))
+	require.Len(t, result.Alerts, 1)
+	assert.Equal(t, alert.ID, result.Alerts[0].ID)
+}
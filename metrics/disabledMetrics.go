@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+)
+
+type disabledMetrics struct{}
+
+// NewDisabledMetrics will create a metrics handler which does nothing, to be used when the exporter is disabled
+func NewDisabledMetrics() *disabledMetrics {
+	return &disabledMetrics{}
+}
+
+// UpdateNodeMetrics does nothing
+func (dm *disabledMetrics) UpdateNodeMetrics(_ clients.APINode) {
+}
+
+// ObserveCycleDuration does nothing
+func (dm *disabledMetrics) ObserveCycleDuration(_ time.Duration) {
+}
+
+// IncrementClientErrors does nothing
+func (dm *disabledMetrics) IncrementClientErrors(_ string) {
+}
+
+// IncrementNotifierResult does nothing
+func (dm *disabledMetrics) IncrementNotifierResult(_ string, _ bool) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dm *disabledMetrics) IsInterfaceNil() bool {
+	return dm == nil
+}
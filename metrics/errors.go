@@ -0,0 +1,9 @@
+package metrics
+
+import "errors"
+
+// ErrEmptyListenAddress signals that an empty listen address has been provided
+var ErrEmptyListenAddress = errors.New("empty listen address has been provided")
+
+// ErrNilHandler signals that a nil http handler has been provided
+var ErrNilHandler = errors.New("nil http handler")
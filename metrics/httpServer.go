@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("metrics")
+
+const (
+	metricsPath       = "/metrics"
+	shutdownTimeout   = 5 * time.Second
+	readHeaderTimeout = 10 * time.Second
+)
+
+// ArgsHTTPServer defines the arguments needed to create a new metrics http server
+type ArgsHTTPServer struct {
+	ListenAddress string
+	Handler       http.Handler
+}
+
+type httpServer struct {
+	server *http.Server
+}
+
+// NewHTTPServer will create a new http server which exposes the metrics handler on /metrics
+func NewHTTPServer(args ArgsHTTPServer) (*httpServer, error) {
+	if args.ListenAddress == "" {
+		return nil, ErrEmptyListenAddress
+	}
+	if args.Handler == nil {
+		return nil, ErrNilHandler
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, args.Handler)
+
+	return &httpServer{
+		server: &http.Server{
+			Addr:              args.ListenAddress,
+			Handler:           mux,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+	}, nil
+}
+
+// Start will start serving requests on a separate go routine
+func (hs *httpServer) Start() {
+	go func() {
+		log.Info("starting metrics http server", "address", hs.server.Addr, "path", metricsPath)
+
+		err := hs.server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("metrics http server stopped", "error", err.Error())
+		}
+	}()
+}
+
+// Close will gracefully stop the http server
+func (hs *httpServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return hs.server.Shutdown(ctx)
+}
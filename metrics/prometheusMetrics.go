@@ -0,0 +1,219 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "node_monitoring"
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+)
+
+var nodeLabels = []string{"bls", "name", "shard", "identity"}
+
+type prometheusMetrics struct {
+	registry *prometheus.Registry
+
+	tempRating                 *prometheus.GaugeVec
+	rating                     *prometheus.GaugeVec
+	ratingModifier             *prometheus.GaugeVec
+	online                     *prometheus.GaugeVec
+	nonce                      *prometheus.GaugeVec
+	validatorSuccess           *prometheus.GaugeVec
+	validatorFailure           *prometheus.GaugeVec
+	validatorIgnoredSignatures *prometheus.GaugeVec
+	leaderSuccess              *prometheus.GaugeVec
+	leaderFailure              *prometheus.GaugeVec
+	instances                  *prometheus.GaugeVec
+
+	cycleDuration   prometheus.Histogram
+	clientErrors    *prometheus.CounterVec
+	notifierResults *prometheus.CounterVec
+
+	nodesLabels    map[string]prometheus.Labels
+	mutNodesLabels sync.Mutex
+}
+
+// NewPrometheusMetrics will create a new prometheus metrics handler instance
+func NewPrometheusMetrics() (*prometheusMetrics, error) {
+	pm := &prometheusMetrics{
+		registry:                   prometheus.NewRegistry(),
+		tempRating:                 newNodeGauge("temp_rating", "The temp rating of the node"),
+		rating:                     newNodeGauge("rating", "The rating of the node"),
+		ratingModifier:             newNodeGauge("rating_modifier", "The rating modifier of the node"),
+		online:                     newNodeGauge("online", "Whether the node is online (1) or not (0)"),
+		nonce:                      newNodeGauge("nonce", "The last nonce reported by the node"),
+		validatorSuccess:           newNodeGauge("validator_success", "The number of successful validations"),
+		validatorFailure:           newNodeGauge("validator_failure", "The number of failed validations"),
+		validatorIgnoredSignatures: newNodeGauge("validator_ignored_signatures", "The number of ignored validator signatures"),
+		leaderSuccess:              newNodeGauge("leader_success", "The number of successfully proposed blocks"),
+		leaderFailure:              newNodeGauge("leader_failure", "The number of failed block proposals"),
+		instances:                  newNodeGauge("instances", "The number of instances running with the node key"),
+		cycleDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "cycle_duration_seconds",
+			Help:      "The duration of an events processing cycle",
+		}),
+		clientErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "client_errors_total",
+			Help:      "The number of errors returned by each client",
+		}, []string{"client"}),
+		notifierResults: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "notifications_total",
+			Help:      "The number of notifications pushed by each notifier, by result",
+		}, []string{"notifier", "result"}),
+		nodesLabels: make(map[string]prometheus.Labels),
+	}
+
+	collectors := []prometheus.Collector{
+		pm.tempRating,
+		pm.rating,
+		pm.ratingModifier,
+		pm.online,
+		pm.nonce,
+		pm.validatorSuccess,
+		pm.validatorFailure,
+		pm.validatorIgnoredSignatures,
+		pm.leaderSuccess,
+		pm.leaderFailure,
+		pm.instances,
+		pm.cycleDuration,
+		pm.clientErrors,
+		pm.notifierResults,
+	}
+	for _, collector := range collectors {
+		err := pm.registry.Register(collector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pm, nil
+}
+
+func newNodeGauge(name string, help string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "node",
+		Name:      name,
+		Help:      help,
+	}, nodeLabels)
+}
+
+// UpdateNodeMetrics will set the node gauges based on the values fetched from api
+func (pm *prometheusMetrics) UpdateNodeMetrics(node clients.APINode) {
+	labels := prometheus.Labels{
+		"bls":      node.Bls,
+		"name":     node.Name,
+		"shard":    strconv.Itoa(node.Shard),
+		"identity": node.Identity,
+	}
+	pm.replaceNodeLabels(node.Bls, labels)
+
+	pm.tempRating.With(labels).Set(node.TempRating)
+	pm.rating.With(labels).Set(float64(node.Rating))
+	pm.ratingModifier.With(labels).Set(node.RatingModifier)
+	pm.online.With(labels).Set(boolToFloat(node.Online))
+	pm.nonce.With(labels).Set(float64(node.Nonce))
+	pm.validatorSuccess.With(labels).Set(float64(node.ValidatorSuccess))
+	pm.validatorFailure.With(labels).Set(float64(node.ValidatorFailure))
+	pm.validatorIgnoredSignatures.With(labels).Set(float64(node.ValidatorIgnoredSignatures))
+	pm.leaderSuccess.With(labels).Set(float64(node.LeaderSuccess))
+	pm.leaderFailure.With(labels).Set(float64(node.LeaderFailure))
+	pm.instances.With(labels).Set(float64(node.Instances))
+}
+
+// replaceNodeLabels will remove the series exported with old labels, if the node name, shard
+// or identity changed, so that a node is always exported as a single series
+func (pm *prometheusMetrics) replaceNodeLabels(bls string, labels prometheus.Labels) {
+	pm.mutNodesLabels.Lock()
+	defer pm.mutNodesLabels.Unlock()
+
+	oldLabels, ok := pm.nodesLabels[bls]
+	pm.nodesLabels[bls] = labels
+	if !ok || labelsEqual(oldLabels, labels) {
+		return
+	}
+
+	for _, gauge := range pm.nodeGauges() {
+		gauge.Delete(oldLabels)
+	}
+}
+
+func (pm *prometheusMetrics) nodeGauges() []*prometheus.GaugeVec {
+	return []*prometheus.GaugeVec{
+		pm.tempRating,
+		pm.rating,
+		pm.ratingModifier,
+		pm.online,
+		pm.nonce,
+		pm.validatorSuccess,
+		pm.validatorFailure,
+		pm.validatorIgnoredSignatures,
+		pm.leaderSuccess,
+		pm.leaderFailure,
+		pm.instances,
+	}
+}
+
+// ObserveCycleDuration will record the duration of an events processing cycle
+func (pm *prometheusMetrics) ObserveCycleDuration(duration time.Duration) {
+	pm.cycleDuration.Observe(duration.Seconds())
+}
+
+// IncrementClientErrors will increment the errors counter for the provided client
+func (pm *prometheusMetrics) IncrementClientErrors(clientID string) {
+	pm.clientErrors.WithLabelValues(clientID).Inc()
+}
+
+// IncrementNotifierResult will increment the notifications counter for the provided notifier
+func (pm *prometheusMetrics) IncrementNotifierResult(notifierID string, success bool) {
+	result := resultSuccess
+	if !success {
+		result = resultFailure
+	}
+
+	pm.notifierResults.WithLabelValues(notifierID, result).Inc()
+}
+
+// Handler returns the http handler which exposes the registered metrics
+func (pm *prometheusMetrics) Handler() http.Handler {
+	return promhttp.HandlerFor(pm.registry, promhttp.HandlerOpts{})
+}
+
+func labelsEqual(first prometheus.Labels, second prometheus.Labels) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for key, value := range first {
+		if second[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pm *prometheusMetrics) IsInterfaceNil() bool {
+	return pm == nil
+}
@@ -0,0 +1,122 @@
+package metrics_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrapeMetrics(t *testing.T, handler http.Handler) string {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := ioutil.ReadAll(recorder.Body)
+	require.Nil(t, err)
+
+	return string(body)
+}
+
+func TestPrometheusMetrics_UpdateNodeMetrics(t *testing.T) {
+	t.Parallel()
+
+	pm, err := metrics.NewPrometheusMetrics()
+	require.Nil(t, err)
+	require.False(t, pm.IsInterfaceNil())
+
+	pm.UpdateNodeMetrics(clients.APINode{
+		Bls:              "blskey",
+		Name:             "node-0",
+		Identity:         "identity",
+		Shard:            1,
+		TempRating:       99.5,
+		Rating:           100,
+		Online:           true,
+		Nonce:            1234,
+		ValidatorSuccess: 10,
+		Instances:        1,
+	})
+
+	output := scrapeMetrics(t, pm.Handler())
+	labels := `{bls="blskey",identity="identity",name="node-0",shard="1"}`
+	assert.Contains(t, output, "node_monitoring_node_temp_rating"+labels+" 99.5")
+	assert.Contains(t, output, "node_monitoring_node_rating"+labels+" 100")
+	assert.Contains(t, output, "node_monitoring_node_online"+labels+" 1")
+	assert.Contains(t, output, "node_monitoring_node_nonce"+labels+" 1234")
+	assert.Contains(t, output, "node_monitoring_node_validator_success"+labels+" 10")
+	assert.Contains(t, output, "node_monitoring_node_instances"+labels+" 1")
+
+	// node renamed, the old series should not be exported anymore
+	pm.UpdateNodeMetrics(clients.APINode{
+		Bls:      "blskey",
+		Name:     "node-1",
+		Identity: "identity",
+		Shard:    1,
+	})
+
+	output = scrapeMetrics(t, pm.Handler())
+	assert.NotContains(t, output, `name="node-0"`)
+	assert.Contains(t, output, `node_monitoring_node_temp_rating{bls="blskey",identity="identity",name="node-1",shard="1"} 0`)
+}
+
+func TestPrometheusMetrics_InternalMetrics(t *testing.T) {
+	t.Parallel()
+
+	pm, err := metrics.NewPrometheusMetrics()
+	require.Nil(t, err)
+
+	pm.ObserveCycleDuration(time.Second)
+	pm.IncrementClientErrors("NodeRating")
+	pm.IncrementNotifierResult("Slack", true)
+	pm.IncrementNotifierResult("Slack", false)
+	pm.IncrementNotifierResult("Slack", false)
+
+	output := scrapeMetrics(t, pm.Handler())
+	assert.Contains(t, output, "node_monitoring_cycle_duration_seconds_count 1")
+	assert.Contains(t, output, `node_monitoring_client_errors_total{client="NodeRating"} 1`)
+	assert.Contains(t, output, `node_monitoring_notifications_total{notifier="Slack",result="success"} 1`)
+	assert.Contains(t, output, `node_monitoring_notifications_total{notifier="Slack",result="failure"} 2`)
+}
+
+func TestNewHTTPServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty listen address", func(t *testing.T) {
+		t.Parallel()
+
+		hs, err := metrics.NewHTTPServer(metrics.ArgsHTTPServer{
+			Handler: http.NotFoundHandler(),
+		})
+		require.Nil(t, hs)
+		assert.True(t, errors.Is(err, metrics.ErrEmptyListenAddress))
+	})
+
+	t.Run("nil handler", func(t *testing.T) {
+		t.Parallel()
+
+		hs, err := metrics.NewHTTPServer(metrics.ArgsHTTPServer{
+			ListenAddress: ":0",
+		})
+		require.Nil(t, hs)
+		assert.True(t, errors.Is(err, metrics.ErrNilHandler))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hs, err := metrics.NewHTTPServer(metrics.ArgsHTTPServer{
+			ListenAddress: ":0",
+			Handler:       http.NotFoundHandler(),
+		})
+		require.Nil(t, err)
+		require.NotNil(t, hs)
+		require.Nil(t, hs.Close())
+	})
+}
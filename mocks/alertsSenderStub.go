@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
+// AlertsSenderStub implements escalation.Sender interface
+type AlertsSenderStub struct {
+	SendAlertsCalled func(notifierNames []string, msg data.NotificationMessage) error
+}
+
+// SendAlerts -
+func (ass *AlertsSenderStub) SendAlerts(notifierNames []string, msg data.NotificationMessage) error {
+	if ass.SendAlertsCalled != nil {
+		return ass.SendAlertsCalled(notifierNames, msg)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ass *AlertsSenderStub) IsInterfaceNil() bool {
+	return ass == nil
+}
@@ -1,9 +1,12 @@
 package mocks
 
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
 // HTTPClientStub implements HTTPClient interface
 type HTTPClientStub struct {
 	CallGetRestEndPointCalled  func(address string, path string) ([]byte, error)
 	CallPostRestEndPointCalled func(address string, path string, data interface{}) error
+	CallRestEndPointCalled     func(request data.HTTPRequest) ([]byte, error)
 }
 
 // CallGetRestEndPoint -
@@ -24,6 +27,15 @@ func (hcs *HTTPClientStub) CallPostRestEndPoint(address string, path string, dat
 	return nil
 }
 
+// CallRestEndPoint -
+func (hcs *HTTPClientStub) CallRestEndPoint(request data.HTTPRequest) ([]byte, error) {
+	if hcs.CallRestEndPointCalled != nil {
+		return hcs.CallRestEndPointCalled(request)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hcs *HTTPClientStub) IsInterfaceNil() bool {
 	return hcs == nil
@@ -0,0 +1,20 @@
+package mocks
+
+// MailSenderStub implements email.MailSender interface
+type MailSenderStub struct {
+	SendMailCalled func(from string, to []string, msg []byte) error
+}
+
+// SendMail -
+func (mss *MailSenderStub) SendMail(from string, to []string, msg []byte) error {
+	if mss.SendMailCalled != nil {
+		return mss.SendMailCalled(from, to, msg)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (mss *MailSenderStub) IsInterfaceNil() bool {
+	return mss == nil
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/clients"
+
+// NodesMetricsHandlerStub implements clients.NodesMetricsHandler interface
+type NodesMetricsHandlerStub struct {
+	UpdateNodeMetricsCalled func(node clients.APINode)
+}
+
+// UpdateNodeMetrics -
+func (nmhs *NodesMetricsHandlerStub) UpdateNodeMetrics(node clients.APINode) {
+	if nmhs.UpdateNodeMetricsCalled != nil {
+		nmhs.UpdateNodeMetricsCalled(node)
+	}
+}
+
+// IsInterfaceNil -
+func (nmhs *NodesMetricsHandlerStub) IsInterfaceNil() bool {
+	return nmhs == nil
+}
@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+)
+
+// NodesStatusProviderStub implements telegram.NodesStatusProvider interface
+type NodesStatusProviderStub struct {
+	NodesStatusCalled    func() []status.NodeStatus
+	MonitoredNodesCalled func() []config.NodeLabels
+}
+
+// NodesStatus -
+func (nsps *NodesStatusProviderStub) NodesStatus() []status.NodeStatus {
+	if nsps.NodesStatusCalled != nil {
+		return nsps.NodesStatusCalled()
+	}
+
+	return nil
+}
+
+// MonitoredNodes -
+func (nsps *NodesStatusProviderStub) MonitoredNodes() []config.NodeLabels {
+	if nsps.MonitoredNodesCalled != nil {
+		return nsps.MonitoredNodesCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (nsps *NodesStatusProviderStub) IsInterfaceNil() bool {
+	return nsps == nil
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
+// ReportSenderStub implements reports.ReportSender interface
+type ReportSenderStub struct {
+	SendReportCalled func(notifierNames []string, msg data.NotificationMessage) error
+}
+
+// SendReport -
+func (rss *ReportSenderStub) SendReport(notifierNames []string, msg data.NotificationMessage) error {
+	if rss.SendReportCalled != nil {
+		return rss.SendReportCalled(notifierNames, msg)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (rss *ReportSenderStub) IsInterfaceNil() bool {
+	return rss == nil
+}
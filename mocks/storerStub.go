@@ -0,0 +1,35 @@
+package mocks
+
+import "errors"
+
+// ErrKeyNotFound -
+var ErrKeyNotFound = errors.New("key not found")
+
+// StorerStub implements clients.Storer interface
+type StorerStub struct {
+	PutCalled func(key string, value interface{}) error
+	GetCalled func(key string, value interface{}) error
+}
+
+// Put -
+func (ss *StorerStub) Put(key string, value interface{}) error {
+	if ss.PutCalled != nil {
+		return ss.PutCalled(key, value)
+	}
+
+	return nil
+}
+
+// Get -
+func (ss *StorerStub) Get(key string, value interface{}) error {
+	if ss.GetCalled != nil {
+		return ss.GetCalled(key, value)
+	}
+
+	return ErrKeyNotFound
+}
+
+// IsInterfaceNil -
+func (ss *StorerStub) IsInterfaceNil() bool {
+	return ss == nil
+}
@@ -0,0 +1,73 @@
+package monitoring
+
+import (
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	noderating "github.com/multiversx/mx-chain-node-monitoring/clients/nodeRating"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/metrics"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+)
+
+// argsClientCreator defines the components needed to create the clients
+type argsClientCreator struct {
+	config          *config.Alarms
+	httpClient      clients.HTTPClient
+	metricsHandler  clients.NodesMetricsHandler
+	storer          clients.Storer
+	lastValues      map[string]float64
+	thresholdLevels map[string]common.EventLevel
+}
+
+// CreateClients will create the configured clients in the same way the monitoring runner does. The clients
+// will use the state persisted in the provided file, if any
+func CreateClients(cfg *config.Alarms, stateFilePath string) ([]process.Connector, error) {
+	httpClient, err := createHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := createStorer(stateFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	args := argsClientCreator{
+		config:         cfg,
+		httpClient:     httpClient,
+		metricsHandler: metrics.NewDisabledMetrics(),
+		storer:         storer,
+	}
+	nodeRatingClient, err := createNodeRatingClient(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return []process.Connector{nodeRatingClient}, nil
+}
+
+func createNodeRatingClient(args argsClientCreator) (nodeRatingHandler, error) {
+	nodeRatingArgs := noderating.ArgsNodeRating{
+		Client:          args.httpClient,
+		MetricsHandler:  args.metricsHandler,
+		Storer:          args.storer,
+		Config:          args.config.NodeRating,
+		LastValues:      args.lastValues,
+		ThresholdLevels: args.thresholdLevels,
+	}
+	nodeRatingClient, err := noderating.NewNodeRatingClient(nodeRatingArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeRatingClient, nil
+}
+
+func createStorer(stateFilePath string) (clients.Storer, error) {
+	if stateFilePath == "" {
+		return storage.NewDisabledStorer(), nil
+	}
+
+	return storage.NewJSONFileStorer(stateFilePath)
+}
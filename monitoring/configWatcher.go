@@ -0,0 +1,79 @@
+package monitoring
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// configWatcher polls the config file and signals when its modification time or size changed
+type configWatcher struct {
+	filePath    string
+	interval    time.Duration
+	lastModTime time.Time
+	lastSize    int64
+	changes     chan struct{}
+	cancelFunc  func()
+}
+
+func newConfigWatcher(filePath string, interval time.Duration) (*configWatcher, error) {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configWatcher{
+		filePath:    filePath,
+		interval:    interval,
+		lastModTime: fileInfo.ModTime(),
+		lastSize:    fileInfo.Size(),
+		changes:     make(chan struct{}, 1),
+	}, nil
+}
+
+func (cw *configWatcher) start() {
+	var ctx context.Context
+	ctx, cw.cancelFunc = context.WithCancel(context.Background())
+
+	go cw.watch(ctx)
+}
+
+func (cw *configWatcher) watch(ctx context.Context) {
+	timer := time.NewTicker(cw.interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			cw.checkFile()
+		}
+	}
+}
+
+func (cw *configWatcher) checkFile() {
+	fileInfo, err := os.Stat(cw.filePath)
+	if err != nil {
+		log.Warn("failed to stat config file", "path", cw.filePath, "error", err.Error())
+		return
+	}
+
+	if fileInfo.ModTime().Equal(cw.lastModTime) && fileInfo.Size() == cw.lastSize {
+		return
+	}
+
+	cw.lastModTime = fileInfo.ModTime()
+	cw.lastSize = fileInfo.Size()
+
+	select {
+	case cw.changes <- struct{}{}:
+	default:
+	}
+}
+
+func (cw *configWatcher) close() {
+	if cw.cancelFunc != nil {
+		cw.cancelFunc()
+	}
+}
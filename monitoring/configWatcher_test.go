@@ -0,0 +1,53 @@
+package monitoring_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/monitoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		cw, err := monitoring.NewConfigWatcher(filepath.Join(t.TempDir(), "missing.toml"), time.Millisecond)
+		require.Nil(t, cw)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should signal file changes", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "config.toml")
+		err := ioutil.WriteFile(filePath, []byte("[General]"), 0644)
+		require.Nil(t, err)
+
+		cw, err := monitoring.NewConfigWatcher(filePath, time.Millisecond*10)
+		require.Nil(t, err)
+
+		cw.Start()
+		defer cw.Close()
+
+		select {
+		case <-cw.Changes():
+			assert.Fail(t, "should not signal an unchanged file")
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		err = ioutil.WriteFile(filePath, []byte("[General]\n    TriggerIntervalSec = 5"), 0644)
+		require.Nil(t, err)
+
+		select {
+		case <-cw.Changes():
+		case <-time.After(time.Second):
+			assert.Fail(t, "should have signaled the file change")
+		}
+	})
+}
@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilConfigs signals that nil config has been provided
 var ErrNilConfigs = errors.New("nil configs provided")
+
+// ErrNotifierNotEnabled signals that the notifier with the provided name is not enabled
+var ErrNotifierNotEnabled = errors.New("notifier not enabled")
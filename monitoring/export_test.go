@@ -0,0 +1,55 @@
+package monitoring
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/process/mocks"
+)
+
+// NewConfigWatcher -
+func NewConfigWatcher(filePath string, interval time.Duration) (*configWatcher, error) {
+	return newConfigWatcher(filePath, interval)
+}
+
+// Start -
+func (cw *configWatcher) Start() {
+	cw.start()
+}
+
+// Close -
+func (cw *configWatcher) Close() {
+	cw.close()
+}
+
+// Changes -
+func (cw *configWatcher) Changes() <-chan struct{} {
+	return cw.changes
+}
+
+type metricsHandlerStub struct {
+	*mocks.MetricsHandlerStub
+}
+
+// UpdateNodeMetrics -
+func (mhs *metricsHandlerStub) UpdateNodeMetrics(_ clients.APINode) {
+}
+
+// SetNotifyWorkers -
+func (mr *monitoringRunner) SetNotifyWorkers(workers map[string]process.Notifier) {
+	mr.mutNotifiers.Lock()
+	mr.workers = workers
+	mr.metricsHandler = &metricsHandlerStub{MetricsHandlerStub: &mocks.MetricsHandlerStub{}}
+	mr.mutNotifiers.Unlock()
+}
+
+// LockNotifiers -
+func (mr *monitoringRunner) LockNotifiers() {
+	mr.mutNotifiers.Lock()
+}
+
+// UnlockNotifiers -
+func (mr *monitoringRunner) UnlockNotifiers() {
+	mr.mutNotifiers.Unlock()
+}
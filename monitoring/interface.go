@@ -1,7 +1,90 @@
 package monitoring
 
+import (
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/telegram"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/reports"
+)
+
 // processorHandler defines the behaviour of an events processor
 type processorHandler interface {
+	AddClients(client process.Connector)
 	Run()
 	Close() error
 }
+
+// notifyProcessorHandler defines the behaviour of a notify processor which can change its notifiers at runtime
+type notifyProcessorHandler interface {
+	process.Pusher
+	AddNotifier(notifier process.Notifier)
+	RemoveNotifier(id string)
+}
+
+// nodeRatingHandler defines the behaviour of a node rating client which can hand over its baseline values
+type nodeRatingHandler interface {
+	process.Connector
+	GetLastValues() map[string]float64
+	GetThresholdLevels() map[string]common.EventLevel
+	GetNodes() []clients.APINode
+}
+
+// alertsStateHandler defines the behaviour of the component which holds the active alerts, the acks and the silences
+type alertsStateHandler interface {
+	process.AlertsHandler
+	process.AlertsMuter
+	process.FiringAlertsProvider
+	telegram.AlertsManager
+}
+
+// hysteresisHandler defines the behaviour of the component which confirms the alerts state changes
+type hysteresisHandler interface {
+	process.AlertsHandler
+	SetConfig(cfg *config.Hysteresis)
+}
+
+// inhibitionHandler defines the behaviour of the component which suppresses the alerts depending on other firing alerts
+type inhibitionHandler interface {
+	process.AlertsHandler
+	SetConfig(cfg *config.Inhibition) error
+}
+
+// escalationHandler defines the behaviour of the component which notifies the unacknowledged alerts through the
+// steps of the escalation policies
+type escalationHandler interface {
+	process.AlertsHandler
+	botHandler
+	SetConfig(cfg *config.Escalation) error
+}
+
+// correlationHandler defines the behaviour of the component which groups the correlated alerts into incidents
+type correlationHandler interface {
+	process.AlertsHandler
+	SetConfig(cfg *config.Correlation)
+}
+
+// botHandler defines the behaviour of a bot which answers the commands received from the operators
+type botHandler interface {
+	Start()
+	Close() error
+}
+
+// samplesCollectorHandler defines the behaviour of the component which collects the samples used by the reports
+type samplesCollectorHandler interface {
+	process.EventsRecorder
+	reports.PeriodProvider
+}
+
+// metricsHandler defines the behaviour of a component which exports both node and internal metrics
+type metricsHandler interface {
+	clients.NodesMetricsHandler
+	process.MetricsHandler
+}
+
+// metricsServerHandler defines the behaviour of the http server which exposes the metrics
+type metricsServerHandler interface {
+	Start()
+	Close() error
+}
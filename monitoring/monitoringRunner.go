@@ -1,24 +1,63 @@
 package monitoring
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
 
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
 	"github.com/multiversx/mx-chain-node-monitoring/clients"
-	noderating "github.com/multiversx/mx-chain-node-monitoring/clients/nodeRating"
 	"github.com/multiversx/mx-chain-node-monitoring/config"
-	"github.com/multiversx/mx-chain-node-monitoring/notifiers/email"
+	"github.com/multiversx/mx-chain-node-monitoring/correlation"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/escalation"
+	"github.com/multiversx/mx-chain-node-monitoring/hysteresis"
+	"github.com/multiversx/mx-chain-node-monitoring/inhibition"
+	"github.com/multiversx/mx-chain-node-monitoring/metrics"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
 	"github.com/multiversx/mx-chain-node-monitoring/notifiers/slack"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/telegram"
 	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/reports"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
 )
 
 var log = logger.GetOrCreate("monitoring")
 
-const reqTimeoutSec = 10
+const (
+	reqTimeoutSec       = 10
+	configWatchInterval = 5 * time.Second
+)
 
 type monitoringRunner struct {
-	config *config.GeneralConfig
+	config            *config.GeneralConfig
+	httpClient        notifiers.HTTPClient
+	notifierArgs      argsNotifierCreator
+	metricsHandler    metricsHandler
+	storer            clients.Storer
+	alertsState       alertsStateHandler
+	hysteresis        hysteresisHandler
+	inhibitor         inhibitionHandler
+	escalator         escalationHandler
+	correlator        correlationHandler
+	notifyProcessor   notifyProcessorHandler
+	eventsProcessor   processorHandler
+	nodeRating        nodeRatingHandler
+	mutNodeRating     sync.RWMutex
+	notifiers         map[string]process.Notifier
+	workers           map[string]process.Notifier
+	mutNotifiers      sync.RWMutex
+	telegramBot       botHandler
+	slackInteractions botHandler
+	samplesCollector  samplesCollectorHandler
+	digestScheduler   botHandler
+	epochReporter     botHandler
 }
 
 // NewMonitoringRunner create a new notifierRunner instance
@@ -28,87 +67,783 @@ func NewMonitoringRunner(cfgs *config.GeneralConfig) (*monitoringRunner, error)
 	}
 
 	return &monitoringRunner{
-		config: cfgs,
+		config:    cfgs,
+		notifiers: make(map[string]process.Notifier),
+		workers:   make(map[string]process.Notifier),
 	}, nil
 }
 
 // Start will trigger the main flow
 func (mr *monitoringRunner) Start() error {
-	clientArgs := clients.HTTPClientWrapperArgs{
-		ReqTimeoutSec: reqTimeoutSec,
+	var err error
+	mr.httpClient, err = createHTTPClient()
+	if err != nil {
+		return err
 	}
-	httpClientWrapper, err := clients.NewHTTPClientWrapper(clientArgs)
+
+	metricsHandler, metricsServer, err := mr.createMetricsComponents()
 	if err != nil {
 		return err
 	}
+	mr.metricsHandler = metricsHandler
 
-	nodeRatingArgs := noderating.ArgsNodeRating{
-		Client: httpClientWrapper,
-		Config: mr.config.Alarms.NodeRating,
+	stateFilePath := mr.config.General.StateFilePath
+	if mr.config.Flags.DryRun {
+		// the state file of the running monitoring is neither loaded nor overwritten
+		log.Info("dry-run mode: the state is not persisted")
+		stateFilePath = ""
 	}
-	nodeRatingClient, err := noderating.NewNodeRatingClient(nodeRatingArgs)
+	mr.storer, err = createStorer(stateFilePath)
 	if err != nil {
 		return err
 	}
 
-	notifyProcessor := process.NewNotifyProcessor()
+	mr.nodeRating, err = mr.createNodeRatingClient(mr.config.Alarms, nil)
+	if err != nil {
+		return err
+	}
 
-	if mr.config.Notifiers.Slack.Enabled {
-		argsSlackNotifier := slack.ArgsSlackNotifier{
-			Config:     mr.config.Notifiers.Slack,
-			HTTPClient: httpClientWrapper,
-		}
-		slackNotifier, err := slack.NewSlackNotifier(argsSlackNotifier)
-		if err != nil {
-			return err
-		}
-		notifyProcessor.AddNotifier(slackNotifier)
+	mr.alertsState, err = alerts.NewAlertsState(alerts.ArgsAlertsState{
+		Storer: mr.storer,
+	})
+	if err != nil {
+		return err
 	}
 
-	if mr.config.Notifiers.Email.Enabled {
-		argsEmailNotifier := email.ArgsEmailNotifier{
-			Config: mr.config.Notifiers.Email,
-		}
-		emailNotifier, err := email.NewEmailNotifier(argsEmailNotifier)
-		if err != nil {
-			return err
-		}
-		notifyProcessor.AddNotifier(emailNotifier)
+	mr.hysteresis, err = hysteresis.NewHysteresis(hysteresis.ArgsHysteresis{
+		Config: mr.config.Hysteresis,
+		Storer: mr.storer,
+	})
+	if err != nil {
+		return err
+	}
+	mr.inhibitor, err = inhibition.NewInhibitor(inhibition.ArgsInhibitor{
+		Config: mr.config.Inhibition,
+		Storer: mr.storer,
+	})
+	if err != nil {
+		return err
+	}
+	mr.escalator, err = escalation.NewEscalator(escalation.ArgsEscalator{
+		Config:         mr.config.Escalation,
+		Storer:         mr.storer,
+		Sender:         mr,
+		AlertsProvider: mr.alertsState,
+	})
+	if err != nil {
+		return err
+	}
+	mr.correlator, err = correlation.NewCorrelator(correlation.ArgsCorrelator{
+		Config: mr.config.Correlation,
+		Storer: mr.storer,
+	})
+	if err != nil {
+		return err
+	}
+	alertsHandler, err := process.NewAlertsHandlersChain(mr.hysteresis, mr.alertsState, mr.inhibitor, mr.escalator, mr.correlator)
+	if err != nil {
+		return err
+	}
+
+	argsNotifyProcessor := process.ArgsNotifyProcessor{
+		MetricsHandler: metricsHandler,
+		AlertsMuter:    mr.alertsState,
+	}
+	mr.notifyProcessor, err = process.NewNotifyProcessor(argsNotifyProcessor)
+	if err != nil {
+		return err
+	}
+
+	mr.notifierArgs, err = createArgsNotifierCreator(mr.config.Notifiers, mr.config.Flags.DryRun)
+	if err != nil {
+		return err
+	}
+	mr.notifiers, err = createNotifiers(mr.notifierArgs)
+	if err != nil {
+		return err
+	}
+	workers, err := createNotifyWorkers(mr.config.Throttling, mr.notifiers)
+	if err != nil {
+		return err
+	}
+	for _, worker := range workers {
+		mr.notifyProcessor.AddNotifier(worker)
+	}
+	mr.workers = workers
+
+	mr.samplesCollector, err = reports.NewSamplesCollector(reports.ArgsSamplesCollector{
+		NodesStatusProvider: mr,
+		Storer:              mr.storer,
+	})
+	if err != nil {
+		return err
 	}
 
 	argsEventsProcessor := process.ArgsEventsProcessor{
-		Pusher:             notifyProcessor,
+		Pusher:             mr.notifyProcessor,
+		AlertsHandler:      alertsHandler,
+		EventsRecorder:     mr.samplesCollector,
+		MetricsHandler:     metricsHandler,
+		FiringAlerts:       mr.alertsState,
 		TriggerInternalSec: mr.config.General.TriggerIntervalSec,
 	}
-	eventsProcessor, err := process.NewEventsProcessor(argsEventsProcessor)
+	mr.eventsProcessor, err = process.NewEventsProcessor(argsEventsProcessor)
+	if err != nil {
+		return err
+	}
+	mr.eventsProcessor.AddClients(mr.nodeRating)
+
+	mr.telegramBot, err = mr.createTelegramBot(mr.config.Notifiers.Telegram)
 	if err != nil {
 		return err
 	}
-	eventsProcessor.AddClients(nodeRatingClient)
 
-	eventsProcessor.Run()
+	mr.slackInteractions, err = mr.createSlackInteractions(mr.config.Notifiers.Slack)
+	if err != nil {
+		return err
+	}
 
-	err = waitForGracefulShutdown(eventsProcessor)
+	mr.digestScheduler, err = mr.createDigestScheduler(mr.config.Reports)
 	if err != nil {
 		return err
 	}
 
+	mr.epochReporter, err = mr.createEpochReporter(mr.config.Reports, mr.config.Alarms)
+	if err != nil {
+		return err
+	}
+
+	if metricsServer != nil {
+		metricsServer.Start()
+	}
+	mr.escalator.Start()
+	if mr.telegramBot != nil {
+		mr.telegramBot.Start()
+	}
+	if mr.slackInteractions != nil {
+		mr.slackInteractions.Start()
+	}
+	if mr.digestScheduler != nil {
+		mr.digestScheduler.Start()
+	}
+	if mr.epochReporter != nil {
+		mr.epochReporter.Start()
+	}
+
+	mr.eventsProcessor.Run()
+
+	err = mr.waitForGracefulShutdown(metricsServer)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createNodeRatingClient will create the node rating client; if a previous client is provided, its baseline values
+// and its notified threshold levels are carried over
+func (mr *monitoringRunner) createNodeRatingClient(cfg *config.Alarms, previous nodeRatingHandler) (nodeRatingHandler, error) {
+	args := argsClientCreator{
+		config:         cfg,
+		httpClient:     mr.httpClient,
+		metricsHandler: mr.metricsHandler,
+		storer:         mr.storer,
+	}
+	if previous != nil {
+		args.lastValues = previous.GetLastValues()
+		args.thresholdLevels = previous.GetThresholdLevels()
+	}
+
+	return createNodeRatingClient(args)
+}
+
+// createTelegramBot will create the telegram bot if its commands are enabled, or nil otherwise
+func (mr *monitoringRunner) createTelegramBot(cfg *config.Telegram) (botHandler, error) {
+	if cfg == nil || !cfg.Enabled || !cfg.CommandsEnabled {
+		return nil, nil
+	}
+	if mr.config.Flags.DryRun {
+		log.Info("dry-run mode: the telegram bot commands are disabled")
+		return nil, nil
+	}
+
+	argsTelegramBot := telegram.ArgsTelegramBot{
+		Config:         cfg,
+		HTTPClient:     mr.httpClient,
+		StatusProvider: mr,
+		AlertsManager:  mr.alertsState,
+	}
+	telegramBot, err := telegram.NewTelegramBot(argsTelegramBot)
+	if err != nil {
+		return nil, err
+	}
+
+	return telegramBot, nil
+}
+
+// createSlackInteractions will create the server handling the slack buttons if the interactions are enabled, or nil otherwise
+func (mr *monitoringRunner) createSlackInteractions(cfg *config.Slack) (botHandler, error) {
+	if cfg == nil || !cfg.Enabled || !slack.InteractionsEnabled(cfg) {
+		return nil, nil
+	}
+	if mr.config.Flags.DryRun {
+		log.Info("dry-run mode: the slack interactions are disabled")
+		return nil, nil
+	}
+
+	argsInteractionsServer := slack.ArgsInteractionsServer{
+		Config:        cfg,
+		HTTPClient:    mr.httpClient,
+		AlertsManager: mr.alertsState,
+	}
+	interactionsServer, err := slack.NewInteractionsServer(argsInteractionsServer)
+	if err != nil {
+		return nil, err
+	}
+
+	return interactionsServer, nil
+}
+
+// createDigestScheduler will create the scheduler of the digest if it is enabled, or nil otherwise
+func (mr *monitoringRunner) createDigestScheduler(cfg *config.Reports) (botHandler, error) {
+	if cfg == nil || cfg.Digest == nil || !cfg.Digest.Enabled {
+		return nil, nil
+	}
+
+	argsDigestScheduler := reports.ArgsDigestScheduler{
+		Config:         cfg.Digest,
+		PeriodProvider: mr.samplesCollector,
+		Sender:         mr,
+	}
+	digestScheduler, err := reports.NewDigestScheduler(argsDigestScheduler)
+	if err != nil {
+		return nil, err
+	}
+
+	return digestScheduler, nil
+}
+
+// createEpochReporter will create the reporter of the epoch performance if it is enabled, or nil otherwise
+func (mr *monitoringRunner) createEpochReporter(cfg *config.Reports, alarms *config.Alarms) (botHandler, error) {
+	if cfg == nil || cfg.Epoch == nil || !cfg.Epoch.Enabled {
+		return nil, nil
+	}
+
+	nodeRatingConfig := alarms.NodeRating
+	statsFetcher, err := clients.NewStatsFetcher(clients.ArgsStatsFetcher{
+		Client: mr.httpClient,
+		ApiUrl: nodeRatingConfig.ApiUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodesFetcher, err := clients.NewNodesFetcher(clients.ArgsNodesFetcher{
+		Client: mr.httpClient,
+		ApiUrl: nodeRatingConfig.ApiUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	argsEpochReporter := reports.ArgsEpochReporter{
+		Config:       cfg.Epoch,
+		PubKeys:      nodeRatingConfig.PubKeys,
+		Labels:       nodeRatingConfig.Nodes,
+		StatsFetcher: statsFetcher,
+		NodesFetcher: nodesFetcher,
+		Storer:       mr.storer,
+		Sender:       mr,
+	}
+	epochReporter, err := reports.NewEpochReporter(argsEpochReporter)
+	if err != nil {
+		return nil, err
+	}
+
+	return epochReporter, nil
+}
+
+func (mr *monitoringRunner) createMetricsComponents() (metricsHandler, metricsServerHandler, error) {
+	if mr.config.Metrics == nil || !mr.config.Metrics.Enabled {
+		return metrics.NewDisabledMetrics(), nil, nil
+	}
+
+	prometheusMetrics, err := metrics.NewPrometheusMetrics()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argsHTTPServer := metrics.ArgsHTTPServer{
+		ListenAddress: mr.config.Metrics.ListenAddress,
+		Handler:       prometheusMetrics.Handler(),
+	}
+	metricsServer, err := metrics.NewHTTPServer(argsHTTPServer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return prometheusMetrics, metricsServer, nil
+}
+
+// reloadConfig will read again the config file and will rebuild only the client and the notifiers
+// with changed config; nothing is applied unless all the changed components can be created
+func (mr *monitoringRunner) reloadConfig() error {
+	newConfig, err := config.LoadConfig(mr.config.Flags.GeneralConfigPath)
+	if err != nil {
+		return err
+	}
+	err = config.CheckConfig(newConfig)
+	if err != nil {
+		return err
+	}
+	newConfig.Flags = mr.config.Flags
+
+	var newNodeRating nodeRatingHandler
+	if !reflect.DeepEqual(mr.config.Alarms.NodeRating, newConfig.Alarms.NodeRating) {
+		newNodeRating, err = mr.createNodeRatingClient(newConfig.Alarms, mr.nodeRating)
+		if err != nil {
+			return fmt.Errorf("%s client: %w", mr.nodeRating.GetID(), err)
+		}
+	}
+
+	changedNotifiers, err := mr.createChangedNotifiers(newConfig.Notifiers)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(mr.config.Throttling, newConfig.Throttling) {
+		// all the notifiers are wrapped again with the new rate limits
+		mr.addUnchangedNotifiers(changedNotifiers)
+	}
+	changedWorkers, err := createNotifyWorkers(newConfig.Throttling, changedNotifiers)
+	if err != nil {
+		return fmt.Errorf("throttling: %w", err)
+	}
+
+	telegramBotChanged := !reflect.DeepEqual(mr.config.Notifiers.Telegram, newConfig.Notifiers.Telegram)
+	var newTelegramBot botHandler
+	if telegramBotChanged {
+		newTelegramBot, err = mr.createTelegramBot(newConfig.Notifiers.Telegram)
+		if err != nil {
+			return fmt.Errorf("telegram bot: %w", err)
+		}
+	}
+
+	slackInteractionsChanged := !reflect.DeepEqual(mr.config.Notifiers.Slack, newConfig.Notifiers.Slack)
+	var newSlackInteractions botHandler
+	if slackInteractionsChanged {
+		newSlackInteractions, err = mr.createSlackInteractions(newConfig.Notifiers.Slack)
+		if err != nil {
+			return fmt.Errorf("slack interactions: %w", err)
+		}
+	}
+
+	digestSchedulerChanged := !reflect.DeepEqual(digestConfig(mr.config.Reports), digestConfig(newConfig.Reports))
+	var newDigestScheduler botHandler
+	if digestSchedulerChanged {
+		newDigestScheduler, err = mr.createDigestScheduler(newConfig.Reports)
+		if err != nil {
+			return fmt.Errorf("digest scheduler: %w", err)
+		}
+	}
+
+	epochReporterChanged := !reflect.DeepEqual(epochReportConfig(mr.config.Reports), epochReportConfig(newConfig.Reports)) ||
+		!reflect.DeepEqual(mr.config.Alarms, newConfig.Alarms)
+	var newEpochReporter botHandler
+	if epochReporterChanged {
+		newEpochReporter, err = mr.createEpochReporter(newConfig.Reports, newConfig.Alarms)
+		if err != nil {
+			return fmt.Errorf("epoch reporter: %w", err)
+		}
+	}
+
+	hysteresisChanged := !reflect.DeepEqual(mr.config.Hysteresis, newConfig.Hysteresis)
+	inhibitionChanged := !reflect.DeepEqual(mr.config.Inhibition, newConfig.Inhibition)
+	escalationChanged := !reflect.DeepEqual(mr.config.Escalation, newConfig.Escalation)
+	correlationChanged := !reflect.DeepEqual(mr.config.Correlation, newConfig.Correlation)
+
+	mr.warnOnNonReloadableChanges(newConfig)
+
+	// the client is added before locking, as the events processor reads the nodes status while processing the events
+	if newNodeRating != nil {
+		mr.eventsProcessor.AddClients(newNodeRating)
+	}
+
+	mr.mutNodeRating.Lock()
+	if newNodeRating != nil {
+		mr.nodeRating = newNodeRating
+		log.Info("client reloaded", "client", newNodeRating.GetID())
+	}
+	mr.config = newConfig
+	mr.mutNodeRating.Unlock()
+
+	mr.applyChangedNotifiers(changedNotifiers, changedWorkers)
+	if hysteresisChanged {
+		mr.hysteresis.SetConfig(newConfig.Hysteresis)
+		log.Info("hysteresis rules reloaded")
+	}
+	if inhibitionChanged {
+		err = mr.inhibitor.SetConfig(newConfig.Inhibition)
+		if err != nil {
+			log.Error("failed to reload the inhibition rules", "error", err.Error())
+		} else {
+			log.Info("inhibition rules reloaded")
+		}
+	}
+	if escalationChanged {
+		err = mr.escalator.SetConfig(newConfig.Escalation)
+		if err != nil {
+			log.Error("failed to reload the escalation policies", "error", err.Error())
+		} else {
+			log.Info("escalation policies reloaded")
+		}
+	}
+	if correlationChanged {
+		mr.correlator.SetConfig(newConfig.Correlation)
+		log.Info("correlation rules reloaded")
+	}
+	if telegramBotChanged {
+		mr.telegramBot = replaceComponent(mr.telegramBot, newTelegramBot, "telegram bot")
+	}
+	if slackInteractionsChanged {
+		mr.slackInteractions = replaceComponent(mr.slackInteractions, newSlackInteractions, "slack interactions")
+	}
+	if digestSchedulerChanged {
+		mr.digestScheduler = replaceComponent(mr.digestScheduler, newDigestScheduler, "digest scheduler")
+	}
+	if epochReporterChanged {
+		mr.epochReporter = replaceComponent(mr.epochReporter, newEpochReporter, "epoch reporter")
+	}
+
 	return nil
 }
 
-func waitForGracefulShutdown(
-	processor processorHandler,
+func digestConfig(cfg *config.Reports) *config.Digest {
+	if cfg == nil {
+		return nil
+	}
+
+	return cfg.Digest
+}
+
+func epochReportConfig(cfg *config.Reports) *config.EpochReport {
+	if cfg == nil {
+		return nil
+	}
+
+	return cfg.Epoch
+}
+
+// replaceComponent will close the old background component and will start the new one, if any
+func replaceComponent(oldComponent botHandler, newComponent botHandler, name string) botHandler {
+	if oldComponent != nil {
+		_ = oldComponent.Close()
+	}
+
+	if newComponent != nil {
+		newComponent.Start()
+		log.Info(name + " reloaded")
+	}
+
+	return newComponent
+}
+
+// createChangedNotifiers returns the notifiers whose config section changed, mapped by creator name;
+// a nil value means the notifier has been disabled
+func (mr *monitoringRunner) createChangedNotifiers(cfg *config.Notifiers) (map[string]process.Notifier, error) {
+	args := mr.notifierArgs
+	args.config = cfg
+
+	changedNotifiers := make(map[string]process.Notifier)
+	for _, creator := range notifierCreators() {
+		if reflect.DeepEqual(creator.section(mr.config.Notifiers), creator.section(cfg)) {
+			continue
+		}
+		if !creator.isEnabled(cfg) {
+			changedNotifiers[creator.name] = nil
+			continue
+		}
+
+		notifier, err := creator.create(args)
+		if err != nil {
+			return nil, fmt.Errorf("%s notifier: %w", creator.name, err)
+		}
+
+		changedNotifiers[creator.name] = notifier
+	}
+
+	return changedNotifiers, nil
+}
+
+// addUnchangedNotifiers adds the running notifiers which are not already in the provided map
+func (mr *monitoringRunner) addUnchangedNotifiers(changedNotifiers map[string]process.Notifier) {
+	mr.mutNotifiers.RLock()
+	defer mr.mutNotifiers.RUnlock()
+
+	for name, notifier := range mr.notifiers {
+		_, changed := changedNotifiers[name]
+		if !changed {
+			changedNotifiers[name] = notifier
+		}
+	}
+}
+
+// applyChangedNotifiers replaces the changed notifiers; the notify processor receives their workers, which are
+// the notifiers wrapped with their rate limits, while the notifiers are kept for the reports. The replaced workers
+// are closed, so that their pending messages are sent
+func (mr *monitoringRunner) applyChangedNotifiers(changedNotifiers map[string]process.Notifier, changedWorkers map[string]process.Notifier) {
+	mr.mutNotifiers.Lock()
+	replacedWorkers := make([]process.Notifier, 0, len(changedNotifiers))
+	for name, notifier := range changedNotifiers {
+		oldNotifier, ok := mr.notifiers[name]
+		if ok {
+			mr.notifyProcessor.RemoveNotifier(oldNotifier.GetID())
+			replacedWorkers = append(replacedWorkers, mr.workers[name])
+			delete(mr.notifiers, name)
+			delete(mr.workers, name)
+		}
+
+		if notifier == nil {
+			log.Info("notifier disabled", "notifier", name)
+			continue
+		}
+
+		mr.notifyProcessor.AddNotifier(changedWorkers[name])
+		mr.notifiers[name] = notifier
+		mr.workers[name] = changedWorkers[name]
+		log.Info("notifier reloaded", "notifier", name)
+	}
+	mr.mutNotifiers.Unlock()
+
+	closeWorkers(replacedWorkers)
+}
+
+// closeWorkers closes the workers which hold pending messages, as the throttled notifiers do
+func closeWorkers(workers []process.Notifier) {
+	for _, worker := range workers {
+		closer, ok := worker.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		err := closer.Close()
+		if err != nil {
+			log.Warn("failed to close the notifier", "notifier", worker.GetID(), "error", err.Error())
+		}
+	}
+}
+
+func (mr *monitoringRunner) warnOnNonReloadableChanges(newConfig *config.GeneralConfig) {
+	if !reflect.DeepEqual(mr.config.General, newConfig.General) {
+		log.Warn("changes in General config section require a restart to be applied")
+	}
+	if !reflect.DeepEqual(mr.config.Metrics, newConfig.Metrics) {
+		log.Warn("changes in Metrics config section require a restart to be applied")
+	}
+}
+
+func (mr *monitoringRunner) handleReload(reason string) {
+	log.Info("reloading config...", "reason", reason)
+
+	err := mr.reloadConfig()
+	if err != nil {
+		log.Error("new config rejected, will keep the running config", "error", err.Error())
+		return
+	}
+
+	log.Info("config reloaded")
+}
+
+func (mr *monitoringRunner) waitForGracefulShutdown(
+	metricsServer metricsServerHandler,
 ) error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
-	<-quit
 
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	var configChanges <-chan struct{}
+	watcher, err := newConfigWatcher(mr.config.Flags.GeneralConfigPath, configWatchInterval)
+	if err != nil {
+		log.Warn("config file changes will not be watched", "error", err.Error())
+	} else {
+		watcher.start()
+		defer watcher.close()
+		configChanges = watcher.changes
+	}
+
+	for {
+		select {
+		case <-quit:
+			return mr.close(metricsServer)
+		case <-reload:
+			mr.handleReload("SIGHUP received")
+		case <-configChanges:
+			mr.handleReload("config file changed")
+		}
+	}
+}
+
+// NodesStatus returns the state of the monitored nodes, as fetched on the last check
+func (mr *monitoringRunner) NodesStatus() []status.NodeStatus {
+	mr.mutNodeRating.RLock()
+	defer mr.mutNodeRating.RUnlock()
+
+	return status.NewNodesStatus(mr.nodeRating.GetNodes(), mr.config.Alarms.NodeRating.Nodes)
+}
+
+// MonitoredNodes returns the configured public keys, with their labels
+func (mr *monitoringRunner) MonitoredNodes() []config.NodeLabels {
+	mr.mutNodeRating.RLock()
+	defer mr.mutNodeRating.RUnlock()
+
+	cfg := mr.config.Alarms.NodeRating
+	labelsByKey := make(map[string]config.NodeLabels)
+	for _, nodeLabels := range cfg.Nodes {
+		labelsByKey[nodeLabels.PubKey] = nodeLabels
+	}
+
+	monitoredNodes := make([]config.NodeLabels, 0, len(cfg.PubKeys))
+	for _, pubKey := range cfg.PubKeys {
+		nodeLabels := labelsByKey[pubKey]
+		nodeLabels.PubKey = pubKey
+		monitoredNodes = append(monitoredNodes, nodeLabels)
+	}
+
+	return monitoredNodes
+}
+
+// SendReport will push the report through the notifiers with the provided names, like Slack or Email
+func (mr *monitoringRunner) SendReport(notifierNames []string, msg data.NotificationMessage) error {
+	mr.mutNotifiers.RLock()
+	selected := selectNotifiers(mr.notifiers, notifierNames)
+	mr.mutNotifiers.RUnlock()
+
+	return mr.sendThrough(selected, notifierNames, msg, "report")
+}
+
+// SendAlerts will push the alerts through the workers of the notifiers with the provided names, so they are throttled
+// and grouped together with the alerts pushed to all the notifiers
+func (mr *monitoringRunner) SendAlerts(notifierNames []string, msg data.NotificationMessage) error {
+	mr.mutNotifiers.RLock()
+	selected := selectNotifiers(mr.workers, notifierNames)
+	mr.mutNotifiers.RUnlock()
+
+	return mr.sendThrough(selected, notifierNames, msg, "alerts")
+}
+
+// selectNotifiers returns the notifiers with the provided names, so they can be used without holding the lock, as a
+// push through a worker waits until its grouping window is sent. It should be called under mutex protection
+func selectNotifiers(notifiers map[string]process.Notifier, notifierNames []string) map[string]process.Notifier {
+	selected := make(map[string]process.Notifier, len(notifierNames))
+	for _, name := range notifierNames {
+		notifier, ok := notifiers[name]
+		if ok {
+			selected[name] = notifier
+		}
+	}
+
+	return selected
+}
+
+// sendThrough pushes the message through the notifiers concurrently, so a slow notifier does not delay the others
+func (mr *monitoringRunner) sendThrough(notifiers map[string]process.Notifier, notifierNames []string, msg data.NotificationMessage, kind string) error {
+	errs := make([]error, len(notifierNames))
+	wg := sync.WaitGroup{}
+	for i, name := range notifierNames {
+		notifier, ok := notifiers[name]
+		if !ok {
+			errs[i] = fmt.Errorf("%w: %s", ErrNotifierNotEnabled, name)
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, name string, notifier process.Notifier) {
+			defer wg.Done()
+
+			err := notifier.PushMessage(msg)
+			mr.metricsHandler.IncrementNotifierResult(notifier.GetID(), err == nil)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", name, err)
+			}
+		}(i, name, notifier)
+	}
+	wg.Wait()
+
+	var lastErr error
+	numFailed := 0
+	for _, err := range errs {
+		if err != nil {
+			numFailed++
+			lastErr = err
+		}
+	}
+	if lastErr != nil {
+		return fmt.Errorf("failed to send the %s through %d out of %d notifiers, last error: %w", kind, numFailed, len(notifierNames), lastErr)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *monitoringRunner) IsInterfaceNil() bool {
+	return mr == nil
+}
+
+func (mr *monitoringRunner) close(metricsServer metricsServerHandler) error {
 	log.Info("closing components...")
 
-	err := processor.Close()
+	err := mr.eventsProcessor.Close()
+	if err != nil {
+		return err
+	}
+
+	err = mr.escalator.Close()
 	if err != nil {
 		return err
 	}
 
+	mr.mutNotifiers.RLock()
+	workers := make([]process.Notifier, 0, len(mr.workers))
+	for _, worker := range mr.workers {
+		workers = append(workers, worker)
+	}
+	mr.mutNotifiers.RUnlock()
+	closeWorkers(workers)
+
+	if mr.telegramBot != nil {
+		err = mr.telegramBot.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if mr.slackInteractions != nil {
+		err = mr.slackInteractions.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if mr.digestScheduler != nil {
+		err = mr.digestScheduler.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if mr.epochReporter != nil {
+		err = mr.epochReporter.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if metricsServer != nil {
+		err = metricsServer.Close()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,102 @@
+package monitoring_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/monitoring"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/process/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitoringRunner_SendAlerts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should push through the workers concurrently, without holding the lock", func(t *testing.T) {
+		t.Parallel()
+
+		started := make(chan string, 2)
+		release := make(chan struct{})
+		createWorker := func(name string) process.Notifier {
+			return &mocks.NotifierStub{
+				PushMessageCalled: func(msg data.NotificationMessage) error {
+					started <- name
+					<-release
+					return nil
+				},
+				GetIDCalled: func() string {
+					return name
+				},
+			}
+		}
+
+		mr, err := monitoring.NewMonitoringRunner(&config.GeneralConfig{})
+		require.Nil(t, err)
+		mr.SetNotifyWorkers(map[string]process.Notifier{
+			"Slack":    createWorker("Slack"),
+			"Telegram": createWorker("Telegram"),
+		})
+
+		sendErr := make(chan error, 1)
+		go func() {
+			sendErr <- mr.SendAlerts([]string{"Slack", "Telegram"}, data.NotificationMessage{Message: "alert"})
+		}()
+
+		pushed := make(map[string]struct{})
+		for i := 0; i < 2; i++ {
+			select {
+			case name := <-started:
+				pushed[name] = struct{}{}
+			case <-time.After(time.Second):
+				require.Fail(t, "the workers should be pushed concurrently")
+			}
+		}
+		assert.Len(t, pushed, 2)
+
+		// a config reload is not held up by the pending pushes
+		locked := make(chan struct{})
+		go func() {
+			mr.LockNotifiers()
+			mr.UnlockNotifiers()
+			close(locked)
+		}()
+		select {
+		case <-locked:
+		case <-time.After(time.Second):
+			require.Fail(t, "the lock should not be held while pushing")
+		}
+
+		close(release)
+		select {
+		case err = <-sendErr:
+			assert.Nil(t, err)
+		case <-time.After(time.Second):
+			require.Fail(t, "send should return once the workers are done")
+		}
+	})
+
+	t.Run("should report the failing and the missing notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		pushErr := errors.New("push error")
+		mr, err := monitoring.NewMonitoringRunner(&config.GeneralConfig{})
+		require.Nil(t, err)
+		mr.SetNotifyWorkers(map[string]process.Notifier{
+			"Slack": &mocks.NotifierStub{
+				PushMessageCalled: func(msg data.NotificationMessage) error {
+					return pushErr
+				},
+			},
+		})
+
+		err = mr.SendAlerts([]string{"Slack", "Email"}, data.NotificationMessage{Message: "alert"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "2 out of 2 notifiers")
+		assert.True(t, errors.Is(err, monitoring.ErrNotifierNotEnabled))
+	})
+}
@@ -0,0 +1,353 @@
+package monitoring
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/console"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/discord"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/email"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/gotify"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/mattermost"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/ntfy"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/opsgenie"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/pagerduty"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/pushover"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/slack"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/teams"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/telegram"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/webhook"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/throttling"
+)
+
+// argsNotifierCreator defines the components needed to create the notifiers
+type argsNotifierCreator struct {
+	config        *config.Notifiers
+	httpClient    notifiers.HTTPClient
+	newMailSender func(cfg *config.Email) email.MailSender
+	output        io.Writer
+}
+
+// notifierCreator defines how a notifier is created out of its own config section
+type notifierCreator struct {
+	name      string
+	section   func(cfg *config.Notifiers) interface{}
+	isEnabled func(cfg *config.Notifiers) bool
+	create    func(args argsNotifierCreator) (process.Notifier, error)
+}
+
+func notifierCreators() []notifierCreator {
+	return []notifierCreator{
+		{
+			name: "Slack",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Slack
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Slack != nil && cfg.Slack.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return slack.NewSlackNotifier(slack.ArgsSlackNotifier{
+					Config:     args.config.Slack,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Telegram",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Telegram
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Telegram != nil && cfg.Telegram.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return telegram.NewTelegramNotifier(telegram.ArgsTelegramNotifier{
+					Config:     args.config.Telegram,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Discord",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Discord
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Discord != nil && cfg.Discord.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return discord.NewDiscordNotifier(discord.ArgsDiscordNotifier{
+					Config:     args.config.Discord,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Teams",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Teams
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Teams != nil && cfg.Teams.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return teams.NewTeamsNotifier(teams.ArgsTeamsNotifier{
+					Config:     args.config.Teams,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Mattermost",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Mattermost
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Mattermost != nil && cfg.Mattermost.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return mattermost.NewMattermostNotifier(mattermost.ArgsMattermostNotifier{
+					Config:     args.config.Mattermost,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "PagerDuty",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.PagerDuty
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.PagerDuty != nil && cfg.PagerDuty.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return pagerduty.NewPagerDutyNotifier(pagerduty.ArgsPagerDutyNotifier{
+					Config:     args.config.PagerDuty,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Opsgenie",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Opsgenie
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Opsgenie != nil && cfg.Opsgenie.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return opsgenie.NewOpsgenieNotifier(opsgenie.ArgsOpsgenieNotifier{
+					Config:     args.config.Opsgenie,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Webhook",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Webhook
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Webhook != nil && cfg.Webhook.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return webhook.NewWebhookNotifier(webhook.ArgsWebhookNotifier{
+					Config:     args.config.Webhook,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Ntfy",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Ntfy
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Ntfy != nil && cfg.Ntfy.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return ntfy.NewNtfyNotifier(ntfy.ArgsNtfyNotifier{
+					Config:     args.config.Ntfy,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Gotify",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Gotify
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Gotify != nil && cfg.Gotify.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return gotify.NewGotifyNotifier(gotify.ArgsGotifyNotifier{
+					Config:     args.config.Gotify,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Pushover",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Pushover
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Pushover != nil && cfg.Pushover.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return pushover.NewPushoverNotifier(pushover.ArgsPushoverNotifier{
+					Config:     args.config.Pushover,
+					HTTPClient: args.httpClient,
+				})
+			},
+		},
+		{
+			name: "Email",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Email
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Email != nil && cfg.Email.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return email.NewEmailNotifier(email.ArgsEmailNotifier{
+					Config: args.config.Email,
+					Sender: args.newMailSender(args.config.Email),
+				})
+			},
+		},
+		{
+			name: "Console",
+			section: func(cfg *config.Notifiers) interface{} {
+				return cfg.Console
+			},
+			isEnabled: func(cfg *config.Notifiers) bool {
+				return cfg.Console != nil && cfg.Console.Enabled
+			},
+			create: func(args argsNotifierCreator) (process.Notifier, error) {
+				return console.NewConsoleNotifier(console.ArgsConsoleNotifier{
+					Writer: args.output,
+				})
+			},
+		},
+	}
+}
+
+// CreateNotifiers will create the enabled notifiers in the same way the monitoring runner does, mapped by name
+func CreateNotifiers(cfg *config.Notifiers, flags *config.FlagsConfig) (map[string]process.Notifier, error) {
+	args, err := createArgsNotifierCreator(cfg, flags.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return createNotifiers(args)
+}
+
+// createArgsNotifierCreator will create the components used by the notifiers to deliver the notifications.
+// In dry-run mode, the notifications are written to stdout exactly as they would have been sent
+func createArgsNotifierCreator(cfg *config.Notifiers, dryRun bool) (argsNotifierCreator, error) {
+	args := argsNotifierCreator{
+		config: cfg,
+		output: os.Stdout,
+	}
+
+	if !dryRun {
+		httpClient, err := createHTTPClient()
+		if err != nil {
+			return argsNotifierCreator{}, err
+		}
+
+		args.httpClient = httpClient
+		args.newMailSender = func(cfg *config.Email) email.MailSender {
+			return email.NewSMTPSender(cfg)
+		}
+
+		return args, nil
+	}
+
+	log.Info("dry-run mode: the notifications will be written to stdout instead of being sent")
+
+	dryRunHTTPClient, err := console.NewDryRunHTTPClient(args.output)
+	if err != nil {
+		return argsNotifierCreator{}, err
+	}
+	dryRunMailSender, err := console.NewDryRunMailSender(args.output)
+	if err != nil {
+		return argsNotifierCreator{}, err
+	}
+
+	args.httpClient = dryRunHTTPClient
+	args.newMailSender = func(_ *config.Email) email.MailSender {
+		return dryRunMailSender
+	}
+
+	return args, nil
+}
+
+// createNotifiers will create all the enabled notifiers, mapped by their creator name
+func createNotifiers(args argsNotifierCreator) (map[string]process.Notifier, error) {
+	createdNotifiers := make(map[string]process.Notifier)
+	for _, creator := range notifierCreators() {
+		if !creator.isEnabled(args.config) {
+			continue
+		}
+
+		notifier, err := creator.create(args)
+		if err != nil {
+			return nil, err
+		}
+
+		createdNotifiers[creator.name] = notifier
+	}
+
+	return createdNotifiers, nil
+}
+
+// createNotifyWorkers returns the notifiers to be used by the notify processor: when the throttling is enabled,
+// every notifier is wrapped with its rate limit, otherwise the notifiers are used as they are
+func createNotifyWorkers(cfg *config.Throttling, createdNotifiers map[string]process.Notifier) (map[string]process.Notifier, error) {
+	workers := make(map[string]process.Notifier)
+	for name, notifier := range createdNotifiers {
+		if notifier == nil || cfg == nil || !cfg.Enabled {
+			workers[name] = notifier
+			continue
+		}
+
+		rateLimit, ok := cfg.Notifiers[name]
+		if !ok {
+			rateLimit = cfg.Default
+		}
+		worker, err := throttling.NewThrottledNotifier(throttling.ArgsThrottledNotifier{
+			Notifier:       notifier,
+			RateLimit:      rateLimit,
+			GroupingWindow: time.Duration(cfg.GroupingWindowSec) * time.Second,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s notifier: %w", name, err)
+		}
+
+		workers[name] = worker
+	}
+
+	return workers, nil
+}
+
+func createHTTPClient() (notifiers.HTTPClient, error) {
+	clientArgs := clients.HTTPClientWrapperArgs{
+		ReqTimeoutSec: reqTimeoutSec,
+	}
+	httpClientWrapper, err := clients.NewHTTPClientWrapper(clientArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpClientWrapper, nil
+}
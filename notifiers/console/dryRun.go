@@ -0,0 +1,92 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+// dryRunWriter serializes the writes of a dry-run transport, so that concurrent notifications do not interleave
+type dryRunWriter struct {
+	writer   io.Writer
+	mutWrite sync.Mutex
+}
+
+func (drw *dryRunWriter) write(header string, body []byte) error {
+	drw.mutWrite.Lock()
+	defer drw.mutWrite.Unlock()
+
+	_, err := fmt.Fprintf(drw.writer, "----- [dry-run] %s -----\n%s\n", header, strings.TrimRight(string(body), "\r\n"))
+
+	return err
+}
+
+type dryRunHTTPClient struct {
+	*dryRunWriter
+}
+
+// NewDryRunHTTPClient will create a http client which writes the requests to the provided writer instead of sending them
+func NewDryRunHTTPClient(writer io.Writer) (*dryRunHTTPClient, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	return &dryRunHTTPClient{
+		dryRunWriter: &dryRunWriter{writer: writer},
+	}, nil
+}
+
+// CallGetRestEndPoint will write the request and will return an empty response
+func (dhc *dryRunHTTPClient) CallGetRestEndPoint(address string, path string) ([]byte, error) {
+	return nil, dhc.write("GET "+address+path, nil)
+}
+
+// CallPostRestEndPoint will write the request and the payload, marshaled exactly as it would be sent
+func (dhc *dryRunHTTPClient) CallPostRestEndPoint(address string, path string, data interface{}) error {
+	buff, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return dhc.write("POST "+address+path, buff)
+}
+
+// CallRestEndPoint will write the request and its body, without the headers as they usually hold credentials,
+// and will return an empty response
+func (dhc *dryRunHTTPClient) CallRestEndPoint(request data.HTTPRequest) ([]byte, error) {
+	return nil, dhc.write(request.Method+" "+request.URL, request.Body)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dhc *dryRunHTTPClient) IsInterfaceNil() bool {
+	return dhc == nil
+}
+
+type dryRunMailSender struct {
+	*dryRunWriter
+}
+
+// NewDryRunMailSender will create a mail sender which writes the messages to the provided writer instead of sending them
+func NewDryRunMailSender(writer io.Writer) (*dryRunMailSender, error) {
+	if writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	return &dryRunMailSender{
+		dryRunWriter: &dryRunWriter{writer: writer},
+	}, nil
+}
+
+// SendMail will write the envelope and the full email message
+func (dms *dryRunMailSender) SendMail(from string, to []string, msg []byte) error {
+	return dms.write(fmt.Sprintf("MAIL FROM: %s RCPT TO: %s", from, strings.Join(to, ", ")), msg)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dms *dryRunMailSender) IsInterfaceNil() bool {
+	return dms == nil
+}
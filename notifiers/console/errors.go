@@ -0,0 +1,6 @@
+package console
+
+import "errors"
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
@@ -0,0 +1,60 @@
+package console
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// ArgsConsoleNotifier defines the arguments needed to create a new console notifier
+type ArgsConsoleNotifier struct {
+	Writer io.Writer
+}
+
+type consoleNotifier struct {
+	writer   io.Writer
+	mutWrite sync.Mutex
+}
+
+// NewConsoleNotifier will create a new notifier which writes the notifications to the provided writer (like stdout)
+func NewConsoleNotifier(args ArgsConsoleNotifier) (*consoleNotifier, error) {
+	if args.Writer == nil {
+		return nil, ErrNilWriter
+	}
+
+	return &consoleNotifier{
+		writer: args.Writer,
+	}, nil
+}
+
+// PushMessage will write the notification
+func (cn *consoleNotifier) PushMessage(msg data.NotificationMessage) error {
+	cn.mutWrite.Lock()
+	defer cn.mutWrite.Unlock()
+
+	_, err := fmt.Fprintf(
+		cn.writer,
+		"[%s] %s: %s\n",
+		time.Now().Format(timeLayout),
+		strings.ToUpper(msg.Level.String()),
+		strings.TrimSpace(msg.Message),
+	)
+
+	return err
+}
+
+// GetID will return the identifier for console notifier
+func (cn *consoleNotifier) GetID() string {
+	return "Console"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cn *consoleNotifier) IsInterfaceNil() bool {
+	return cn == nil
+}
@@ -0,0 +1,87 @@
+package console_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/console"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConsoleNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil writer", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := console.NewConsoleNotifier(console.ArgsConsoleNotifier{})
+		require.Nil(t, cn)
+		require.Equal(t, console.ErrNilWriter, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cn, err := console.NewConsoleNotifier(console.ArgsConsoleNotifier{Writer: &bytes.Buffer{}})
+		require.Nil(t, err)
+		require.False(t, cn.IsInterfaceNil())
+		require.Equal(t, "Console", cn.GetID())
+	})
+}
+
+func TestConsoleNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	cn, _ := console.NewConsoleNotifier(console.ArgsConsoleNotifier{Writer: buff})
+
+	err := cn.PushMessage(data.NotificationMessage{
+		Message: "NodeName: node-0 - TempRating decreased\n",
+		Level:   common.CriticalEvent,
+	})
+	require.Nil(t, err)
+	assert.Contains(t, buff.String(), "] CRITICAL: NodeName: node-0 - TempRating decreased\n")
+}
+
+func TestDryRunHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	drc, err := console.NewDryRunHTTPClient(nil)
+	require.Nil(t, drc)
+	require.Equal(t, console.ErrNilWriter, err)
+
+	buff := &bytes.Buffer{}
+	drc, err = console.NewDryRunHTTPClient(buff)
+	require.Nil(t, err)
+	require.False(t, drc.IsInterfaceNil())
+
+	err = drc.CallPostRestEndPoint("https://hooks.slack.com", "/services/X", map[string]string{"text": "message"})
+	require.Nil(t, err)
+	assert.Equal(t, "----- [dry-run] POST https://hooks.slack.com/services/X -----\n{\"text\":\"message\"}\n", buff.String())
+
+	buff.Reset()
+	response, err := drc.CallGetRestEndPoint("https://api.telegram.org", "/getUpdates")
+	require.Nil(t, err)
+	assert.Nil(t, response)
+	assert.Equal(t, "----- [dry-run] GET https://api.telegram.org/getUpdates -----\n\n", buff.String())
+}
+
+func TestDryRunMailSender(t *testing.T) {
+	t.Parallel()
+
+	dms, err := console.NewDryRunMailSender(nil)
+	require.Nil(t, dms)
+	require.Equal(t, console.ErrNilWriter, err)
+
+	buff := &bytes.Buffer{}
+	dms, err = console.NewDryRunMailSender(buff)
+	require.Nil(t, err)
+	require.False(t, dms.IsInterfaceNil())
+
+	err = dms.SendMail("from@mail.com", []string{"to1@mail.com", "to2@mail.com"}, []byte("Subject: test\r\n\r\nbody\r\n"))
+	require.Nil(t, err)
+	assert.Equal(t, "----- [dry-run] MAIL FROM: from@mail.com RCPT TO: to1@mail.com, to2@mail.com -----\nSubject: test\r\n\r\nbody\n", buff.String())
+}
@@ -0,0 +1,9 @@
+package discord
+
+import "errors"
+
+// ErrInvalidDiscordURL signals that an empty url has been provided
+var ErrInvalidDiscordURL = errors.New("empty discord url has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
@@ -0,0 +1,8 @@
+package discord
+
+import "time"
+
+// SetSleepHandler -
+func (dn *discordNotifier) SetSleepHandler(handler func(duration time.Duration)) {
+	dn.sleepHandler = handler
+}
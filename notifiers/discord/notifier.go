@@ -0,0 +1,225 @@
+package discord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+var log = logger.GetOrCreate("discordNotifier")
+
+// discord limits, see https://discord.com/developers/docs/resources/channel#embed-object-embed-limits
+const (
+	maxFieldsPerEmbed   = 25
+	maxFieldNameLength  = 256
+	maxFieldValueLength = 1024
+	maxDescriptionLen   = 4096
+	// the total limit is 6000 characters per message, the rest is left for the title and the footer
+	maxEmbedFieldsLength = 5000
+)
+
+const (
+	maxRetries    = 3
+	maxRetryAfter = 30 * time.Second
+)
+
+type payload struct {
+	Embeds []embed `json:"embeds"`
+}
+
+type embed struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color"`
+	Timestamp   string       `json:"timestamp"`
+	Fields      []embedField `json:"fields,omitempty"`
+	Footer      embedFooter  `json:"footer"`
+}
+
+type embedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type embedFooter struct {
+	Text string `json:"text"`
+}
+
+type rateLimitResponse struct {
+	RetryAfter float64 `json:"retry_after"`
+}
+
+// ArgsDiscordNotifier defines the arguments needed to create a new discord notifier
+type ArgsDiscordNotifier struct {
+	Config     *config.Discord
+	HTTPClient notifiers.HTTPClient
+}
+
+type discordNotifier struct {
+	url          string
+	explorerUrl  string
+	httpClient   notifiers.HTTPClient
+	sleepHandler func(duration time.Duration)
+}
+
+// NewDiscordNotifier will create a new discord notifier instance
+func NewDiscordNotifier(args ArgsDiscordNotifier) (*discordNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordNotifier{
+		url:          args.Config.URL,
+		explorerUrl:  args.Config.ExplorerUrl,
+		httpClient:   args.HTTPClient,
+		sleepHandler: time.Sleep,
+	}, nil
+}
+
+func checkArgs(args ArgsDiscordNotifier) error {
+	if args.Config.URL == "" {
+		return ErrInvalidDiscordURL
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will push the notification as embeds, with one field for every affected node
+func (dn *discordNotifier) PushMessage(msg data.NotificationMessage) error {
+	for _, msgEmbed := range dn.createEmbeds(msg) {
+		err := dn.send(payload{Embeds: []embed{msgEmbed}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dn *discordNotifier) createEmbeds(msg data.NotificationMessage) []embed {
+	newEmbed := func() embed {
+		return embed{
+			Title:     notifiers.Title(msg.Level),
+			Color:     notifiers.LevelColor(msg.Level),
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Footer:    embedFooter{Text: notifiers.Footer},
+		}
+	}
+
+	if len(msg.Alerts) == 0 {
+		msgEmbed := newEmbed()
+		msgEmbed.Description = truncate(msg.Message, maxDescriptionLen)
+		return []embed{msgEmbed}
+	}
+
+	embeds := make([]embed, 0, 1)
+	current := newEmbed()
+	currentLength := 0
+	for _, alert := range msg.Alerts {
+		field := dn.createField(alert)
+		fieldLength := utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+
+		isFull := len(current.Fields) == maxFieldsPerEmbed || currentLength+fieldLength > maxEmbedFieldsLength
+		if len(current.Fields) > 0 && isFull {
+			embeds = append(embeds, current)
+			current = newEmbed()
+			currentLength = 0
+		}
+
+		current.Fields = append(current.Fields, field)
+		currentLength += fieldLength
+	}
+	embeds = append(embeds, current)
+
+	return embeds
+}
+
+func (dn *discordNotifier) createField(alert data.Alert) embedField {
+	value := fmt.Sprintf("%s\nAlert `%s`", alert.Message, alert.ID)
+	explorerURL := notifiers.NodeExplorerURL(dn.explorerUrl, alert.PubKey)
+	if explorerURL != "" {
+		value += fmt.Sprintf(" - [Explorer](%s)", explorerURL)
+	}
+
+	return embedField{
+		Name:  truncate(alert.DisplayName(), maxFieldNameLength),
+		Value: truncate(value, maxFieldValueLength),
+	}
+}
+
+// send will post the payload, waiting and retrying as requested by discord when rate limited
+func (dn *discordNotifier) send(msgPayload payload) error {
+	var err error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		err = dn.httpClient.CallPostRestEndPoint(dn.url, "", msgPayload)
+		if err == nil {
+			return nil
+		}
+
+		retryAfter, isRateLimited := getRetryAfter(err)
+		if !isRateLimited || attempt == maxRetries {
+			return err
+		}
+
+		log.Debug("discord rate limit hit, will retry", "retry after", retryAfter)
+		dn.sleepHandler(retryAfter)
+	}
+
+	return err
+}
+
+// getRetryAfter returns the time to wait before retrying, if the error is a discord rate limit response
+func getRetryAfter(err error) (time.Duration, bool) {
+	var statusErr *common.HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	response := rateLimitResponse{}
+	errUnmarshal := json.Unmarshal(statusErr.Body, &response)
+	if errUnmarshal != nil || response.RetryAfter <= 0 {
+		return time.Second, true
+	}
+
+	retryAfter := time.Duration(response.RetryAfter * float64(time.Second))
+	if retryAfter > maxRetryAfter {
+		return maxRetryAfter, true
+	}
+
+	return retryAfter, true
+}
+
+func truncate(text string, maxLength int) string {
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+
+	runes := []rune(text)
+
+	return string(runes[:maxLength-3]) + "..."
+}
+
+// GetID will return the identifier for discord notifier
+func (dn *discordNotifier) GetID() string {
+	return "Discord"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dn *discordNotifier) IsInterfaceNil() bool {
+	return dn == nil
+}
@@ -0,0 +1,256 @@
+package discord_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/discord"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedEmbed struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Color       int    `json:"color"`
+	Timestamp   string `json:"timestamp"`
+	Fields      []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"fields"`
+}
+
+type receivedPayload struct {
+	Embeds []receivedEmbed `json:"embeds"`
+}
+
+type discordStubServer struct {
+	*httptest.Server
+	mut            sync.Mutex
+	payloads       []receivedPayload
+	numRateLimited int
+}
+
+func newDiscordStubServer(t *testing.T, numRateLimited int) *discordStubServer {
+	stub := &discordStubServer{numRateLimited: numRateLimited}
+	stub.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stub.mut.Lock()
+		defer stub.mut.Unlock()
+
+		if stub.numRateLimited > 0 {
+			stub.numRateLimited--
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "You are being rate limited.", "retry_after": 0.25, "global": false}`))
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+
+		receivedPayload := receivedPayload{}
+		err = json.Unmarshal(body, &receivedPayload)
+		require.Nil(t, err)
+		stub.payloads = append(stub.payloads, receivedPayload)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	return stub
+}
+
+func createMockDiscordNotifierArgs() discord.ArgsDiscordNotifier {
+	return discord.ArgsDiscordNotifier{
+		Config: &config.Discord{
+			Enabled:     true,
+			URL:         "http://localhost",
+			ExplorerUrl: "https://explorer.multiversx.com/",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+func createArgsWithServer(t *testing.T, server *discordStubServer) discord.ArgsDiscordNotifier {
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 5})
+	require.Nil(t, err)
+
+	args := createMockDiscordNotifierArgs()
+	args.Config.URL = server.URL
+	args.HTTPClient = httpClient
+
+	return args
+}
+
+func createAlerts(numAlerts int) []data.Alert {
+	alerts := make([]data.Alert, 0, numAlerts)
+	for i := 0; i < numAlerts; i++ {
+		pubKey := fmt.Sprintf("key%d", i)
+		alerts = append(alerts, data.Alert{
+			ID:       data.NewAlertID("client", pubKey, "condition"),
+			PubKey:   pubKey,
+			NodeName: fmt.Sprintf("node-%d", i),
+			Level:    common.CriticalEvent,
+			Message:  fmt.Sprintf("node-%d rating decreased", i),
+			Changed:  true,
+		})
+	}
+
+	return alerts
+}
+
+func TestNewDiscordNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url string", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDiscordNotifierArgs()
+		args.Config.URL = ""
+
+		dn, err := discord.NewDiscordNotifier(args)
+		require.Nil(t, dn)
+		require.Equal(t, discord.ErrInvalidDiscordURL, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDiscordNotifierArgs()
+		args.HTTPClient = nil
+
+		dn, err := discord.NewDiscordNotifier(args)
+		require.Nil(t, dn)
+		require.Equal(t, discord.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dn, err := discord.NewDiscordNotifier(createMockDiscordNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, dn.IsInterfaceNil())
+		assert.Equal(t, "Discord", dn.GetID())
+	})
+}
+
+func TestDiscordNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("message without alerts", func(t *testing.T) {
+		t.Parallel()
+
+		server := newDiscordStubServer(t, 0)
+		defer server.Close()
+
+		dn, err := discord.NewDiscordNotifier(createArgsWithServer(t, server))
+		require.Nil(t, err)
+
+		err = dn.PushMessage(data.NotificationMessage{Message: "test message", Level: common.WarningEvent})
+		require.Nil(t, err)
+
+		require.Len(t, server.payloads, 1)
+		require.Len(t, server.payloads[0].Embeds, 1)
+		receivedEmbed := server.payloads[0].Embeds[0]
+		assert.Equal(t, "Node monitoring - WARNING", receivedEmbed.Title)
+		assert.Equal(t, "test message", receivedEmbed.Description)
+		assert.Equal(t, 0xF1C40F, receivedEmbed.Color)
+		assert.Empty(t, receivedEmbed.Fields)
+		_, err = time.Parse(time.RFC3339, receivedEmbed.Timestamp)
+		assert.Nil(t, err)
+	})
+
+	t.Run("one field per node", func(t *testing.T) {
+		t.Parallel()
+
+		server := newDiscordStubServer(t, 0)
+		defer server.Close()
+
+		dn, err := discord.NewDiscordNotifier(createArgsWithServer(t, server))
+		require.Nil(t, err)
+
+		alerts := createAlerts(2)
+		err = dn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: alerts})
+		require.Nil(t, err)
+
+		require.Len(t, server.payloads, 1)
+		receivedEmbed := server.payloads[0].Embeds[0]
+		assert.Equal(t, 0xE74C3C, receivedEmbed.Color)
+		require.Len(t, receivedEmbed.Fields, 2)
+		assert.Equal(t, "node-1", receivedEmbed.Fields[1].Name)
+		assert.Equal(t,
+			fmt.Sprintf("node-1 rating decreased\nAlert `%s` - [Explorer](https://explorer.multiversx.com/nodes/key1)", alerts[1].ID),
+			receivedEmbed.Fields[1].Value,
+		)
+	})
+
+	t.Run("too many nodes should be split in multiple embeds", func(t *testing.T) {
+		t.Parallel()
+
+		server := newDiscordStubServer(t, 0)
+		defer server.Close()
+
+		dn, err := discord.NewDiscordNotifier(createArgsWithServer(t, server))
+		require.Nil(t, err)
+
+		err = dn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: createAlerts(30)})
+		require.Nil(t, err)
+
+		require.Len(t, server.payloads, 2)
+		assert.Len(t, server.payloads[0].Embeds[0].Fields, 25)
+		assert.Len(t, server.payloads[1].Embeds[0].Fields, 5)
+	})
+
+	t.Run("rate limited should retry after the requested time", func(t *testing.T) {
+		t.Parallel()
+
+		server := newDiscordStubServer(t, 2)
+		defer server.Close()
+
+		dn, err := discord.NewDiscordNotifier(createArgsWithServer(t, server))
+		require.Nil(t, err)
+
+		sleeps := make([]time.Duration, 0)
+		dn.SetSleepHandler(func(duration time.Duration) {
+			sleeps = append(sleeps, duration)
+		})
+
+		err = dn.PushMessage(data.NotificationMessage{Message: "message", Level: common.CriticalEvent})
+		require.Nil(t, err)
+
+		assert.Equal(t, []time.Duration{time.Millisecond * 250, time.Millisecond * 250}, sleeps)
+		assert.Len(t, server.payloads, 1)
+	})
+
+	t.Run("rate limited too many times should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := newDiscordStubServer(t, 10)
+		defer server.Close()
+
+		dn, err := discord.NewDiscordNotifier(createArgsWithServer(t, server))
+		require.Nil(t, err)
+
+		numSleeps := 0
+		dn.SetSleepHandler(func(duration time.Duration) {
+			numSleeps++
+		})
+
+		err = dn.PushMessage(data.NotificationMessage{Message: "message", Level: common.CriticalEvent})
+		var statusErr *common.HTTPStatusError
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusTooManyRequests, statusErr.StatusCode)
+		assert.Equal(t, 3, numSleeps)
+		assert.Empty(t, server.payloads)
+	})
+}
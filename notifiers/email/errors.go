@@ -13,3 +13,18 @@ var ErrInvalidEmailHostPort = errors.New("invalid email host port")
 
 // ErrInvalidEmailHost signals that an empty email host have been provided in config
 var ErrInvalidEmailHost = errors.New("empty email host provided in config")
+
+// ErrNilMailSender signals that a nil mail sender has been provided
+var ErrNilMailSender = errors.New("nil mail sender")
+
+// ErrEmptyEmailFrom signals that an empty from address has been provided
+var ErrEmptyEmailFrom = errors.New("empty email from address")
+
+// ErrInvalidTLSMode signals that an invalid TLS mode has been provided
+var ErrInvalidTLSMode = errors.New("invalid email TLS mode")
+
+// ErrStartTLSNotSupported signals that the smtp server does not support the STARTTLS extension
+var ErrStartTLSNotSupported = errors.New("smtp server does not support STARTTLS")
+
+// ErrAuthNotSupported signals that the smtp server does not support authentication
+var ErrAuthNotSupported = errors.New("smtp server does not support AUTH")
@@ -0,0 +1,8 @@
+package email
+
+import "time"
+
+// SetGetTimeHandler -
+func (en *emailNotifier) SetGetTimeHandler(handler func() time.Time) {
+	en.getTimeHandler = handler
+}
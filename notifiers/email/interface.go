@@ -0,0 +1,7 @@
+package email
+
+// MailSender defines the behaviour of a component able to deliver an already built email message
+type MailSender interface {
+	SendMail(from string, to []string, msg []byte) error
+	IsInterfaceNil() bool
+}
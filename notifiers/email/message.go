@@ -0,0 +1,272 @@
+package email
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	maxSubjectLength = 120
+	maxSubjectNodes  = 3
+	// RFC 5322 recommends at most 78 characters per line
+	maxHeaderLineLength = 78
+	crlf                = "\r\n"
+	defaultIDHostname   = "localhost"
+)
+
+var htmlTemplate = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: Arial, Helvetica, sans-serif; font-size: 14px; color: #1D1C1D;">
+<div style="border-left: 4px solid {{.Color}}; padding-left: 12px;">
+<h2 style="margin: 0 0 12px 0;">{{.Title}}</h2>
+{{- if .Alerts}}
+<table cellpadding="6" cellspacing="0" style="border-collapse: collapse;">
+<tr style="background: #F4F4F4; text-align: left;"><th>Node</th><th>Alert</th><th>Level</th><th>Temp rating</th><th>Details</th></tr>
+{{- range .Alerts}}
+<tr style="border-top: 1px solid #DDDDDD;">
+<td>{{if .ExplorerURL}}<a href="{{.ExplorerURL}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}</td>
+<td><code>{{.ID}}</code></td>
+<td>{{.Level}}</td>
+<td>{{.Rating}}</td>
+<td>{{.Message}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Lines}}
+<p style="margin: 4px 0;">{{.}}</p>
+{{- end}}
+</div>
+<p style="color: #888888; font-size: 12px;">{{.Footer}}</p>
+</body>
+</html>
+`))
+
+type htmlData struct {
+	Title  string
+	Color  string
+	Alerts []htmlAlert
+	Lines  []string
+	Footer string
+}
+
+type htmlAlert struct {
+	Name        string
+	ID          string
+	Level       string
+	Rating      string
+	Message     string
+	ExplorerURL string
+}
+
+// createMessage builds a multipart/alternative message, with a plain text and an html version of the notification
+func (en *emailNotifier) createMessage(msg data.NotificationMessage) ([]byte, error) {
+	htmlBody, err := en.createHTMLBody(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	now := en.getTimeHandler()
+	boundary := randomHex(16)
+
+	buff := &bytes.Buffer{}
+	writeHeader(buff, "From", en.from.String())
+	writeHeader(buff, "To", formatAddresses(en.to))
+	writeHeader(buff, "Subject", mime.QEncoding.Encode("utf-8", createSubject(msg)))
+	writeHeader(buff, "Date", now.Format(time.RFC1123Z))
+	writeHeader(buff, "Message-ID", fmt.Sprintf("<%d.%s@%s>", now.UnixNano(), randomHex(8), messageIDHostname(en.from.Address)))
+	writeHeader(buff, "MIME-Version", "1.0")
+	writeHeader(buff, "Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary))
+	buff.WriteString(crlf)
+
+	err = writePart(buff, boundary, "text/plain; charset=utf-8", createTextBody(msg))
+	if err != nil {
+		return nil, err
+	}
+	err = writePart(buff, boundary, "text/html; charset=utf-8", htmlBody)
+	if err != nil {
+		return nil, err
+	}
+	buff.WriteString("--" + boundary + "--" + crlf)
+
+	return buff.Bytes(), nil
+}
+
+// createSubject summarizes the notification: the level, followed by the affected nodes or by the first line
+// of the message
+func createSubject(msg data.NotificationMessage) string {
+	level := strings.ToUpper(msg.Level.String())
+	alerts := msg.Alerts
+	if len(alerts) == 0 && len(msg.Resolved) > 0 {
+		level = "RESOLVED"
+		alerts = msg.Resolved
+	}
+
+	var summary string
+	switch {
+	case len(alerts) == 1:
+		summary = fmt.Sprintf("%s: %s", alerts[0].DisplayName(), alerts[0].Message)
+	case len(alerts) > 1:
+		summary = fmt.Sprintf("%d nodes: %s", len(alerts), nodeNames(alerts))
+	default:
+		summary = strings.SplitN(strings.TrimSpace(msg.Message), "\n", 2)[0]
+		if summary == "" {
+			summary = "Node monitoring"
+		}
+	}
+
+	return notifiers.Truncate(fmt.Sprintf("[%s] %s", level, summary), maxSubjectLength)
+}
+
+func nodeNames(alerts []data.Alert) string {
+	names := make([]string, 0, len(alerts))
+	seen := make(map[string]struct{})
+	for _, alert := range alerts {
+		name := alert.DisplayName()
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(names) <= maxSubjectNodes {
+		return strings.Join(names, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(names[:maxSubjectNodes], ", "), len(names)-maxSubjectNodes)
+}
+
+func createTextBody(msg data.NotificationMessage) string {
+	lines := []string{notifiers.Title(msg.Level), ""}
+	for _, alert := range msg.Alerts {
+		lines = append(lines, fmt.Sprintf("[%s] %s: %s", alert.ID, alert.DisplayName(), alert.Message))
+	}
+	for _, alert := range msg.Resolved {
+		lines = append(lines, fmt.Sprintf("[%s] Resolved - %s", alert.ID, alert.DisplayName()))
+	}
+	if len(msg.Alerts)+len(msg.Resolved) == 0 {
+		lines = append(lines, strings.TrimSpace(msg.Message))
+	}
+	lines = append(lines, "", "-- ", notifiers.Footer)
+
+	return strings.Join(lines, crlf) + crlf
+}
+
+func (en *emailNotifier) createHTMLBody(msg data.NotificationMessage) (string, error) {
+	content := htmlData{
+		Title:  notifiers.Title(msg.Level),
+		Color:  notifiers.LevelHexColor(msg.Level),
+		Footer: notifiers.Footer,
+	}
+	for _, alert := range msg.Alerts {
+		content.Alerts = append(content.Alerts, en.createHTMLAlert(alert))
+	}
+	for _, alert := range msg.Resolved {
+		htmlResolved := en.createHTMLAlert(alert)
+		htmlResolved.Level = "resolved"
+		content.Alerts = append(content.Alerts, htmlResolved)
+	}
+	if len(content.Alerts) == 0 {
+		for _, line := range strings.Split(strings.TrimSpace(msg.Message), "\n") {
+			content.Lines = append(content.Lines, line)
+		}
+	}
+
+	buff := &bytes.Buffer{}
+	err := htmlTemplate.Execute(buff, content)
+	if err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+func (en *emailNotifier) createHTMLAlert(alert data.Alert) htmlAlert {
+	rating := fmt.Sprintf("%.2f", alert.CurrentValue)
+	if alert.PreviousValue != 0 {
+		rating = fmt.Sprintf("%.2f → %.2f", alert.PreviousValue, alert.CurrentValue)
+	}
+
+	return htmlAlert{
+		Name:        alert.DisplayName(),
+		ID:          alert.ID,
+		Level:       alert.Level.String(),
+		Rating:      rating,
+		Message:     alert.Message,
+		ExplorerURL: notifiers.NodeExplorerURL(en.explorerUrl, alert.PubKey),
+	}
+}
+
+// writeHeader writes the header folded at spaces, so the lines are kept under the recommended length
+func writeHeader(buff *bytes.Buffer, name string, value string) {
+	line := name + ":"
+	for _, word := range strings.Split(value, " ") {
+		if len(line)+1+len(word) > maxHeaderLineLength && strings.TrimSpace(line) != name+":" {
+			buff.WriteString(line + crlf)
+			line = ""
+		}
+		line += " " + word
+	}
+	buff.WriteString(line + crlf)
+}
+
+// writePart writes a quoted-printable encoded part, so the long lines and the non ascii characters are kept
+// within the limits of RFC 5322
+func writePart(buff *bytes.Buffer, boundary string, contentType string, body string) error {
+	buff.WriteString("--" + boundary + crlf)
+	writeHeader(buff, "Content-Type", contentType)
+	writeHeader(buff, "Content-Transfer-Encoding", "quoted-printable")
+	buff.WriteString(crlf)
+
+	writer := quotedprintable.NewWriter(buff)
+	_, err := writer.Write([]byte(body))
+	if err != nil {
+		return err
+	}
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+	buff.WriteString(crlf)
+
+	return nil
+}
+
+func formatAddresses(addresses []*mail.Address) string {
+	formatted := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		formatted = append(formatted, address.String())
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func messageIDHostname(address string) string {
+	at := strings.LastIndex(address, "@")
+	if at < 0 || at == len(address)-1 {
+		return defaultIDHostname
+	}
+
+	return address[at+1:]
+}
+
+func randomHex(numBytes int) string {
+	buff := make([]byte, numBytes)
+	_, _ = rand.Read(buff)
+
+	return hex.EncodeToString(buff)
+}
@@ -2,8 +2,10 @@ package email
 
 import (
 	"fmt"
-	"net/smtp"
+	"net/mail"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-node-monitoring/config"
 	"github.com/multiversx/mx-chain-node-monitoring/data"
@@ -14,10 +16,15 @@ var log = logger.GetOrCreate("eventNotifier")
 // ArgsEmailNotifier defines the arguments needed to create a new email notifier
 type ArgsEmailNotifier struct {
 	Config *config.Email
+	Sender MailSender
 }
 
 type emailNotifier struct {
-	config *config.Email
+	from           *mail.Address
+	to             []*mail.Address
+	explorerUrl    string
+	sender         MailSender
+	getTimeHandler func() time.Time
 }
 
 // NewEmailNotifier will create a new email notifier instance
@@ -27,14 +34,35 @@ func NewEmailNotifier(args ArgsEmailNotifier) (*emailNotifier, error) {
 		return nil, err
 	}
 
+	from, err := parseFrom(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	to := make([]*mail.Address, 0, len(args.Config.To))
+	for _, address := range args.Config.To {
+		parsedAddress, errParse := mail.ParseAddress(address)
+		if errParse != nil {
+			return nil, fmt.Errorf("invalid email to address %q: %w", address, errParse)
+		}
+		to = append(to, parsedAddress)
+	}
+
 	return &emailNotifier{
-		config: args.Config,
+		from:           from,
+		to:             to,
+		explorerUrl:    args.Config.ExplorerUrl,
+		sender:         args.Sender,
+		getTimeHandler: time.Now,
 	}, nil
 }
 
 func checkArgs(args ArgsEmailNotifier) error {
-	if args.Config.EmailUsername == "" ||
-		args.Config.EmailPassword == "" {
+	if check.IfNil(args.Sender) {
+		return ErrNilMailSender
+	}
+	// the credentials are optional, for the relays which do not require authentication
+	if (args.Config.EmailUsername == "") != (args.Config.EmailPassword == "") {
 		return ErrInvalidEmailCredentials
 	}
 	if args.Config.EmailHost == "" {
@@ -46,41 +74,54 @@ func checkArgs(args ArgsEmailNotifier) error {
 	if len(args.Config.To) == 0 {
 		return ErrEmptyEmailToList
 	}
+	_, err := getTLSMode(args.Config)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
-// PushMessage will push the notification
-func (en *emailNotifier) PushMessage(msg data.NotificationMessage) error {
-	return en.push(msg)
+// parseFrom returns the from address, falling back to the username for the configs which do not set it
+func parseFrom(cfg *config.Email) (*mail.Address, error) {
+	from := cfg.From
+	if from == "" {
+		from = cfg.EmailUsername
+	}
+	if from == "" {
+		return nil, ErrEmptyEmailFrom
+	}
+
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid email from address %q: %w", from, err)
+	}
+
+	return address, nil
 }
 
-func (en *emailNotifier) push(msg data.NotificationMessage) error {
-	auth := smtp.PlainAuth("", en.config.EmailUsername, en.config.EmailPassword, en.config.EmailHost)
+// PushMessage will push the notification as a multipart email, with a plain text and an html version
+func (en *emailNotifier) PushMessage(msg data.NotificationMessage) error {
+	emailMessage, err := en.createMessage(msg)
+	if err != nil {
+		return err
+	}
 
-	smtpHost := fmt.Sprintf("%s:%d", en.config.EmailHost, en.config.EmailPort)
+	to := make([]string, 0, len(en.to))
+	for _, address := range en.to {
+		to = append(to, address.Address)
+	}
 
-	err := smtp.SendMail(
-		smtpHost,
-		auth,
-		en.config.From,
-		en.config.To,
-		en.msgToEmailMessageBytes(msg),
-	)
+	err = en.sender.SendMail(en.from.Address, to, emailMessage)
 	if err != nil {
 		return err
 	}
 
-	log.Info("Email sent", "to", en.config.To)
+	log.Info("Email sent", "to", to)
 
 	return nil
 }
 
-func (en *emailNotifier) msgToEmailMessageBytes(msg data.NotificationMessage) []byte {
-	msgStr := fmt.Sprintf("To: %s\r\nSubject: %s!\r\n\r\n%s\r\n", "Email", "Nodes rating", msg.Message)
-	return []byte(msgStr)
-}
-
 // GetID will return the identifier for email notifier
 func (en *emailNotifier) GetID() string {
 	return "SimpleEmail"
@@ -0,0 +1,278 @@
+package email_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/email"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockEmailNotifierArgs() email.ArgsEmailNotifier {
+	return email.ArgsEmailNotifier{
+		Config: &config.Email{
+			Enabled:       true,
+			EmailHost:     "smtp.mail.com",
+			EmailPort:     587,
+			EmailUsername: "user@mail.com",
+			EmailPassword: "password",
+			From:          "user@mail.com",
+			To:            []string{"to@mail.com"},
+		},
+		Sender: &mocks.MailSenderStub{},
+	}
+}
+
+func TestNewEmailNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil mail sender", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Sender = nil
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, email.ErrNilMailSender, err)
+	})
+
+	t.Run("password without username", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Config.EmailUsername = ""
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, email.ErrInvalidEmailCredentials, err)
+	})
+
+	t.Run("invalid TLS mode", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Config.TLSMode = "ssl"
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, en)
+		require.True(t, errors.Is(err, email.ErrInvalidTLSMode))
+	})
+
+	t.Run("invalid from address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Config.From = "not an address"
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, en)
+		require.NotNil(t, err)
+	})
+
+	t.Run("relay without credentials", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Config.EmailUsername = ""
+		args.Config.EmailPassword = ""
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, err)
+		require.False(t, en.IsInterfaceNil())
+	})
+
+	t.Run("empty to list", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Config.To = nil
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, en)
+		require.Equal(t, email.ErrEmptyEmailToList, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		en, err := email.NewEmailNotifier(createMockEmailNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, en.IsInterfaceNil())
+	})
+}
+
+func readMessage(t *testing.T, rawMessage string) (*mail.Message, map[string]string) {
+	msg, err := mail.ReadMessage(strings.NewReader(rawMessage))
+	require.Nil(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.Nil(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	parts := make(map[string]string)
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, errPart := reader.NextPart()
+		if errPart == io.EOF {
+			break
+		}
+		require.Nil(t, errPart)
+
+		content, errRead := ioutil.ReadAll(part)
+		require.Nil(t, errRead)
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[contentType] = string(content)
+	}
+
+	return msg, parts
+}
+
+func TestEmailNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	alert := data.Alert{
+		ID:            "a1b2c3d4",
+		Level:         common.CriticalEvent,
+		Message:       "NodeName: node-1 - TempRating decreased with 1.0 percent, current value: 90.00, last value: 95.00",
+		PubKey:        "key1",
+		NodeName:      "node-1",
+		Alias:         "validator-<01>",
+		CurrentValue:  90,
+		PreviousValue: 95,
+	}
+
+	t.Run("multipart message with headers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEmailNotifierArgs()
+		args.Config.From = "Monitoring <monitoring@mail.com>"
+		args.Config.To = []string{"to1@mail.com", "Ops <to2@mail.com>"}
+		args.Config.ExplorerUrl = "https://explorer.multiversx.com"
+
+		var sentMessage string
+		args.Sender = &mocks.MailSenderStub{
+			SendMailCalled: func(from string, to []string, msg []byte) error {
+				assert.Equal(t, "monitoring@mail.com", from)
+				assert.Equal(t, []string{"to1@mail.com", "to2@mail.com"}, to)
+				sentMessage = string(msg)
+				return nil
+			},
+		}
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, err)
+		en.SetGetTimeHandler(func() time.Time {
+			return time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+		})
+
+		err = en.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Message: alert.Message, Alerts: []data.Alert{alert}})
+		require.Nil(t, err)
+
+		for _, line := range readDataLines(sentMessage) {
+			assert.LessOrEqual(t, len(line), 78)
+		}
+
+		msg, parts := readMessage(t, sentMessage)
+		assert.Equal(t, `"Monitoring" <monitoring@mail.com>`, msg.Header.Get("From"))
+		assert.Equal(t, `<to1@mail.com>, "Ops" <to2@mail.com>`, msg.Header.Get("To"))
+		assert.Equal(t, "Fri, 01 Mar 2024 10:30:00 +0000", msg.Header.Get("Date"))
+		assert.Regexp(t, `^<\d+\.[0-9a-f]{16}@mail\.com>$`, msg.Header.Get("Message-ID"))
+		assert.Equal(t, "1.0", msg.Header.Get("MIME-Version"))
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		require.Nil(t, err)
+		assert.True(t, strings.HasPrefix(subject, "[CRITICAL] validator-<01>: NodeName: node-1 - TempRating decreased"))
+		assert.LessOrEqual(t, len(subject), 120)
+
+		assert.Contains(t, parts["text/plain"], "[a1b2c3d4] validator-<01>: "+alert.Message)
+		assert.Contains(t, parts["text/html"], `<a href="https://explorer.multiversx.com/nodes/key1">validator-&lt;01&gt;</a>`)
+		assert.Contains(t, parts["text/html"], "95.00 → 90.00")
+	})
+
+	t.Run("subject summarizes multiple nodes and resolves", func(t *testing.T) {
+		t.Parallel()
+
+		var messages []string
+		args := createMockEmailNotifierArgs()
+		args.Sender = &mocks.MailSenderStub{
+			SendMailCalled: func(from string, to []string, msg []byte) error {
+				messages = append(messages, string(msg))
+				return nil
+			},
+		}
+		en, _ := email.NewEmailNotifier(args)
+
+		alerts := make([]data.Alert, 0)
+		for _, name := range []string{"node-d", "node-a", "node-c", "node-b"} {
+			alerts = append(alerts, data.Alert{ID: name, NodeName: name, Level: common.WarningEvent, Message: "below threshold"})
+		}
+		require.Nil(t, en.PushMessage(data.NotificationMessage{Level: common.WarningEvent, Alerts: alerts}))
+		require.Nil(t, en.PushMessage(data.NotificationMessage{Level: common.InfoEvent, Resolved: alerts[:1]}))
+		require.Nil(t, en.PushMessage(data.NotificationMessage{Level: common.InfoEvent, Message: "Test notification\nsecond line"}))
+
+		expectedSubjects := []string{
+			"[WARNING] 4 nodes: node-a, node-b, node-c and 1 more",
+			"[RESOLVED] node-d: below threshold",
+			"[INFO] Test notification",
+		}
+		require.Len(t, messages, len(expectedSubjects))
+		for i, expectedSubject := range expectedSubjects {
+			msg, parts := readMessage(t, messages[i])
+			assert.Equal(t, expectedSubject, msg.Header.Get("Subject"))
+			assert.NotEmpty(t, parts["text/plain"])
+			assert.NotEmpty(t, parts["text/html"])
+		}
+	})
+
+	t.Run("delivered through the smtp server", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, true, true)
+		args := createMockEmailNotifierArgs()
+		args.Config = createSMTPConfig(server, email.TLSModeStartTLS)
+		args.Sender = email.NewSMTPSender(args.Config)
+
+		en, err := email.NewEmailNotifier(args)
+		require.Nil(t, err)
+
+		err = en.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+		require.Nil(t, err)
+
+		mails := server.receivedMails()
+		require.Len(t, mails, 1)
+		assert.True(t, mails[0].TLS)
+		msg, parts := readMessage(t, mails[0].Data)
+		assert.Equal(t, "<to@mail.com>", msg.Header.Get("To"))
+		assert.Contains(t, parts["text/plain"], alert.Message)
+	})
+
+	t.Run("sender error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEmailNotifierArgs()
+		args.Sender = &mocks.MailSenderStub{
+			SendMailCalled: func(from string, to []string, msg []byte) error {
+				return expectedErr
+			},
+		}
+		en, _ := email.NewEmailNotifier(args)
+
+		err := en.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Message: "message"})
+		assert.Equal(t, expectedErr, err)
+	})
+}
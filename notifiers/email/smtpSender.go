@@ -0,0 +1,142 @@
+package email
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+)
+
+const (
+	// TLSModeImplicit opens a TLS connection from the start, usually on port 465
+	TLSModeImplicit = "implicit"
+	// TLSModeStartTLS upgrades the plain connection with the STARTTLS command, usually on port 587
+	TLSModeStartTLS = "starttls"
+	// TLSModeNone sends the messages over an unencrypted connection, for the local relays
+	TLSModeNone = "none"
+
+	implicitTLSPort = 465
+	sendTimeout     = 30 * time.Second
+)
+
+// getTLSMode returns the configured TLS mode; when not set, implicit TLS is used on port 465 and STARTTLS otherwise
+func getTLSMode(cfg *config.Email) (string, error) {
+	switch cfg.TLSMode {
+	case "":
+		if cfg.EmailPort == implicitTLSPort {
+			return TLSModeImplicit, nil
+		}
+		return TLSModeStartTLS, nil
+	case TLSModeImplicit, TLSModeStartTLS, TLSModeNone:
+		return cfg.TLSMode, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidTLSMode, cfg.TLSMode)
+	}
+}
+
+type smtpSender struct {
+	config *config.Email
+}
+
+// NewSMTPSender will create a mail sender which delivers the messages through the configured smtp server
+func NewSMTPSender(cfg *config.Email) *smtpSender {
+	return &smtpSender{
+		config: cfg,
+	}
+}
+
+// SendMail will send the message through the smtp server, over the configured TLS mode. The plain auth is used
+// only when credentials are provided
+func (ss *smtpSender) SendMail(from string, to []string, msg []byte) error {
+	tlsMode, err := getTLSMode(ss.config)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         ss.config.EmailHost,
+		InsecureSkipVerify: ss.config.TLSInsecureSkipVerify,
+	}
+	address := net.JoinHostPort(ss.config.EmailHost, strconv.Itoa(ss.config.EmailPort))
+	dialer := &net.Dialer{Timeout: sendTimeout}
+
+	var conn net.Conn
+	if tlsMode == TLSModeImplicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(sendTimeout))
+
+	client, err := smtp.NewClient(conn, ss.config.EmailHost)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if tlsMode == TLSModeStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return ErrStartTLSNotSupported
+		}
+		err = client.StartTLS(tlsConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ss.config.EmailUsername != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return ErrAuthNotSupported
+		}
+		auth := smtp.PlainAuth("", ss.config.EmailUsername, ss.config.EmailPassword, ss.config.EmailHost)
+		err = client.Auth(auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sendData(client, from, to, msg)
+}
+
+func sendData(client *smtp.Client, from string, to []string, msg []byte) error {
+	err := client.Mail(from)
+	if err != nil {
+		return err
+	}
+	for _, address := range to {
+		err = client.Rcpt(address)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(msg)
+	if err != nil {
+		return err
+	}
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *smtpSender) IsInterfaceNil() bool {
+	return ss == nil
+}
@@ -0,0 +1,127 @@
+package email_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/email"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMessage = "Subject: test\r\n\r\nbody\r\n"
+
+func createSMTPConfig(server *smtpServerStub, tlsMode string) *config.Email {
+	return &config.Email{
+		Enabled:               true,
+		EmailHost:             "127.0.0.1",
+		EmailPort:             server.port(),
+		EmailUsername:         "user@mail.com",
+		EmailPassword:         "password",
+		From:                  "user@mail.com",
+		To:                    []string{"to@mail.com"},
+		TLSMode:               tlsMode,
+		TLSInsecureSkipVerify: true,
+	}
+}
+
+func TestSMTPSender_SendMail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthenticated relay without TLS", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, false, false)
+		cfg := createSMTPConfig(server, email.TLSModeNone)
+		cfg.EmailUsername = ""
+		cfg.EmailPassword = ""
+
+		err := email.NewSMTPSender(cfg).SendMail("user@mail.com", []string{"to1@mail.com", "to2@mail.com"}, []byte(testMessage))
+		require.Nil(t, err)
+
+		mails := server.receivedMails()
+		require.Len(t, mails, 1)
+		assert.Equal(t, "user@mail.com", mails[0].From)
+		assert.Equal(t, []string{"to1@mail.com", "to2@mail.com"}, mails[0].To)
+		assert.Equal(t, "Subject: test\n\nbody\n", mails[0].Data)
+		assert.False(t, mails[0].TLS)
+		assert.Empty(t, mails[0].AuthUser)
+	})
+
+	t.Run("starttls with authentication", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, true, true)
+		err := email.NewSMTPSender(createSMTPConfig(server, email.TLSModeStartTLS)).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		require.Nil(t, err)
+
+		mails := server.receivedMails()
+		require.Len(t, mails, 1)
+		assert.True(t, mails[0].TLS)
+		assert.Equal(t, "user@mail.com", mails[0].AuthUser)
+	})
+
+	t.Run("starttls is the default mode", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, true, true)
+		err := email.NewSMTPSender(createSMTPConfig(server, "")).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		require.Nil(t, err)
+
+		mails := server.receivedMails()
+		require.Len(t, mails, 1)
+		assert.True(t, mails[0].TLS)
+	})
+
+	t.Run("starttls not supported by the server", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, false, true)
+		err := email.NewSMTPSender(createSMTPConfig(server, email.TLSModeStartTLS)).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		assert.Equal(t, email.ErrStartTLSNotSupported, err)
+		assert.Empty(t, server.receivedMails())
+	})
+
+	t.Run("certificate is verified unless disabled", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, true, true)
+		cfg := createSMTPConfig(server, email.TLSModeStartTLS)
+		cfg.TLSInsecureSkipVerify = false
+
+		err := email.NewSMTPSender(cfg).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "certificate")
+		assert.Empty(t, server.receivedMails())
+	})
+
+	t.Run("implicit TLS", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, true, false, true)
+		err := email.NewSMTPSender(createSMTPConfig(server, email.TLSModeImplicit)).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		require.Nil(t, err)
+
+		mails := server.receivedMails()
+		require.Len(t, mails, 1)
+		assert.True(t, mails[0].TLS)
+		assert.Equal(t, "user@mail.com", mails[0].AuthUser)
+	})
+
+	t.Run("authentication not supported by the server", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, true, false)
+		err := email.NewSMTPSender(createSMTPConfig(server, email.TLSModeStartTLS)).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		assert.Equal(t, email.ErrAuthNotSupported, err)
+	})
+
+	t.Run("invalid TLS mode", func(t *testing.T) {
+		t.Parallel()
+
+		server := newSMTPServerStub(t, false, false, false)
+		err := email.NewSMTPSender(createSMTPConfig(server, "ssl")).SendMail("user@mail.com", []string{"to@mail.com"}, []byte(testMessage))
+		assert.True(t, errors.Is(err, email.ErrInvalidTLSMode))
+	})
+}
@@ -0,0 +1,203 @@
+package email_test
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// receivedMail holds a message delivered to the smtp server stub
+type receivedMail struct {
+	From     string
+	To       []string
+	Data     string
+	TLS      bool
+	AuthUser string
+}
+
+// smtpServerStub is a minimal in-process smtp server, supporting STARTTLS, implicit TLS and AUTH PLAIN
+type smtpServerStub struct {
+	listener    net.Listener
+	tlsConfig   *tls.Config
+	startTLS    bool
+	authEnabled bool
+	mutMails    sync.Mutex
+	mails       []receivedMail
+}
+
+func newSMTPServerStub(t *testing.T, implicitTLS bool, startTLS bool, authEnabled bool) *smtpServerStub {
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{createSelfSignedCertificate(t)}}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	if implicitTLS {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	server := &smtpServerStub{
+		listener:    listener,
+		tlsConfig:   tlsConfig,
+		startTLS:    startTLS,
+		authEnabled: authEnabled,
+	}
+	go server.serve(implicitTLS)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return server
+}
+
+func (sss *smtpServerStub) port() int {
+	return sss.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (sss *smtpServerStub) receivedMails() []receivedMail {
+	sss.mutMails.Lock()
+	defer sss.mutMails.Unlock()
+
+	return append([]receivedMail(nil), sss.mails...)
+}
+
+func (sss *smtpServerStub) serve(implicitTLS bool) {
+	for {
+		conn, err := sss.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go sss.handleConnection(conn, implicitTLS)
+	}
+}
+
+func (sss *smtpServerStub) handleConnection(conn net.Conn, isTLS bool) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	textConn := textproto.NewConn(conn)
+	_ = textConn.PrintfLine("220 stub ESMTP")
+
+	mail := receivedMail{TLS: isTLS}
+	for {
+		line, err := textConn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			extensions := []string{"stub"}
+			if sss.startTLS && !mail.TLS {
+				extensions = append(extensions, "STARTTLS")
+			}
+			if sss.authEnabled {
+				extensions = append(extensions, "AUTH PLAIN")
+			}
+			for i, extension := range extensions {
+				separator := "-"
+				if i == len(extensions)-1 {
+					separator = " "
+				}
+				_ = textConn.PrintfLine("250%s%s", separator, extension)
+			}
+		case "STARTTLS":
+			_ = textConn.PrintfLine("220 ready to start TLS")
+			tlsConn := tls.Server(conn, sss.tlsConfig)
+			if tlsConn.Handshake() != nil {
+				return
+			}
+			conn = tlsConn
+			textConn = textproto.NewConn(conn)
+			mail.TLS = true
+		case "AUTH":
+			fields := strings.Fields(line)
+			credentials, _ := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+			parts := strings.Split(string(credentials), "\x00")
+			if len(parts) != 3 || parts[2] != "password" {
+				_ = textConn.PrintfLine("535 authentication failed")
+				continue
+			}
+			mail.AuthUser = parts[1]
+			_ = textConn.PrintfLine("235 authenticated")
+		case "MAIL":
+			mail.From = extractAddress(line)
+			_ = textConn.PrintfLine("250 ok")
+		case "RCPT":
+			mail.To = append(mail.To, extractAddress(line))
+			_ = textConn.PrintfLine("250 ok")
+		case "DATA":
+			_ = textConn.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, errRead := textConn.ReadDotBytes()
+			if errRead != nil {
+				return
+			}
+			mail.Data = string(data)
+			sss.mutMails.Lock()
+			sss.mails = append(sss.mails, mail)
+			sss.mutMails.Unlock()
+			_ = textConn.PrintfLine("250 queued")
+		case "QUIT":
+			_ = textConn.PrintfLine("221 bye")
+			return
+		default:
+			_ = textConn.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+func extractAddress(line string) string {
+	start := strings.Index(line, "<")
+	end := strings.Index(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+
+	return line[start+1 : end]
+}
+
+func createSelfSignedCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{certificate}, PrivateKey: key}
+}
+
+// readDataLines is used to check the raw message lines, as the smtp server received them
+func readDataLines(data string) []string {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines
+}
@@ -0,0 +1,13 @@
+package notifiers
+
+import "strings"
+
+// NodeExplorerURL returns the explorer page of the node with the provided public key, or an empty string
+// if no explorer url is configured
+func NodeExplorerURL(explorerUrl string, pubKey string) string {
+	if explorerUrl == "" || pubKey == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(explorerUrl, "/") + "/nodes/" + pubKey
+}
@@ -0,0 +1,75 @@
+package notifiers
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+const (
+	// Footer is the text identifying the tool on the rich notifications
+	Footer = "MultiversX Node Monitoring"
+	title  = "Node monitoring"
+
+	ellipsis = "..."
+)
+
+var levelColors = map[common.EventLevel]int{
+	common.NoEvent:       0x95A5A6,
+	common.InfoEvent:     0x3498DB,
+	common.WarningEvent:  0xF1C40F,
+	common.CriticalEvent: 0xE74C3C,
+}
+
+// Title returns the title of a notification with the provided level
+func Title(level common.EventLevel) string {
+	return fmt.Sprintf("%s - %s", title, strings.ToUpper(level.String()))
+}
+
+// LevelColor returns the RGB color used to highlight the notifications with the provided level
+func LevelColor(level common.EventLevel) int {
+	color, ok := levelColors[level]
+	if !ok {
+		return levelColors[common.NoEvent]
+	}
+
+	return color
+}
+
+// LevelHexColor returns the color used to highlight the notifications with the provided level, as #RRGGBB
+func LevelHexColor(level common.EventLevel) string {
+	return fmt.Sprintf("#%06X", LevelColor(level))
+}
+
+// Truncate cuts the text to the provided maximum length in bytes, ending it with "..." when cut
+func Truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+
+	cut := text[:maxLength-len(ellipsis)]
+	for len(cut) > 0 && !utf8.ValidString(cut) {
+		cut = cut[:len(cut)-1]
+	}
+
+	return cut + ellipsis
+}
+
+// SingleNodeExplorerURL returns the explorer page of the affected node, if the message has alerts for only one node
+func SingleNodeExplorerURL(explorerUrl string, msg data.NotificationMessage) string {
+	if len(msg.Alerts) == 0 {
+		return ""
+	}
+
+	pubKey := msg.Alerts[0].PubKey
+	for _, alert := range msg.Alerts[1:] {
+		if alert.PubKey != pubKey {
+			return ""
+		}
+	}
+
+	return NodeExplorerURL(explorerUrl, pubKey)
+}
@@ -0,0 +1,12 @@
+package gotify
+
+import "errors"
+
+// ErrInvalidGotifyURL signals that an empty server url has been provided
+var ErrInvalidGotifyURL = errors.New("empty gotify url has been provided")
+
+// ErrEmptyAppToken signals that an empty application token has been provided
+var ErrEmptyAppToken = errors.New("empty gotify application token has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
@@ -0,0 +1,116 @@
+package gotify
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const messagePath = "/message"
+
+// gotify priorities, from 0 to 10. The android app notifies with sound from 4 and pops up from 8
+var levelPriorities = map[common.EventLevel]int{
+	common.NoEvent:       0,
+	common.InfoEvent:     2,
+	common.WarningEvent:  5,
+	common.CriticalEvent: 8,
+}
+
+type payload struct {
+	Title    string                 `json:"title"`
+	Message  string                 `json:"message"`
+	Priority int                    `json:"priority"`
+	Extras   map[string]interface{} `json:"extras,omitempty"`
+}
+
+// ArgsGotifyNotifier defines the arguments needed to create a new gotify notifier
+type ArgsGotifyNotifier struct {
+	Config     *config.Gotify
+	HTTPClient notifiers.HTTPClient
+}
+
+type gotifyNotifier struct {
+	url         string
+	appToken    string
+	explorerUrl string
+	httpClient  notifiers.HTTPClient
+}
+
+// NewGotifyNotifier will create a new gotify notifier instance
+func NewGotifyNotifier(args ArgsGotifyNotifier) (*gotifyNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gotifyNotifier{
+		url:         strings.TrimSuffix(args.Config.URL, "/"),
+		appToken:    args.Config.AppToken,
+		explorerUrl: args.Config.ExplorerUrl,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsGotifyNotifier) error {
+	if args.Config.URL == "" {
+		return ErrInvalidGotifyURL
+	}
+	if args.Config.AppToken == "" {
+		return ErrEmptyAppToken
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will push the notification as a gotify message. The application token is sent as header, so it
+// does not end up in the logs of the proxies
+func (gn *gotifyNotifier) PushMessage(msg data.NotificationMessage) error {
+	msgPayload := payload{
+		Title:    notifiers.Title(msg.Level),
+		Message:  strings.TrimSpace(msg.Message),
+		Priority: levelPriorities[msg.Level],
+	}
+	explorerURL := notifiers.SingleNodeExplorerURL(gn.explorerUrl, msg)
+	if explorerURL != "" {
+		msgPayload.Extras = map[string]interface{}{
+			"client::notification": map[string]interface{}{
+				"click": map[string]string{"url": explorerURL},
+			},
+		}
+	}
+
+	body, err := json.Marshal(msgPayload)
+	if err != nil {
+		return err
+	}
+
+	_, err = gn.httpClient.CallRestEndPoint(data.HTTPRequest{
+		Method: http.MethodPost,
+		URL:    gn.url + messagePath,
+		Headers: map[string]string{
+			"X-Gotify-Key": gn.appToken,
+		},
+		Body: body,
+	})
+
+	return err
+}
+
+// GetID will return the identifier for gotify notifier
+func (gn *gotifyNotifier) GetID() string {
+	return "Gotify"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gn *gotifyNotifier) IsInterfaceNil() bool {
+	return gn == nil
+}
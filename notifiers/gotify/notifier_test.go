@@ -0,0 +1,130 @@
+package gotify_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/gotify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockGotifyNotifierArgs() gotify.ArgsGotifyNotifier {
+	return gotify.ArgsGotifyNotifier{
+		Config: &config.Gotify{
+			Enabled:     true,
+			URL:         "https://gotify.example.com/",
+			AppToken:    "token",
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+func TestNewGotifyNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockGotifyNotifierArgs()
+		args.Config.URL = ""
+
+		gn, err := gotify.NewGotifyNotifier(args)
+		require.Nil(t, gn)
+		require.Equal(t, gotify.ErrInvalidGotifyURL, err)
+	})
+
+	t.Run("empty app token", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockGotifyNotifierArgs()
+		args.Config.AppToken = ""
+
+		gn, err := gotify.NewGotifyNotifier(args)
+		require.Nil(t, gn)
+		require.Equal(t, gotify.ErrEmptyAppToken, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockGotifyNotifierArgs()
+		args.HTTPClient = nil
+
+		gn, err := gotify.NewGotifyNotifier(args)
+		require.Nil(t, gn)
+		require.Equal(t, gotify.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gn, err := gotify.NewGotifyNotifier(createMockGotifyNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, gn.IsInterfaceNil())
+		assert.Equal(t, "Gotify", gn.GetID())
+	})
+}
+
+func TestGotifyNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var sentRequest data.HTTPRequest
+		args := createMockGotifyNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				sentRequest = request
+				return []byte(`{"id": 1}`), nil
+			},
+		}
+		gn, err := gotify.NewGotifyNotifier(args)
+		require.Nil(t, err)
+
+		err = gn.PushMessage(data.NotificationMessage{
+			Message: "message\n",
+			Level:   common.CriticalEvent,
+			Alerts:  []data.Alert{{PubKey: "key1"}},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, "POST", sentRequest.Method)
+		assert.Equal(t, "https://gotify.example.com/message", sentRequest.URL)
+		assert.Equal(t, map[string]string{"X-Gotify-Key": "token"}, sentRequest.Headers)
+
+		body := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(sentRequest.Body, &body))
+		assert.Equal(t, "Node monitoring - CRITICAL", body["title"])
+		assert.Equal(t, "message", body["message"])
+		assert.Equal(t, float64(8), body["priority"])
+		assert.Equal(t, map[string]interface{}{
+			"client::notification": map[string]interface{}{
+				"click": map[string]interface{}{"url": "https://explorer.multiversx.com/nodes/key1"},
+			},
+		}, body["extras"])
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockGotifyNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		gn, err := gotify.NewGotifyNotifier(args)
+		require.Nil(t, err)
+
+		assert.Equal(t, expectedErr, gn.PushMessage(data.NotificationMessage{Message: "message", Level: common.InfoEvent}))
+	})
+}
@@ -1,8 +1,11 @@
 package notifiers
 
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
 // HTTPClient defines the behaviour of a http client
 type HTTPClient interface {
 	CallGetRestEndPoint(address string, path string) ([]byte, error)
 	CallPostRestEndPoint(address string, path string, data interface{}) error
+	CallRestEndPoint(request data.HTTPRequest) ([]byte, error)
 	IsInterfaceNil() bool
 }
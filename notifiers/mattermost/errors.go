@@ -0,0 +1,9 @@
+package mattermost
+
+import "errors"
+
+// ErrInvalidMattermostURL signals that an empty url has been provided
+var ErrInvalidMattermostURL = errors.New("empty mattermost url has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
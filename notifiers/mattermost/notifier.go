@@ -0,0 +1,124 @@
+package mattermost
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+// payload follows the slack compatible format, see https://developers.mattermost.com/integrate/reference/message-attachments/
+type payload struct {
+	Channel     string       `json:"channel,omitempty"`
+	Username    string       `json:"username,omitempty"`
+	Attachments []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	Fallback string            `json:"fallback"`
+	Color    string            `json:"color"`
+	Title    string            `json:"title"`
+	Text     string            `json:"text,omitempty"`
+	Fields   []attachmentField `json:"fields,omitempty"`
+	Footer   string            `json:"footer"`
+	Ts       int64             `json:"ts"`
+}
+
+type attachmentField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// ArgsMattermostNotifier defines the arguments needed to create a new mattermost notifier
+type ArgsMattermostNotifier struct {
+	Config     *config.Mattermost
+	HTTPClient notifiers.HTTPClient
+}
+
+type mattermostNotifier struct {
+	url         string
+	channel     string
+	username    string
+	explorerUrl string
+	httpClient  notifiers.HTTPClient
+}
+
+// NewMattermostNotifier will create a new mattermost notifier instance
+func NewMattermostNotifier(args ArgsMattermostNotifier) (*mattermostNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mattermostNotifier{
+		url:         args.Config.URL,
+		channel:     args.Config.Channel,
+		username:    args.Config.Username,
+		explorerUrl: args.Config.ExplorerUrl,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsMattermostNotifier) error {
+	if args.Config.URL == "" {
+		return ErrInvalidMattermostURL
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will push the notification as an attachment, with one field for every affected node
+func (mn *mattermostNotifier) PushMessage(msg data.NotificationMessage) error {
+	msgAttachment := attachment{
+		Fallback: fmt.Sprintf("%s\n%s", notifiers.Title(msg.Level), msg.Message),
+		Color:    notifiers.LevelHexColor(msg.Level),
+		Title:    notifiers.Title(msg.Level),
+		Footer:   notifiers.Footer,
+		Ts:       time.Now().Unix(),
+	}
+
+	if len(msg.Alerts) == 0 {
+		msgAttachment.Text = msg.Message
+	}
+	for _, alert := range msg.Alerts {
+		msgAttachment.Fields = append(msgAttachment.Fields, mn.createField(alert))
+	}
+
+	msgPayload := payload{
+		Channel:     mn.channel,
+		Username:    mn.username,
+		Attachments: []attachment{msgAttachment},
+	}
+
+	return mn.httpClient.CallPostRestEndPoint(mn.url, "", msgPayload)
+}
+
+func (mn *mattermostNotifier) createField(alert data.Alert) attachmentField {
+	value := fmt.Sprintf("%s\nAlert `%s`", alert.Message, alert.ID)
+	explorerURL := notifiers.NodeExplorerURL(mn.explorerUrl, alert.PubKey)
+	if explorerURL != "" {
+		value += fmt.Sprintf(" - [Explorer](%s)", explorerURL)
+	}
+
+	return attachmentField{
+		Title: alert.DisplayName(),
+		Value: value,
+	}
+}
+
+// GetID will return the identifier for mattermost notifier
+func (mn *mattermostNotifier) GetID() string {
+	return "Mattermost"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mn *mattermostNotifier) IsInterfaceNil() bool {
+	return mn == nil
+}
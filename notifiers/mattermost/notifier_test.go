@@ -0,0 +1,156 @@
+package mattermost_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/mattermost"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedPayload struct {
+	Channel     string `json:"channel"`
+	Username    string `json:"username"`
+	Attachments []struct {
+		Fallback string `json:"fallback"`
+		Color    string `json:"color"`
+		Title    string `json:"title"`
+		Text     string `json:"text"`
+		Fields   []struct {
+			Title string `json:"title"`
+			Value string `json:"value"`
+		} `json:"fields"`
+		Ts int64 `json:"ts"`
+	} `json:"attachments"`
+}
+
+func createMockMattermostNotifierArgs() mattermost.ArgsMattermostNotifier {
+	return mattermost.ArgsMattermostNotifier{
+		Config: &config.Mattermost{
+			Enabled:     true,
+			URL:         "http://localhost",
+			Channel:     "validators",
+			Username:    "node-monitoring",
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+// pushToReceiver will push the message to a local receiver and will return the decoded request body
+func pushToReceiver(t *testing.T, args mattermost.ArgsMattermostNotifier, msg data.NotificationMessage) receivedPayload {
+	received := receivedPayload{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		require.Nil(t, json.Unmarshal(body, &received))
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 5})
+	require.Nil(t, err)
+
+	args.Config.URL = server.URL
+	args.HTTPClient = httpClient
+	mn, err := mattermost.NewMattermostNotifier(args)
+	require.Nil(t, err)
+
+	err = mn.PushMessage(msg)
+	require.Nil(t, err)
+
+	return received
+}
+
+func TestNewMattermostNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url string", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockMattermostNotifierArgs()
+		args.Config.URL = ""
+
+		mn, err := mattermost.NewMattermostNotifier(args)
+		require.Nil(t, mn)
+		require.Equal(t, mattermost.ErrInvalidMattermostURL, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockMattermostNotifierArgs()
+		args.HTTPClient = nil
+
+		mn, err := mattermost.NewMattermostNotifier(args)
+		require.Nil(t, mn)
+		require.Equal(t, mattermost.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mn, err := mattermost.NewMattermostNotifier(createMockMattermostNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, mn.IsInterfaceNil())
+		assert.Equal(t, "Mattermost", mn.GetID())
+	})
+}
+
+func TestMattermostNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("message without alerts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockMattermostNotifierArgs()
+		args.Config.Channel = ""
+		args.Config.Username = ""
+		received := pushToReceiver(t, args, data.NotificationMessage{Message: "test message", Level: common.InfoEvent})
+
+		assert.Empty(t, received.Channel)
+		assert.Empty(t, received.Username)
+		require.Len(t, received.Attachments, 1)
+		assert.Equal(t, "#3498DB", received.Attachments[0].Color)
+		assert.Equal(t, "Node monitoring - INFO", received.Attachments[0].Title)
+		assert.Equal(t, "test message", received.Attachments[0].Text)
+		assert.Empty(t, received.Attachments[0].Fields)
+		assert.NotZero(t, received.Attachments[0].Ts)
+	})
+
+	t.Run("one field per node", func(t *testing.T) {
+		t.Parallel()
+
+		alerts := []data.Alert{
+			{ID: "id1", PubKey: "key1", NodeName: "node-1", Level: common.CriticalEvent, Message: "node-1 message"},
+			{ID: "id2", PubKey: "key2", NodeName: "node-2", Level: common.CriticalEvent, Message: "node-2 message"},
+		}
+		received := pushToReceiver(t, createMockMattermostNotifierArgs(), data.NotificationMessage{
+			Message: "[id1] node-1 message\n[id2] node-2 message\n",
+			Level:   common.CriticalEvent,
+			Alerts:  alerts,
+		})
+
+		assert.Equal(t, "validators", received.Channel)
+		assert.Equal(t, "node-monitoring", received.Username)
+		require.Len(t, received.Attachments, 1)
+		assert.Equal(t, "#E74C3C", received.Attachments[0].Color)
+		assert.Contains(t, received.Attachments[0].Fallback, "[id2] node-2 message")
+		require.Len(t, received.Attachments[0].Fields, 2)
+		assert.Equal(t, "node-2", received.Attachments[0].Fields[1].Title)
+		assert.Equal(t,
+			"node-2 message\nAlert `id2` - [Explorer](https://explorer.multiversx.com/nodes/key2)",
+			received.Attachments[0].Fields[1].Value,
+		)
+	})
+}
@@ -0,0 +1,9 @@
+package ntfy
+
+import "errors"
+
+// ErrInvalidNtfyURL signals that an empty topic url has been provided
+var ErrInvalidNtfyURL = errors.New("empty ntfy topic url has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
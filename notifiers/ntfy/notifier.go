@@ -0,0 +1,109 @@
+package ntfy
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+// ntfy keeps the messages up to 4096 bytes, the longer ones are sent as attachments
+const maxMessageLength = 4096
+
+// ntfy priorities, from 1 (min) to 5 (max), see https://docs.ntfy.sh/publish/#message-priority
+var levelPriorities = map[common.EventLevel]int{
+	common.NoEvent:       2,
+	common.InfoEvent:     3,
+	common.WarningEvent:  4,
+	common.CriticalEvent: 5,
+}
+
+// the tags matching emoji short codes are displayed as emojis, see https://docs.ntfy.sh/publish/#tags-emojis
+var levelTags = map[common.EventLevel]string{
+	common.NoEvent:       "white_check_mark",
+	common.InfoEvent:     "information_source",
+	common.WarningEvent:  "warning",
+	common.CriticalEvent: "rotating_light",
+}
+
+// ArgsNtfyNotifier defines the arguments needed to create a new ntfy notifier
+type ArgsNtfyNotifier struct {
+	Config     *config.Ntfy
+	HTTPClient notifiers.HTTPClient
+}
+
+type ntfyNotifier struct {
+	url         string
+	accessToken string
+	explorerUrl string
+	httpClient  notifiers.HTTPClient
+}
+
+// NewNtfyNotifier will create a new ntfy notifier instance
+func NewNtfyNotifier(args ArgsNtfyNotifier) (*ntfyNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ntfyNotifier{
+		url:         args.Config.URL,
+		accessToken: args.Config.AccessToken,
+		explorerUrl: args.Config.ExplorerUrl,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsNtfyNotifier) error {
+	if args.Config.URL == "" {
+		return ErrInvalidNtfyURL
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will publish the notification to the topic, as plain text with the title, the priority and the tags
+// sent as headers. If a single node is affected, tapping the notification opens its explorer page
+func (nn *ntfyNotifier) PushMessage(msg data.NotificationMessage) error {
+	headers := map[string]string{
+		"Content-Type": "text/plain; charset=utf-8",
+		"Title":        notifiers.Title(msg.Level),
+		"Priority":     strconv.Itoa(levelPriorities[msg.Level]),
+		"Tags":         levelTags[msg.Level],
+	}
+	if nn.accessToken != "" {
+		headers["Authorization"] = "Bearer " + nn.accessToken
+	}
+	explorerURL := notifiers.SingleNodeExplorerURL(nn.explorerUrl, msg)
+	if explorerURL != "" {
+		headers["Click"] = explorerURL
+	}
+
+	message := notifiers.Truncate(strings.TrimSpace(msg.Message), maxMessageLength)
+	_, err := nn.httpClient.CallRestEndPoint(data.HTTPRequest{
+		Method:  http.MethodPost,
+		URL:     nn.url,
+		Headers: headers,
+		Body:    []byte(message),
+	})
+
+	return err
+}
+
+// GetID will return the identifier for ntfy notifier
+func (nn *ntfyNotifier) GetID() string {
+	return "Ntfy"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nn *ntfyNotifier) IsInterfaceNil() bool {
+	return nn == nil
+}
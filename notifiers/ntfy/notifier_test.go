@@ -0,0 +1,135 @@
+package ntfy_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/ntfy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockNtfyNotifierArgs() ntfy.ArgsNtfyNotifier {
+	return ntfy.ArgsNtfyNotifier{
+		Config: &config.Ntfy{
+			Enabled:     true,
+			URL:         "https://ntfy.example.com/validators",
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+func pushAndCapture(t *testing.T, args ntfy.ArgsNtfyNotifier, msg data.NotificationMessage) data.HTTPRequest {
+	var sentRequest data.HTTPRequest
+	args.HTTPClient = &mocks.HTTPClientStub{
+		CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+			sentRequest = request
+			return nil, nil
+		},
+	}
+
+	nn, err := ntfy.NewNtfyNotifier(args)
+	require.Nil(t, err)
+	require.Nil(t, nn.PushMessage(msg))
+
+	return sentRequest
+}
+
+func TestNewNtfyNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNtfyNotifierArgs()
+		args.Config.URL = ""
+
+		nn, err := ntfy.NewNtfyNotifier(args)
+		require.Nil(t, nn)
+		require.Equal(t, ntfy.ErrInvalidNtfyURL, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNtfyNotifierArgs()
+		args.HTTPClient = nil
+
+		nn, err := ntfy.NewNtfyNotifier(args)
+		require.Nil(t, nn)
+		require.Equal(t, ntfy.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nn, err := ntfy.NewNtfyNotifier(createMockNtfyNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, nn.IsInterfaceNil())
+		assert.Equal(t, "Ntfy", nn.GetID())
+	})
+}
+
+func TestNtfyNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("critical message about a single node", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNtfyNotifierArgs()
+		args.Config.AccessToken = "token"
+		request := pushAndCapture(t, args, data.NotificationMessage{
+			Message: "message\n",
+			Level:   common.CriticalEvent,
+			Alerts:  []data.Alert{{PubKey: "key1"}, {PubKey: "key1"}},
+		})
+
+		assert.Equal(t, "POST", request.Method)
+		assert.Equal(t, "https://ntfy.example.com/validators", request.URL)
+		assert.Equal(t, "message", string(request.Body))
+		assert.Equal(t, map[string]string{
+			"Content-Type":  "text/plain; charset=utf-8",
+			"Title":         "Node monitoring - CRITICAL",
+			"Priority":      "5",
+			"Tags":          "rotating_light",
+			"Authorization": "Bearer token",
+			"Click":         "https://explorer.multiversx.com/nodes/key1",
+		}, request.Headers)
+	})
+
+	t.Run("warning message about more nodes", func(t *testing.T) {
+		t.Parallel()
+
+		request := pushAndCapture(t, createMockNtfyNotifierArgs(), data.NotificationMessage{
+			Message: "message",
+			Level:   common.WarningEvent,
+			Alerts:  []data.Alert{{PubKey: "key1"}, {PubKey: "key2"}},
+		})
+
+		assert.Equal(t, "4", request.Headers["Priority"])
+		assert.Equal(t, "warning", request.Headers["Tags"])
+		assert.NotContains(t, request.Headers, "Click")
+		assert.NotContains(t, request.Headers, "Authorization")
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockNtfyNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		nn, err := ntfy.NewNtfyNotifier(args)
+		require.Nil(t, err)
+
+		assert.Equal(t, expectedErr, nn.PushMessage(data.NotificationMessage{Message: "message"}))
+	})
+}
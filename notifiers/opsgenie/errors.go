@@ -0,0 +1,12 @@
+package opsgenie
+
+import "errors"
+
+// ErrInvalidApiUrl signals that an empty alert api url has been provided
+var ErrInvalidApiUrl = errors.New("empty opsgenie api url has been provided")
+
+// ErrEmptyApiKey signals that an empty api key has been provided
+var ErrEmptyApiKey = errors.New("empty opsgenie api key has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
@@ -0,0 +1,244 @@
+package opsgenie
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	alertsPath           = "/v2/alerts"
+	maxMessageLength     = 130
+	maxDescriptionLength = 15000
+	testAliasSource      = "test"
+	responderTypeUser    = "user"
+)
+
+// opsgenie priorities, see https://docs.opsgenie.com/docs/alert-api#create-alert
+var levelPriorities = map[common.EventLevel]string{
+	common.NoEvent:       "P5",
+	common.InfoEvent:     "P5",
+	common.WarningEvent:  "P3",
+	common.CriticalEvent: "P1",
+}
+
+type createAlertPayload struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Responders  []responder       `json:"responders,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Details     map[string]string `json:"details,omitempty"`
+	Entity      string            `json:"entity,omitempty"`
+	Source      string            `json:"source"`
+	Priority    string            `json:"priority"`
+}
+
+type responder struct {
+	Type     string `json:"type"`
+	Name     string `json:"name,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+type closeAlertPayload struct {
+	Source string `json:"source"`
+	Note   string `json:"note"`
+}
+
+// ArgsOpsgenieNotifier defines the arguments needed to create a new opsgenie notifier
+type ArgsOpsgenieNotifier struct {
+	Config     *config.Opsgenie
+	HTTPClient notifiers.HTTPClient
+}
+
+type opsgenieNotifier struct {
+	apiUrl      string
+	apiKey      string
+	explorerUrl string
+	responders  []responder
+	httpClient  notifiers.HTTPClient
+}
+
+// NewOpsgenieNotifier will create a new opsgenie notifier instance
+func NewOpsgenieNotifier(args ArgsOpsgenieNotifier) (*opsgenieNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	responders := make([]responder, 0, len(args.Config.Responders))
+	for _, configResponder := range args.Config.Responders {
+		responders = append(responders, createResponder(configResponder))
+	}
+
+	return &opsgenieNotifier{
+		apiUrl:      strings.TrimSuffix(args.Config.ApiUrl, "/"),
+		apiKey:      args.Config.ApiKey,
+		explorerUrl: args.Config.ExplorerUrl,
+		responders:  responders,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsOpsgenieNotifier) error {
+	if args.Config.ApiUrl == "" {
+		return ErrInvalidApiUrl
+	}
+	if args.Config.ApiKey == "" {
+		return ErrEmptyApiKey
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// createResponder uses the username for the user responders and the name for teams, schedules and escalations
+func createResponder(configResponder config.OpsgenieResponder) responder {
+	if configResponder.Type == responderTypeUser {
+		return responder{
+			Type:     configResponder.Type,
+			Username: configResponder.Name,
+		}
+	}
+
+	return responder{
+		Type: configResponder.Type,
+		Name: configResponder.Name,
+	}
+}
+
+// PushMessage will create an alert for every notified alert and will close the alerts which resolved. The alerts
+// of the same node and condition share the alias, so opsgenie deduplicates them while they are open
+func (on *opsgenieNotifier) PushMessage(msg data.NotificationMessage) error {
+	requests := make([]data.HTTPRequest, 0, len(msg.Alerts)+len(msg.Resolved))
+	for _, alert := range msg.Alerts {
+		requests = append(requests, on.createRequest(on.apiUrl+alertsPath, on.createAlertPayload(alert)))
+	}
+	for _, alert := range msg.Resolved {
+		alias := data.NewDedupKey(alert.ClientID, alert.PubKey, alert.Condition)
+		closeURL := fmt.Sprintf("%s%s/%s/close?identifierType=alias", on.apiUrl, alertsPath, url.PathEscape(alias))
+		payload := closeAlertPayload{
+			Source: notifiers.Footer,
+			Note:   fmt.Sprintf("%s: condition %s cleared", alert.DisplayName(), alert.Condition),
+		}
+		requests = append(requests, on.createRequest(closeURL, payload))
+	}
+	if len(requests) == 0 && msg.Message != "" {
+		requests = append(requests, on.createRequest(on.apiUrl+alertsPath, on.createMessagePayload(msg)))
+	}
+
+	var lastErr error
+	numFailed := 0
+	for _, request := range requests {
+		_, err := on.httpClient.CallRestEndPoint(request)
+		if err != nil {
+			lastErr = err
+			numFailed++
+		}
+	}
+	if lastErr != nil {
+		return fmt.Errorf("failed to send %d out of %d requests, last error: %w", numFailed, len(requests), lastErr)
+	}
+
+	return nil
+}
+
+func (on *opsgenieNotifier) createRequest(requestURL string, payload interface{}) data.HTTPRequest {
+	// the payloads hold only strings, maps and slices of strings, so they can always be marshaled
+	body, _ := json.Marshal(payload)
+
+	return data.HTTPRequest{
+		Method: http.MethodPost,
+		URL:    requestURL,
+		Headers: map[string]string{
+			"Authorization": "GenieKey " + on.apiKey,
+		},
+		Body: body,
+	}
+}
+
+func (on *opsgenieNotifier) createAlertPayload(alert data.Alert) createAlertPayload {
+	details := map[string]string{
+		"alertId":      alert.ID,
+		"pubKey":       alert.PubKey,
+		"name":         alert.NodeName,
+		"shard":        strconv.Itoa(alert.Shard),
+		"condition":    alert.Condition,
+		"currentValue": strconv.FormatFloat(alert.CurrentValue, 'f', 2, 64),
+	}
+	optionalDetails := map[string]string{
+		"alias":    alert.Alias,
+		"identity": alert.Identity,
+		"provider": alert.Provider,
+	}
+	for key, value := range optionalDetails {
+		if value != "" {
+			details[key] = value
+		}
+	}
+	if alert.PreviousValue != 0 {
+		details["previousValue"] = strconv.FormatFloat(alert.PreviousValue, 'f', 2, 64)
+	}
+	if alert.Threshold != 0 {
+		details["threshold"] = strconv.FormatFloat(alert.Threshold, 'f', 2, 64)
+	}
+
+	description := alert.Message
+	explorerURL := notifiers.NodeExplorerURL(on.explorerUrl, alert.PubKey)
+	if explorerURL != "" {
+		description += "\n\n" + explorerURL
+		details["explorer"] = explorerURL
+	}
+
+	return createAlertPayload{
+		Message:     notifiers.Truncate(fmt.Sprintf("%s: %s", alert.DisplayName(), alert.Message), maxMessageLength),
+		Alias:       data.NewDedupKey(alert.ClientID, alert.PubKey, alert.Condition),
+		Description: notifiers.Truncate(description, maxDescriptionLength),
+		Responders:  on.responders,
+		Tags:        alert.Tags,
+		Details:     details,
+		Entity:      alert.DisplayName(),
+		Source:      notifiers.Footer,
+		Priority:    levelPriorities[alert.Level],
+	}
+}
+
+// createMessagePayload is used for the notifications which do not carry alerts, like the test notifications
+func (on *opsgenieNotifier) createMessagePayload(msg data.NotificationMessage) createAlertPayload {
+	message := strings.TrimSpace(msg.Message)
+
+	return createAlertPayload{
+		Message:     notifiers.Truncate(fmt.Sprintf("%s: %s", notifiers.Title(msg.Level), message), maxMessageLength),
+		Alias:       data.NewDedupKey(testAliasSource, notifiers.Footer, msg.Level.String()),
+		Description: notifiers.Truncate(message, maxDescriptionLength),
+		Responders:  on.responders,
+		Source:      notifiers.Footer,
+		Priority:    levelPriorities[msg.Level],
+	}
+}
+
+// NotifiesResolved returns true as the resolved alerts are closed in opsgenie
+func (on *opsgenieNotifier) NotifiesResolved() bool {
+	return true
+}
+
+// GetID will return the identifier for opsgenie notifier
+func (on *opsgenieNotifier) GetID() string {
+	return "Opsgenie"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (on *opsgenieNotifier) IsInterfaceNil() bool {
+	return on == nil
+}
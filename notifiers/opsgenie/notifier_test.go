@@ -0,0 +1,218 @@
+package opsgenie_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/opsgenie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	path  string
+	query string
+	body  map[string]interface{}
+}
+
+func createMockOpsgenieNotifierArgs() opsgenie.ArgsOpsgenieNotifier {
+	return opsgenie.ArgsOpsgenieNotifier{
+		Config: &config.Opsgenie{
+			Enabled: true,
+			ApiUrl:  "http://localhost",
+			ApiKey:  "api-key",
+			Responders: []config.OpsgenieResponder{
+				{Type: "team", Name: "ops"},
+				{Type: "user", Name: "user@example.com"},
+			},
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+// pushToReceiver will push the message to a local stand-in of the alert api and will return the received requests
+func pushToReceiver(t *testing.T, msg data.NotificationMessage) []receivedRequest {
+	received := make([]receivedRequest, 0)
+	mutReceived := sync.Mutex{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "GenieKey api-key", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		buff, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		body := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(buff, &body))
+
+		mutReceived.Lock()
+		received = append(received, receivedRequest{path: r.URL.Path, query: r.URL.RawQuery, body: body})
+		mutReceived.Unlock()
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result":"Request will be processed","requestId":"id"}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 5})
+	require.Nil(t, err)
+
+	args := createMockOpsgenieNotifierArgs()
+	args.Config.ApiUrl = server.URL + "/"
+	args.HTTPClient = httpClient
+	on, err := opsgenie.NewOpsgenieNotifier(args)
+	require.Nil(t, err)
+
+	err = on.PushMessage(msg)
+	require.Nil(t, err)
+
+	return received
+}
+
+func TestNewOpsgenieNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty api url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockOpsgenieNotifierArgs()
+		args.Config.ApiUrl = ""
+
+		on, err := opsgenie.NewOpsgenieNotifier(args)
+		require.Nil(t, on)
+		require.Equal(t, opsgenie.ErrInvalidApiUrl, err)
+	})
+
+	t.Run("empty api key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockOpsgenieNotifierArgs()
+		args.Config.ApiKey = ""
+
+		on, err := opsgenie.NewOpsgenieNotifier(args)
+		require.Nil(t, on)
+		require.Equal(t, opsgenie.ErrEmptyApiKey, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockOpsgenieNotifierArgs()
+		args.HTTPClient = nil
+
+		on, err := opsgenie.NewOpsgenieNotifier(args)
+		require.Nil(t, on)
+		require.Equal(t, opsgenie.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		on, err := opsgenie.NewOpsgenieNotifier(createMockOpsgenieNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, on.IsInterfaceNil())
+		assert.Equal(t, "Opsgenie", on.GetID())
+		assert.True(t, on.NotifiesResolved())
+	})
+}
+
+func TestOpsgenieNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	alert := data.Alert{
+		ID:           "id1",
+		ClientID:     "NodeRating",
+		Condition:    "tempRatingThreshold",
+		Level:        common.WarningEvent,
+		Message:      "temp rating below threshold",
+		PubKey:       "key1",
+		NodeName:     "node-1",
+		Alias:        "validator-1",
+		Shard:        1,
+		Tags:         []string{"prod", "eu"},
+		CurrentValue: 75,
+		Threshold:    80,
+	}
+	alias := data.NewDedupKey("NodeRating", "key1", "tempRatingThreshold")
+
+	t.Run("create and close alerts by alias", func(t *testing.T) {
+		t.Parallel()
+
+		criticalAlert := alert
+		criticalAlert.Level = common.CriticalEvent
+
+		received := pushToReceiver(t, data.NotificationMessage{
+			Level:    common.CriticalEvent,
+			Alerts:   []data.Alert{alert, criticalAlert},
+			Resolved: []data.Alert{alert},
+		})
+		require.Len(t, received, 3)
+
+		create := received[0]
+		assert.Equal(t, "/v2/alerts", create.path)
+		assert.Equal(t, "validator-1: temp rating below threshold", create.body["message"])
+		assert.Equal(t, alias, create.body["alias"])
+		assert.Equal(t, "P3", create.body["priority"])
+		assert.Equal(t, "validator-1", create.body["entity"])
+		assert.Equal(t, "MultiversX Node Monitoring", create.body["source"])
+		assert.Equal(t, []interface{}{"prod", "eu"}, create.body["tags"])
+		assert.Equal(t, "temp rating below threshold\n\nhttps://explorer.multiversx.com/nodes/key1", create.body["description"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"type": "team", "name": "ops"},
+			map[string]interface{}{"type": "user", "username": "user@example.com"},
+		}, create.body["responders"])
+
+		details := create.body["details"].(map[string]interface{})
+		assert.Equal(t, "key1", details["pubKey"])
+		assert.Equal(t, "validator-1", details["alias"])
+		assert.Equal(t, "1", details["shard"])
+		assert.Equal(t, "75.00", details["currentValue"])
+		assert.Equal(t, "80.00", details["threshold"])
+		assert.Nil(t, details["previousValue"])
+
+		assert.Equal(t, "P1", received[1].body["priority"])
+		assert.Equal(t, alias, received[1].body["alias"])
+
+		closeRequest := received[2]
+		assert.Equal(t, "/v2/alerts/"+alias+"/close", closeRequest.path)
+		assert.Equal(t, "identifierType=alias", closeRequest.query)
+		assert.Equal(t, "validator-1: condition tempRatingThreshold cleared", closeRequest.body["note"])
+	})
+
+	t.Run("message without alerts", func(t *testing.T) {
+		t.Parallel()
+
+		received := pushToReceiver(t, data.NotificationMessage{Message: "test message\n", Level: common.CriticalEvent})
+		require.Len(t, received, 1)
+		assert.Equal(t, "Node monitoring - CRITICAL: test message", received[0].body["message"])
+		assert.Equal(t, "P1", received[0].body["priority"])
+	})
+
+	t.Run("failed requests", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockOpsgenieNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		on, err := opsgenie.NewOpsgenieNotifier(args)
+		require.Nil(t, err)
+
+		err = on.PushMessage(data.NotificationMessage{Level: common.WarningEvent, Resolved: []data.Alert{alert}})
+		require.True(t, errors.Is(err, expectedErr))
+		assert.Contains(t, err.Error(), "failed to send 1 out of 1 requests")
+	})
+}
@@ -0,0 +1,12 @@
+package pagerduty
+
+import "errors"
+
+// ErrInvalidApiUrl signals that an empty events api url has been provided
+var ErrInvalidApiUrl = errors.New("empty pagerduty api url has been provided")
+
+// ErrEmptyRoutingKey signals that an empty routing key has been provided
+var ErrEmptyRoutingKey = errors.New("empty pagerduty routing key has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
@@ -0,0 +1,230 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	enqueuePath      = "/v2/enqueue"
+	actionTrigger    = "trigger"
+	actionResolve    = "resolve"
+	maxSummaryLength = 1024
+	testDedupSource  = "test"
+)
+
+// pagerduty severities, see https://developer.pagerduty.com/docs/events-api-v2/trigger-events/
+var levelSeverities = map[common.EventLevel]string{
+	common.NoEvent:       "info",
+	common.InfoEvent:     "info",
+	common.WarningEvent:  "warning",
+	common.CriticalEvent: "critical",
+}
+
+type event struct {
+	RoutingKey  string   `json:"routing_key"`
+	EventAction string   `json:"event_action"`
+	DedupKey    string   `json:"dedup_key,omitempty"`
+	Payload     *payload `json:"payload,omitempty"`
+	Client      string   `json:"client,omitempty"`
+	Links       []link   `json:"links,omitempty"`
+}
+
+type payload struct {
+	Summary       string                 `json:"summary"`
+	Source        string                 `json:"source"`
+	Severity      string                 `json:"severity"`
+	Timestamp     string                 `json:"timestamp,omitempty"`
+	Component     string                 `json:"component,omitempty"`
+	Group         string                 `json:"group,omitempty"`
+	Class         string                 `json:"class,omitempty"`
+	CustomDetails map[string]interface{} `json:"custom_details,omitempty"`
+}
+
+type link struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+// ArgsPagerDutyNotifier defines the arguments needed to create a new pagerduty notifier
+type ArgsPagerDutyNotifier struct {
+	Config     *config.PagerDuty
+	HTTPClient notifiers.HTTPClient
+}
+
+type pagerDutyNotifier struct {
+	apiUrl      string
+	routingKey  string
+	explorerUrl string
+	httpClient  notifiers.HTTPClient
+}
+
+// NewPagerDutyNotifier will create a new pagerduty notifier instance
+func NewPagerDutyNotifier(args ArgsPagerDutyNotifier) (*pagerDutyNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pagerDutyNotifier{
+		apiUrl:      strings.TrimSuffix(args.Config.ApiUrl, "/"),
+		routingKey:  args.Config.RoutingKey,
+		explorerUrl: args.Config.ExplorerUrl,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsPagerDutyNotifier) error {
+	if args.Config.ApiUrl == "" {
+		return ErrInvalidApiUrl
+	}
+	if args.Config.RoutingKey == "" {
+		return ErrEmptyRoutingKey
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will send a trigger event for every alert and a resolve event for every resolved alert. The alerts
+// of the same node and condition share the dedup key, so they are grouped in the same pagerduty incident
+func (pdn *pagerDutyNotifier) PushMessage(msg data.NotificationMessage) error {
+	events := make([]event, 0, len(msg.Alerts)+len(msg.Resolved))
+	for _, alert := range msg.Alerts {
+		events = append(events, pdn.createTriggerEvent(alert))
+	}
+	for _, alert := range msg.Resolved {
+		events = append(events, pdn.createResolveEvent(alert))
+	}
+	if len(events) == 0 && msg.Message != "" {
+		events = append(events, pdn.createMessageEvent(msg))
+	}
+
+	var lastErr error
+	numFailed := 0
+	for _, ev := range events {
+		err := pdn.httpClient.CallPostRestEndPoint(pdn.apiUrl, enqueuePath, ev)
+		if err != nil {
+			lastErr = err
+			numFailed++
+		}
+	}
+	if lastErr != nil {
+		return fmt.Errorf("failed to send %d out of %d events, last error: %w", numFailed, len(events), lastErr)
+	}
+
+	return nil
+}
+
+func (pdn *pagerDutyNotifier) createTriggerEvent(alert data.Alert) event {
+	ev := event{
+		RoutingKey:  pdn.routingKey,
+		EventAction: actionTrigger,
+		DedupKey:    data.NewDedupKey(alert.ClientID, alert.PubKey, alert.Condition),
+		Payload: &payload{
+			Summary:       notifiers.Truncate(fmt.Sprintf("%s: %s", alert.DisplayName(), alert.Message), maxSummaryLength),
+			Source:        alert.DisplayName(),
+			Severity:      levelSeverities[alert.Level],
+			Timestamp:     time.Now().UTC().Format(time.RFC3339),
+			Component:     alert.ClientID,
+			Group:         "shard " + strconv.Itoa(alert.Shard),
+			Class:         alert.Condition,
+			CustomDetails: createCustomDetails(alert),
+		},
+		Client: notifiers.Footer,
+	}
+
+	explorerURL := notifiers.NodeExplorerURL(pdn.explorerUrl, alert.PubKey)
+	if explorerURL != "" {
+		ev.Links = []link{
+			{
+				Href: explorerURL,
+				Text: "View in explorer",
+			},
+		}
+	}
+
+	return ev
+}
+
+func createCustomDetails(alert data.Alert) map[string]interface{} {
+	details := map[string]interface{}{
+		"alertId":      alert.ID,
+		"message":      alert.Message,
+		"pubKey":       alert.PubKey,
+		"name":         alert.NodeName,
+		"shard":        alert.Shard,
+		"currentValue": alert.CurrentValue,
+	}
+
+	optionalDetails := map[string]string{
+		"alias":    alert.Alias,
+		"identity": alert.Identity,
+		"provider": alert.Provider,
+	}
+	for key, value := range optionalDetails {
+		if value != "" {
+			details[key] = value
+		}
+	}
+	if len(alert.Tags) > 0 {
+		details["tags"] = alert.Tags
+	}
+	if alert.PreviousValue != 0 {
+		details["previousValue"] = alert.PreviousValue
+	}
+	if alert.Threshold != 0 {
+		details["threshold"] = alert.Threshold
+	}
+
+	return details
+}
+
+func (pdn *pagerDutyNotifier) createResolveEvent(alert data.Alert) event {
+	return event{
+		RoutingKey:  pdn.routingKey,
+		EventAction: actionResolve,
+		DedupKey:    data.NewDedupKey(alert.ClientID, alert.PubKey, alert.Condition),
+	}
+}
+
+// createMessageEvent is used for the notifications which do not carry alerts, like the test notifications
+func (pdn *pagerDutyNotifier) createMessageEvent(msg data.NotificationMessage) event {
+	return event{
+		RoutingKey:  pdn.routingKey,
+		EventAction: actionTrigger,
+		DedupKey:    data.NewDedupKey(testDedupSource, notifiers.Footer, msg.Level.String()),
+		Payload: &payload{
+			Summary:   notifiers.Truncate(fmt.Sprintf("%s: %s", notifiers.Title(msg.Level), strings.TrimSpace(msg.Message)), maxSummaryLength),
+			Source:    notifiers.Footer,
+			Severity:  levelSeverities[msg.Level],
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		},
+		Client: notifiers.Footer,
+	}
+}
+
+// NotifiesResolved returns true as the resolved alerts close the pagerduty incidents
+func (pdn *pagerDutyNotifier) NotifiesResolved() bool {
+	return true
+}
+
+// GetID will return the identifier for pagerduty notifier
+func (pdn *pagerDutyNotifier) GetID() string {
+	return "PagerDuty"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pdn *pagerDutyNotifier) IsInterfaceNil() bool {
+	return pdn == nil
+}
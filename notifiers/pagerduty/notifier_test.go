@@ -0,0 +1,221 @@
+package pagerduty_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/pagerduty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockPagerDutyNotifierArgs() pagerduty.ArgsPagerDutyNotifier {
+	return pagerduty.ArgsPagerDutyNotifier{
+		Config: &config.PagerDuty{
+			Enabled:     true,
+			ApiUrl:      "https://events.pagerduty.com/",
+			RoutingKey:  "routing-key",
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+// pushAndCollect will push the message and will return the events sent, decoded as generic json objects
+func pushAndCollect(t *testing.T, msg data.NotificationMessage) []map[string]interface{} {
+	events := make([]map[string]interface{}, 0)
+
+	args := createMockPagerDutyNotifierArgs()
+	args.HTTPClient = &mocks.HTTPClientStub{
+		CallPostRestEndPointCalled: func(address string, path string, data interface{}) error {
+			assert.Equal(t, "https://events.pagerduty.com", address)
+			assert.Equal(t, "/v2/enqueue", path)
+
+			buff, err := json.Marshal(data)
+			require.Nil(t, err)
+			event := make(map[string]interface{})
+			require.Nil(t, json.Unmarshal(buff, &event))
+			events = append(events, event)
+
+			return nil
+		},
+	}
+	pdn, err := pagerduty.NewPagerDutyNotifier(args)
+	require.Nil(t, err)
+
+	err = pdn.PushMessage(msg)
+	require.Nil(t, err)
+
+	return events
+}
+
+func TestNewPagerDutyNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty api url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPagerDutyNotifierArgs()
+		args.Config.ApiUrl = ""
+
+		pdn, err := pagerduty.NewPagerDutyNotifier(args)
+		require.Nil(t, pdn)
+		require.Equal(t, pagerduty.ErrInvalidApiUrl, err)
+	})
+
+	t.Run("empty routing key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPagerDutyNotifierArgs()
+		args.Config.RoutingKey = ""
+
+		pdn, err := pagerduty.NewPagerDutyNotifier(args)
+		require.Nil(t, pdn)
+		require.Equal(t, pagerduty.ErrEmptyRoutingKey, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPagerDutyNotifierArgs()
+		args.HTTPClient = nil
+
+		pdn, err := pagerduty.NewPagerDutyNotifier(args)
+		require.Nil(t, pdn)
+		require.Equal(t, pagerduty.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pdn, err := pagerduty.NewPagerDutyNotifier(createMockPagerDutyNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, pdn.IsInterfaceNil())
+		assert.Equal(t, "PagerDuty", pdn.GetID())
+		assert.True(t, pdn.NotifiesResolved())
+	})
+}
+
+func TestPagerDutyNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	alert := data.Alert{
+		ID:            "id1",
+		ClientID:      "NodeRating",
+		Condition:     "ratingDecrease",
+		Level:         common.CriticalEvent,
+		Message:       "rating decreased",
+		PubKey:        "key1",
+		NodeName:      "node-1",
+		Alias:         "validator-1",
+		Identity:      "identity",
+		Shard:         2,
+		Tags:          []string{"prod"},
+		CurrentValue:  90,
+		PreviousValue: 95,
+		Threshold:     1,
+	}
+	dedupKey := data.NewDedupKey("NodeRating", "key1", "ratingDecrease")
+
+	t.Run("trigger and resolve events share the dedup key", func(t *testing.T) {
+		t.Parallel()
+
+		warningAlert := alert
+		warningAlert.Level = common.WarningEvent
+		resolvedAlert := alert
+		resolvedAlert.Condition = "tempRatingThreshold"
+
+		events := pushAndCollect(t, data.NotificationMessage{
+			Level:    common.CriticalEvent,
+			Alerts:   []data.Alert{alert, warningAlert},
+			Resolved: []data.Alert{resolvedAlert},
+		})
+		require.Len(t, events, 3)
+
+		trigger := events[0]
+		assert.Equal(t, "routing-key", trigger["routing_key"])
+		assert.Equal(t, "trigger", trigger["event_action"])
+		assert.Equal(t, dedupKey, trigger["dedup_key"])
+
+		payload := trigger["payload"].(map[string]interface{})
+		assert.Equal(t, "validator-1: rating decreased", payload["summary"])
+		assert.Equal(t, "validator-1", payload["source"])
+		assert.Equal(t, "critical", payload["severity"])
+		assert.Equal(t, "NodeRating", payload["component"])
+		assert.Equal(t, "shard 2", payload["group"])
+		assert.Equal(t, "ratingDecrease", payload["class"])
+		assert.Equal(t, map[string]interface{}{
+			"alertId":       "id1",
+			"message":       "rating decreased",
+			"pubKey":        "key1",
+			"name":          "node-1",
+			"alias":         "validator-1",
+			"identity":      "identity",
+			"shard":         float64(2),
+			"tags":          []interface{}{"prod"},
+			"currentValue":  float64(90),
+			"previousValue": float64(95),
+			"threshold":     float64(1),
+		}, payload["custom_details"])
+
+		links := trigger["links"].([]interface{})
+		require.Len(t, links, 1)
+		assert.Equal(t, "https://explorer.multiversx.com/nodes/key1", links[0].(map[string]interface{})["href"])
+
+		assert.Equal(t, "warning", events[1]["payload"].(map[string]interface{})["severity"])
+		assert.Equal(t, dedupKey, events[1]["dedup_key"])
+
+		resolve := events[2]
+		assert.Equal(t, "resolve", resolve["event_action"])
+		assert.Equal(t, data.NewDedupKey("NodeRating", "key1", "tempRatingThreshold"), resolve["dedup_key"])
+		assert.Nil(t, resolve["payload"])
+	})
+
+	t.Run("message without alerts", func(t *testing.T) {
+		t.Parallel()
+
+		events := pushAndCollect(t, data.NotificationMessage{Message: "test message\n", Level: common.InfoEvent})
+		require.Len(t, events, 1)
+
+		payload := events[0]["payload"].(map[string]interface{})
+		assert.Equal(t, "Node monitoring - INFO: test message", payload["summary"])
+		assert.Equal(t, "info", payload["severity"])
+		assert.Nil(t, payload["custom_details"])
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		t.Parallel()
+
+		events := pushAndCollect(t, data.NotificationMessage{Level: common.InfoEvent})
+		assert.Empty(t, events)
+	})
+
+	t.Run("failed events", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		args := createMockPagerDutyNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallPostRestEndPointCalled: func(address string, path string, data interface{}) error {
+				numCalls++
+				if numCalls == 1 {
+					return expectedErr
+				}
+				return nil
+			},
+		}
+		pdn, err := pagerduty.NewPagerDutyNotifier(args)
+		require.Nil(t, err)
+
+		err = pdn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert, alert}})
+		require.True(t, errors.Is(err, expectedErr))
+		assert.Contains(t, err.Error(), "failed to send 1 out of 2 events")
+		assert.Equal(t, 2, numCalls)
+	})
+}
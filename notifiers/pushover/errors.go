@@ -0,0 +1,15 @@
+package pushover
+
+import "errors"
+
+// ErrInvalidApiUrl signals that an empty api url has been provided
+var ErrInvalidApiUrl = errors.New("empty pushover api url has been provided")
+
+// ErrEmptyAppToken signals that an empty application token has been provided
+var ErrEmptyAppToken = errors.New("empty pushover application token has been provided")
+
+// ErrEmptyUserKey signals that an empty user key has been provided
+var ErrEmptyUserKey = errors.New("empty pushover user key has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
@@ -0,0 +1,140 @@
+package pushover
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	messagesPath      = "/1/messages.json"
+	maxTitleLength    = 250
+	maxMessageLength  = 1024
+	emergencyPriority = 2
+
+	// defaultRetrySec and defaultExpireSec are used for the emergency notifications when not configured
+	defaultRetrySec  = 60
+	defaultExpireSec = 3600
+)
+
+// pushover priorities, see https://pushover.net/api#priority. The emergency priority is repeated until acknowledged
+var levelPriorities = map[common.EventLevel]int{
+	common.NoEvent:       -1,
+	common.InfoEvent:     0,
+	common.WarningEvent:  1,
+	common.CriticalEvent: emergencyPriority,
+}
+
+type payload struct {
+	Token    string `json:"token"`
+	User     string `json:"user"`
+	Device   string `json:"device,omitempty"`
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority"`
+	Retry    int    `json:"retry,omitempty"`
+	Expire   int    `json:"expire,omitempty"`
+	URL      string `json:"url,omitempty"`
+	URLTitle string `json:"url_title,omitempty"`
+}
+
+// ArgsPushoverNotifier defines the arguments needed to create a new pushover notifier
+type ArgsPushoverNotifier struct {
+	Config     *config.Pushover
+	HTTPClient notifiers.HTTPClient
+}
+
+type pushoverNotifier struct {
+	apiUrl      string
+	appToken    string
+	userKey     string
+	device      string
+	retrySec    int
+	expireSec   int
+	explorerUrl string
+	httpClient  notifiers.HTTPClient
+}
+
+// NewPushoverNotifier will create a new pushover notifier instance
+func NewPushoverNotifier(args ArgsPushoverNotifier) (*pushoverNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	retrySec := args.Config.EmergencyRetrySec
+	if retrySec == 0 {
+		retrySec = defaultRetrySec
+	}
+	expireSec := args.Config.EmergencyExpireSec
+	if expireSec == 0 {
+		expireSec = defaultExpireSec
+	}
+
+	return &pushoverNotifier{
+		apiUrl:      strings.TrimSuffix(args.Config.ApiUrl, "/"),
+		appToken:    args.Config.AppToken,
+		userKey:     args.Config.UserKey,
+		device:      args.Config.Device,
+		retrySec:    retrySec,
+		expireSec:   expireSec,
+		explorerUrl: args.Config.ExplorerUrl,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsPushoverNotifier) error {
+	if args.Config.ApiUrl == "" {
+		return ErrInvalidApiUrl
+	}
+	if args.Config.AppToken == "" {
+		return ErrEmptyAppToken
+	}
+	if args.Config.UserKey == "" {
+		return ErrEmptyUserKey
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will push the notification to the user (or group) devices. The critical notifications are sent with
+// the emergency priority, so they are repeated every retry interval until acknowledged in the app or expired
+func (pn *pushoverNotifier) PushMessage(msg data.NotificationMessage) error {
+	msgPayload := payload{
+		Token:    pn.appToken,
+		User:     pn.userKey,
+		Device:   pn.device,
+		Title:    notifiers.Truncate(notifiers.Title(msg.Level), maxTitleLength),
+		Message:  notifiers.Truncate(strings.TrimSpace(msg.Message), maxMessageLength),
+		Priority: levelPriorities[msg.Level],
+	}
+	if msgPayload.Priority == emergencyPriority {
+		msgPayload.Retry = pn.retrySec
+		msgPayload.Expire = pn.expireSec
+	}
+
+	explorerURL := notifiers.SingleNodeExplorerURL(pn.explorerUrl, msg)
+	if explorerURL != "" {
+		msgPayload.URL = explorerURL
+		msgPayload.URLTitle = "View in explorer"
+	}
+
+	return pn.httpClient.CallPostRestEndPoint(pn.apiUrl, messagesPath, msgPayload)
+}
+
+// GetID will return the identifier for pushover notifier
+func (pn *pushoverNotifier) GetID() string {
+	return "Pushover"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pn *pushoverNotifier) IsInterfaceNil() bool {
+	return pn == nil
+}
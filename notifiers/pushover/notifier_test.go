@@ -0,0 +1,152 @@
+package pushover_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/pushover"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockPushoverNotifierArgs() pushover.ArgsPushoverNotifier {
+	return pushover.ArgsPushoverNotifier{
+		Config: &config.Pushover{
+			Enabled:     true,
+			ApiUrl:      "https://api.pushover.net",
+			AppToken:    "app-token",
+			UserKey:     "user-key",
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+// pushAndCapture will push the message and will return the payload sent, decoded as a generic json object
+func pushAndCapture(t *testing.T, args pushover.ArgsPushoverNotifier, msg data.NotificationMessage) map[string]interface{} {
+	sent := make(map[string]interface{})
+	args.HTTPClient = &mocks.HTTPClientStub{
+		CallPostRestEndPointCalled: func(address string, path string, payload interface{}) error {
+			assert.Equal(t, "https://api.pushover.net", address)
+			assert.Equal(t, "/1/messages.json", path)
+
+			buff, err := json.Marshal(payload)
+			require.Nil(t, err)
+			require.Nil(t, json.Unmarshal(buff, &sent))
+
+			return nil
+		},
+	}
+
+	pn, err := pushover.NewPushoverNotifier(args)
+	require.Nil(t, err)
+	require.Nil(t, pn.PushMessage(msg))
+
+	return sent
+}
+
+func TestNewPushoverNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty api url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPushoverNotifierArgs()
+		args.Config.ApiUrl = ""
+
+		pn, err := pushover.NewPushoverNotifier(args)
+		require.Nil(t, pn)
+		require.Equal(t, pushover.ErrInvalidApiUrl, err)
+	})
+
+	t.Run("empty app token", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPushoverNotifierArgs()
+		args.Config.AppToken = ""
+
+		pn, err := pushover.NewPushoverNotifier(args)
+		require.Nil(t, pn)
+		require.Equal(t, pushover.ErrEmptyAppToken, err)
+	})
+
+	t.Run("empty user key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPushoverNotifierArgs()
+		args.Config.UserKey = ""
+
+		pn, err := pushover.NewPushoverNotifier(args)
+		require.Nil(t, pn)
+		require.Equal(t, pushover.ErrEmptyUserKey, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPushoverNotifierArgs()
+		args.HTTPClient = nil
+
+		pn, err := pushover.NewPushoverNotifier(args)
+		require.Nil(t, pn)
+		require.Equal(t, pushover.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pn, err := pushover.NewPushoverNotifier(createMockPushoverNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, pn.IsInterfaceNil())
+		assert.Equal(t, "Pushover", pn.GetID())
+	})
+}
+
+func TestPushoverNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("critical events use the emergency priority", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPushoverNotifierArgs()
+		args.Config.EmergencyRetrySec = 120
+		args.Config.Device = "phone"
+		sent := pushAndCapture(t, args, data.NotificationMessage{
+			Message: "message\n",
+			Level:   common.CriticalEvent,
+			Alerts:  []data.Alert{{PubKey: "key1"}},
+		})
+
+		assert.Equal(t, map[string]interface{}{
+			"token":     "app-token",
+			"user":      "user-key",
+			"device":    "phone",
+			"title":     "Node monitoring - CRITICAL",
+			"message":   "message",
+			"priority":  float64(2),
+			"retry":     float64(120),
+			"expire":    float64(3600),
+			"url":       "https://explorer.multiversx.com/nodes/key1",
+			"url_title": "View in explorer",
+		}, sent)
+	})
+
+	t.Run("warning events use the high priority", func(t *testing.T) {
+		t.Parallel()
+
+		sent := pushAndCapture(t, createMockPushoverNotifierArgs(), data.NotificationMessage{
+			Message: "message",
+			Level:   common.WarningEvent,
+			Alerts:  []data.Alert{{PubKey: "key1"}, {PubKey: "key2"}},
+		})
+
+		assert.Equal(t, float64(1), sent["priority"])
+		assert.NotContains(t, sent, "retry")
+		assert.NotContains(t, sent, "expire")
+		assert.NotContains(t, sent, "url")
+	})
+}
@@ -0,0 +1,188 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+// slack limits, see https://api.slack.com/reference/block-kit/blocks
+const (
+	maxHeaderLength  = 150
+	maxTextLength    = 3000
+	maxBlocks        = 50
+	maxFallbackTexts = 4000
+)
+
+// payload is used by both the incoming webhooks and the chat.postMessage method; the channel and the thread are
+// ignored by the webhooks. The blocks are wrapped in an attachment, so they are highlighted with the level color
+type payload struct {
+	Channel     string       `json:"channel,omitempty"`
+	ThreadTs    string       `json:"thread_ts,omitempty"`
+	Text        string       `json:"text"`
+	Attachments []attachment `json:"attachments,omitempty"`
+}
+
+type attachment struct {
+	Color  string  `json:"color"`
+	Blocks []block `json:"blocks"`
+}
+
+// block holds the fields of the blocks used: header, section, context and actions. The context blocks hold text
+// fields as elements, while the actions blocks hold buttons
+type block struct {
+	Type     string        `json:"type"`
+	BlockID  string        `json:"block_id,omitempty"`
+	Text     *textField    `json:"text,omitempty"`
+	Fields   []textField   `json:"fields,omitempty"`
+	Elements []interface{} `json:"elements,omitempty"`
+}
+
+type textField struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// element holds the fields of the buttons
+type element struct {
+	Type     string     `json:"type"`
+	Text     *textField `json:"text,omitempty"`
+	ActionID string     `json:"action_id,omitempty"`
+	URL      string     `json:"url,omitempty"`
+	Value    string     `json:"value,omitempty"`
+	Style    string     `json:"style,omitempty"`
+}
+
+func plainText(text string) *textField {
+	return &textField{Type: "plain_text", Text: text}
+}
+
+func markdownText(text string) *textField {
+	return &textField{Type: "mrkdwn", Text: notifiers.Truncate(text, maxTextLength)}
+}
+
+// createPayload builds the message for the provided alerts, preceded by the plain message, if any. The message should
+// not repeat the lines of the alerts. The firing alerts get the ack and silence buttons, if the interactions are enabled
+func (sn *slackNotifier) createPayload(title string, level common.EventLevel, message string, alerts []data.Alert, firing bool) payload {
+	blocks := []block{
+		{
+			Type: "header",
+			Text: plainText(notifiers.Truncate(title, maxHeaderLength)),
+		},
+	}
+
+	fallback := make([]string, 0, len(alerts)+1)
+	fallback = append(fallback, title)
+	// every node takes up to 2 blocks, while the header, the message, the footer and the summary of the nodes left
+	// out take one
+	reservedBlocks := 3
+	if strings.TrimSpace(message) != "" {
+		blocks = append(blocks, block{
+			Type: "section",
+			Text: markdownText(message),
+		})
+		fallback = append(fallback, strings.TrimSpace(message))
+		reservedBlocks++
+	}
+
+	maxAlerts := (maxBlocks - reservedBlocks) / 2
+	for idx, alert := range alerts {
+		if idx == maxAlerts {
+			blocks = append(blocks, block{
+				Type: "section",
+				Text: markdownText(fmt.Sprintf("_and %d more_", len(alerts)-maxAlerts)),
+			})
+			break
+		}
+
+		blocks = append(blocks, sn.createNodeBlocks(alert, firing && sn.interactive)...)
+		fallback = append(fallback, fmt.Sprintf("%s: %s", alert.DisplayName(), alert.Message))
+	}
+
+	blocks = append(blocks, block{
+		Type: "context",
+		Elements: []interface{}{
+			markdownText(fmt.Sprintf("%s - %s", notifiers.Footer, time.Now().UTC().Format(time.RFC1123))),
+		},
+	})
+
+	return payload{
+		Text: notifiers.Truncate(strings.Join(fallback, "\n"), maxFallbackTexts),
+		Attachments: []attachment{
+			{
+				Color:  notifiers.LevelHexColor(level),
+				Blocks: blocks,
+			},
+		},
+	}
+}
+
+func (sn *slackNotifier) createNodeBlocks(alert data.Alert, withActions bool) []block {
+	section := block{
+		Type: "section",
+		Text: markdownText(fmt.Sprintf("*%s*\n%s", alert.DisplayName(), alert.Message)),
+		Fields: []textField{
+			*markdownText(fmt.Sprintf("*Temp rating*\n%s", formatRating(alert))),
+			*markdownText(fmt.Sprintf("*Alert*\n`%s` %s", alert.ID, strings.ToUpper(alert.Level.String()))),
+		},
+	}
+
+	buttons := make([]interface{}, 0, 3)
+	explorerURL := notifiers.NodeExplorerURL(sn.explorerUrl, alert.PubKey)
+	if explorerURL != "" {
+		buttons = append(buttons, element{
+			Type:     "button",
+			Text:     plainText("View in explorer"),
+			ActionID: actionExplorer,
+			URL:      explorerURL,
+		})
+	}
+	if withActions {
+		buttons = append(buttons,
+			element{
+				Type:     "button",
+				Text:     plainText("Ack"),
+				ActionID: actionAck,
+				Value:    alert.ID,
+				Style:    "primary",
+			},
+			element{
+				Type:     "button",
+				Text:     plainText("Silence 1h"),
+				ActionID: actionSilence,
+				Value:    alert.PubKey,
+			},
+		)
+	}
+	if len(buttons) == 0 {
+		return []block{section}
+	}
+
+	actions := block{
+		Type:     "actions",
+		Elements: buttons,
+	}
+	if withActions {
+		// the block id identifies the node section to be updated after one of its buttons is clicked
+		actions.BlockID = actionsBlockPrefix + alert.ID
+	}
+
+	return []block{section, actions}
+}
+
+// formatRating shows the rating before and after the change, or the rating and the threshold it is compared with
+func formatRating(alert data.Alert) string {
+	rating := fmt.Sprintf("%.2f", alert.CurrentValue)
+	if alert.PreviousValue != 0 {
+		rating = fmt.Sprintf("%.2f → %.2f", alert.PreviousValue, alert.CurrentValue)
+	}
+	if alert.Threshold != 0 && alert.PreviousValue == 0 {
+		rating += fmt.Sprintf(" (threshold %.2f)", alert.Threshold)
+	}
+
+	return rating
+}
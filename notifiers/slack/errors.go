@@ -7,3 +7,27 @@ var ErrInvalidSlackURL = errors.New("empty slack url has been provided")
 
 // ErrNilHTTPClient signals that a nil http client has been provided
 var ErrNilHTTPClient = errors.New("nil http client")
+
+// ErrInvalidApiUrl signals that an empty web api url has been provided
+var ErrInvalidApiUrl = errors.New("empty slack api url has been provided")
+
+// ErrEmptyChannel signals that no channel has been provided for the bot token mode
+var ErrEmptyChannel = errors.New("empty slack channel has been provided")
+
+// ErrInvalidLevel signals that a channel has been provided for an unknown event level
+var ErrInvalidLevel = errors.New("invalid event level")
+
+// ErrSlackAPI signals that the slack web api rejected the request
+var ErrSlackAPI = errors.New("slack api error")
+
+// ErrEmptySigningSecret signals that an empty signing secret has been provided
+var ErrEmptySigningSecret = errors.New("empty slack signing secret has been provided")
+
+// ErrEmptyListenAddress signals that an empty listen address has been provided
+var ErrEmptyListenAddress = errors.New("empty slack interactions listen address has been provided")
+
+// ErrNilAlertsManager signals that a nil alerts manager has been provided
+var ErrNilAlertsManager = errors.New("nil alerts manager")
+
+// ErrInvalidSignature signals that the request signature could not be verified
+var ErrInvalidSignature = errors.New("invalid slack request signature")
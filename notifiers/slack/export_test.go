@@ -0,0 +1,8 @@
+package slack
+
+import "time"
+
+// SetGetTimeHandler -
+func (is *interactionsServer) SetGetTimeHandler(handler func() time.Time) {
+	is.getTimeHandler = handler
+}
@@ -0,0 +1,328 @@
+package slack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	// InteractionsPath is the path the slack app interactivity request url should point to
+	InteractionsPath = "/slack/actions"
+
+	actionExplorer = "explorer"
+	actionAck      = "ack"
+	actionSilence  = "silence_1h"
+
+	actionsBlockPrefix = "actions-"
+
+	silenceDuration    = time.Hour
+	maxRequestAge      = 5 * time.Minute
+	maxRequestBodySize = 1 << 20
+	signatureVersion   = "v0"
+	shutdownTimeout    = 5 * time.Second
+	readHeaderTimeout  = 10 * time.Second
+	blockActionsType   = "block_actions"
+)
+
+type interactionPayload struct {
+	Type        string                 `json:"type"`
+	User        slackUser              `json:"user"`
+	Actions     []action               `json:"actions"`
+	ResponseURL string                 `json:"response_url"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+type slackUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type action struct {
+	ActionID string `json:"action_id"`
+	BlockID  string `json:"block_id"`
+	Value    string `json:"value"`
+}
+
+// ArgsInteractionsServer defines the arguments needed to create a new slack interactions server
+type ArgsInteractionsServer struct {
+	Config        *config.Slack
+	HTTPClient    notifiers.HTTPClient
+	AlertsManager AlertsManager
+}
+
+type interactionsServer struct {
+	signingSecret  []byte
+	httpClient     notifiers.HTTPClient
+	alertsManager  AlertsManager
+	server         *http.Server
+	getTimeHandler func() time.Time
+}
+
+// InteractionsEnabled returns true if the config enables the handling of the slack buttons
+func InteractionsEnabled(cfg *config.Slack) bool {
+	return cfg.SigningSecret != "" && cfg.InteractionsListenAddress != ""
+}
+
+// NewInteractionsServer will create a new http server which handles the ack and silence buttons of the slack messages
+func NewInteractionsServer(args ArgsInteractionsServer) (*interactionsServer, error) {
+	if args.Config.SigningSecret == "" {
+		return nil, ErrEmptySigningSecret
+	}
+	if args.Config.InteractionsListenAddress == "" {
+		return nil, ErrEmptyListenAddress
+	}
+	if check.IfNil(args.HTTPClient) {
+		return nil, ErrNilHTTPClient
+	}
+	if check.IfNil(args.AlertsManager) {
+		return nil, ErrNilAlertsManager
+	}
+
+	is := &interactionsServer{
+		signingSecret:  []byte(args.Config.SigningSecret),
+		httpClient:     args.HTTPClient,
+		alertsManager:  args.AlertsManager,
+		getTimeHandler: time.Now,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(InteractionsPath, is)
+	is.server = &http.Server{
+		Addr:              args.Config.InteractionsListenAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return is, nil
+}
+
+// Start will start serving requests on a separate go routine
+func (is *interactionsServer) Start() {
+	go func() {
+		log.Info("starting slack interactions http server", "address", is.server.Addr, "path", InteractionsPath)
+
+		err := is.server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("slack interactions http server stopped", "error", err.Error())
+		}
+	}()
+}
+
+// ServeHTTP will verify the signature of the request and will handle the clicked buttons
+func (is *interactionsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = is.verifySignature(r.Header, body)
+	if err != nil {
+		log.Debug("slack request rejected", "error", err.Error())
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	payload := interactionPayload{}
+	err = json.Unmarshal([]byte(values.Get("payload")), &payload)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// slack expects the response in 3 seconds, the message is updated with the response url
+	w.WriteHeader(http.StatusOK)
+	if payload.Type != blockActionsType {
+		return
+	}
+
+	for _, clickedAction := range payload.Actions {
+		is.handleAction(payload, clickedAction)
+	}
+}
+
+// verifySignature checks the signature computed over "v0:<timestamp>:<body>" with the signing secret and rejects
+// the old requests, see https://api.slack.com/authentication/verifying-requests-from-slack
+func (is *interactionsServer) verifySignature(header http.Header, body []byte) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	unixTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp %q", ErrInvalidSignature, timestamp)
+	}
+
+	age := is.getTimeHandler().Sub(time.Unix(unixTimestamp, 0))
+	if math.Abs(float64(age)) > float64(maxRequestAge) {
+		return fmt.Errorf("%w: request timestamp too old, %s", ErrInvalidSignature, age)
+	}
+
+	mac := hmac.New(sha256.New, is.signingSecret)
+	_, _ = mac.Write([]byte(fmt.Sprintf("%s:%s:", signatureVersion, timestamp)))
+	_, _ = mac.Write(body)
+	expected := signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature"))) {
+		return fmt.Errorf("%w: signature mismatch", ErrInvalidSignature)
+	}
+
+	return nil
+}
+
+func (is *interactionsServer) handleAction(payload interactionPayload, clickedAction action) {
+	by := userName(payload.User)
+
+	var note string
+	var err error
+	switch clickedAction.ActionID {
+	case actionAck:
+		_, err = is.alertsManager.Acknowledge(clickedAction.Value, by)
+		note = fmt.Sprintf(":white_check_mark: Acknowledged by %s", by)
+	case actionSilence:
+		_, err = is.alertsManager.Silence(clickedAction.Value, silenceDuration, by)
+		note = fmt.Sprintf(":no_bell: Silenced for 1h by %s", by)
+	default:
+		return
+	}
+	if err != nil {
+		log.Warn("slack action failed", "action", clickedAction.ActionID, "value", clickedAction.Value, "by", by, "error", err.Error())
+		is.respond(payload.ResponseURL, map[string]interface{}{
+			"response_type":    "ephemeral",
+			"replace_original": false,
+			"text":             fmt.Sprintf("Could not apply the action: %s", err.Error()),
+		})
+		return
+	}
+
+	log.Info("slack action applied", "action", clickedAction.ActionID, "value", clickedAction.Value, "by", by)
+	if payload.Message == nil {
+		return
+	}
+
+	updatedMessage := markActionApplied(payload.Message, clickedAction.BlockID, note)
+	updatedMessage["replace_original"] = true
+	is.respond(payload.ResponseURL, updatedMessage)
+}
+
+func (is *interactionsServer) respond(responseURL string, response map[string]interface{}) {
+	if responseURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		log.Warn("failed to marshal the slack response", "error", err.Error())
+		return
+	}
+
+	_, err = is.httpClient.CallRestEndPoint(data.HTTPRequest{
+		Method: http.MethodPost,
+		URL:    responseURL,
+		Body:   body,
+	})
+	if err != nil {
+		log.Warn("failed to update the slack message", "error", err.Error())
+	}
+}
+
+func userName(user slackUser) string {
+	if user.Username != "" {
+		return "@" + user.Username
+	}
+	if user.Name != "" {
+		return "@" + user.Name
+	}
+
+	return user.ID
+}
+
+// markActionApplied removes the ack and silence buttons from the actions block which was clicked and adds a context
+// block after it, showing who acted
+func markActionApplied(message map[string]interface{}, blockID string, note string) map[string]interface{} {
+	attachments, _ := message["attachments"].([]interface{})
+	for _, attachmentValue := range attachments {
+		attachmentFields, ok := attachmentValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		blocks, _ := attachmentFields["blocks"].([]interface{})
+		attachmentFields["blocks"] = markBlocks(blocks, blockID, note)
+	}
+
+	return map[string]interface{}{
+		"text":        message["text"],
+		"attachments": attachments,
+	}
+}
+
+func markBlocks(blocks []interface{}, blockID string, note string) []interface{} {
+	markedBlocks := make([]interface{}, 0, len(blocks)+1)
+	for _, blockValue := range blocks {
+		blockFields, ok := blockValue.(map[string]interface{})
+		if !ok || blockFields["block_id"] != blockID {
+			markedBlocks = append(markedBlocks, blockValue)
+			continue
+		}
+
+		elements, _ := blockFields["elements"].([]interface{})
+		keptElements := make([]interface{}, 0, len(elements))
+		for _, elementValue := range elements {
+			elementFields, _ := elementValue.(map[string]interface{})
+			actionID := elementFields["action_id"]
+			if actionID == actionAck || actionID == actionSilence {
+				continue
+			}
+			keptElements = append(keptElements, elementValue)
+		}
+		if len(keptElements) > 0 {
+			blockFields["elements"] = keptElements
+			markedBlocks = append(markedBlocks, blockFields)
+		}
+
+		markedBlocks = append(markedBlocks, block{
+			Type:     "context",
+			Elements: []interface{}{markdownText(note)},
+		})
+	}
+
+	return markedBlocks
+}
+
+// Close will gracefully stop the http server
+func (is *interactionsServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return is.server.Shutdown(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (is *interactionsServer) IsInterfaceNil() bool {
+	return is == nil
+}
@@ -0,0 +1,325 @@
+package slack_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSigningSecret = "signing-secret"
+	testResponseURL   = "https://hooks.slack.com/actions/T1/1/xyz"
+)
+
+var testNow = time.Unix(1700000000, 0)
+
+func createMockInteractionsServerArgs(t *testing.T) slack.ArgsInteractionsServer {
+	alertsState, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+	require.Nil(t, err)
+
+	return slack.ArgsInteractionsServer{
+		Config: &config.Slack{
+			SigningSecret:             testSigningSecret,
+			InteractionsListenAddress: "localhost:0",
+		},
+		HTTPClient:    &mocks.HTTPClientStub{},
+		AlertsManager: alertsState,
+	}
+}
+
+func createSignedRequest(timestamp time.Time, interaction map[string]interface{}) *http.Request {
+	payloadBuff, _ := json.Marshal(interaction)
+	body := url.Values{"payload": []string{string(payloadBuff)}}.Encode()
+	ts := fmt.Sprintf("%d", timestamp.Unix())
+
+	mac := hmac.New(sha256.New, []byte(testSigningSecret))
+	_, _ = mac.Write([]byte("v0:" + ts + ":" + body))
+
+	request := httptest.NewRequest(http.MethodPost, slack.InteractionsPath, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("X-Slack-Request-Timestamp", ts)
+	request.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+
+	return request
+}
+
+// postInteractiveMessage pushes the alert through a bot mode notifier with the interactions enabled and returns
+// the posted message, as slack sends it back on the button clicks
+func postInteractiveMessage(t *testing.T, alert data.Alert) map[string]interface{} {
+	receiver := &botReceiver{}
+	args := createMockBotArgs()
+	args.Config.SigningSecret = testSigningSecret
+	args.Config.InteractionsListenAddress = "localhost:0"
+	args.HTTPClient = &mocks.HTTPClientStub{
+		CallRestEndPointCalled: receiver.handler(t),
+	}
+	sn, err := slack.NewSlackNotifier(args)
+	require.Nil(t, err)
+
+	err = sn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+	require.Nil(t, err)
+	require.Len(t, receiver.posted, 1)
+
+	return receiver.posted[0]
+}
+
+func TestNewInteractionsServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty signing secret", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockInteractionsServerArgs(t)
+		args.Config.SigningSecret = ""
+		is, err := slack.NewInteractionsServer(args)
+		assert.Nil(t, is)
+		assert.Equal(t, slack.ErrEmptySigningSecret, err)
+	})
+
+	t.Run("empty listen address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockInteractionsServerArgs(t)
+		args.Config.InteractionsListenAddress = ""
+		is, err := slack.NewInteractionsServer(args)
+		assert.Nil(t, is)
+		assert.Equal(t, slack.ErrEmptyListenAddress, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockInteractionsServerArgs(t)
+		args.HTTPClient = nil
+		is, err := slack.NewInteractionsServer(args)
+		assert.Nil(t, is)
+		assert.Equal(t, slack.ErrNilHTTPClient, err)
+	})
+
+	t.Run("nil alerts manager", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockInteractionsServerArgs(t)
+		args.AlertsManager = nil
+		is, err := slack.NewInteractionsServer(args)
+		assert.Nil(t, is)
+		assert.Equal(t, slack.ErrNilAlertsManager, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		is, err := slack.NewInteractionsServer(createMockInteractionsServerArgs(t))
+		require.Nil(t, err)
+		assert.False(t, is.IsInterfaceNil())
+
+		is.Start()
+		assert.Nil(t, is.Close())
+	})
+}
+
+func TestInteractionsServer_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	alert := data.Alert{
+		ID:           data.NewAlertID("NodeRating", "key1", "ratingDecrease"),
+		ClientID:     "NodeRating",
+		Condition:    "ratingDecrease",
+		Level:        common.CriticalEvent,
+		Message:      "rating decreased",
+		Changed:      true,
+		PubKey:       "key1",
+		NodeName:     "node-1",
+		CurrentValue: 90,
+	}
+	user := map[string]interface{}{"id": "U1", "username": "oncall"}
+
+	t.Run("interactive message has ack and silence buttons", func(t *testing.T) {
+		t.Parallel()
+
+		_, blocks := getBlocks(t, postInteractiveMessage(t, alert))
+		actions := blocks[2].(map[string]interface{})
+		assert.Equal(t, "actions-"+alert.ID, actions["block_id"])
+		buttons := actions["elements"].([]interface{})
+		require.Len(t, buttons, 3)
+		assert.Equal(t, alert.ID, buttons[1].(map[string]interface{})["value"])
+		assert.Equal(t, "key1", buttons[2].(map[string]interface{})["value"])
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		is, _ := slack.NewInteractionsServer(createMockInteractionsServerArgs(t))
+		is.SetGetTimeHandler(func() time.Time {
+			return testNow
+		})
+
+		request := createSignedRequest(testNow, map[string]interface{}{"type": "block_actions"})
+		request.Header.Set("X-Slack-Signature", "v0=0000")
+		recorder := httptest.NewRecorder()
+		is.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("old request", func(t *testing.T) {
+		t.Parallel()
+
+		is, _ := slack.NewInteractionsServer(createMockInteractionsServerArgs(t))
+		is.SetGetTimeHandler(func() time.Time {
+			return testNow
+		})
+
+		request := createSignedRequest(testNow.Add(-10*time.Minute), map[string]interface{}{"type": "block_actions"})
+		recorder := httptest.NewRecorder()
+		is.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("ack updates the alert state and the message", func(t *testing.T) {
+		t.Parallel()
+
+		message := postInteractiveMessage(t, alert)
+
+		var response map[string]interface{}
+		args := createMockInteractionsServerArgs(t)
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				assert.Equal(t, http.MethodPost, request.Method)
+				assert.Equal(t, testResponseURL, request.URL)
+				require.Nil(t, json.Unmarshal(request.Body, &response))
+				return nil, nil
+			},
+		}
+		alertsState, _ := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+		args.AlertsManager = alertsState
+		_ = alertsState.ProcessEvent("NodeRating", data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+
+		is, _ := slack.NewInteractionsServer(args)
+		is.SetGetTimeHandler(func() time.Time {
+			return testNow
+		})
+
+		request := createSignedRequest(testNow, map[string]interface{}{
+			"type":         "block_actions",
+			"user":         user,
+			"response_url": testResponseURL,
+			"message":      message,
+			"actions": []map[string]interface{}{
+				{"action_id": "ack", "block_id": "actions-" + alert.ID, "value": alert.ID},
+			},
+		})
+		recorder := httptest.NewRecorder()
+		is.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		activeAlerts := alertsState.ActiveAlerts()
+		require.Len(t, activeAlerts, 1)
+		assert.True(t, activeAlerts[0].Acknowledged)
+		assert.Equal(t, "@oncall", activeAlerts[0].AcknowledgedBy)
+
+		require.NotNil(t, response)
+		assert.Equal(t, true, response["replace_original"])
+		assert.Equal(t, message["text"], response["text"])
+		_, blocks := getBlocks(t, response)
+		require.Len(t, blocks, 5)
+		buttons := blocks[2].(map[string]interface{})["elements"].([]interface{})
+		require.Len(t, buttons, 1)
+		assert.Equal(t, "explorer", buttons[0].(map[string]interface{})["action_id"])
+		note := blocks[3].(map[string]interface{})
+		assert.Equal(t, "context", note["type"])
+		assert.Equal(t, ":white_check_mark: Acknowledged by @oncall", note["elements"].([]interface{})[0].(map[string]interface{})["text"])
+	})
+
+	t.Run("silence updates the alert state and the message", func(t *testing.T) {
+		t.Parallel()
+
+		message := postInteractiveMessage(t, alert)
+
+		var response map[string]interface{}
+		args := createMockInteractionsServerArgs(t)
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				require.Nil(t, json.Unmarshal(request.Body, &response))
+				return nil, nil
+			},
+		}
+		alertsState, _ := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+		args.AlertsManager = alertsState
+		is, _ := slack.NewInteractionsServer(args)
+		is.SetGetTimeHandler(func() time.Time {
+			return testNow
+		})
+
+		request := createSignedRequest(testNow, map[string]interface{}{
+			"type":         "block_actions",
+			"user":         map[string]interface{}{"id": "U2"},
+			"response_url": testResponseURL,
+			"message":      message,
+			"actions": []map[string]interface{}{
+				{"action_id": "silence_1h", "block_id": "actions-" + alert.ID, "value": "key1"},
+			},
+		})
+		recorder := httptest.NewRecorder()
+		is.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		silences := alertsState.Silences()
+		require.Len(t, silences, 1)
+		assert.Equal(t, "key1", silences[0].PubKey)
+		assert.Equal(t, "U2", silences[0].By)
+
+		_, blocks := getBlocks(t, response)
+		note := blocks[3].(map[string]interface{})
+		assert.Equal(t, ":no_bell: Silenced for 1h by U2", note["elements"].([]interface{})[0].(map[string]interface{})["text"])
+	})
+
+	t.Run("failed action is answered with an ephemeral message", func(t *testing.T) {
+		t.Parallel()
+
+		var response map[string]interface{}
+		args := createMockInteractionsServerArgs(t)
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				require.Nil(t, json.Unmarshal(request.Body, &response))
+				return nil, nil
+			},
+		}
+		is, _ := slack.NewInteractionsServer(args)
+		is.SetGetTimeHandler(func() time.Time {
+			return testNow
+		})
+
+		request := createSignedRequest(testNow, map[string]interface{}{
+			"type":         "block_actions",
+			"user":         user,
+			"response_url": testResponseURL,
+			"actions": []map[string]interface{}{
+				{"action_id": "ack", "block_id": "actions-missing", "value": "missing"},
+			},
+		})
+		recorder := httptest.NewRecorder()
+		is.ServeHTTP(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		assert.Equal(t, "ephemeral", response["response_type"])
+		assert.Equal(t, false, response["replace_original"])
+		assert.Contains(t, response["text"], "missing")
+	})
+}
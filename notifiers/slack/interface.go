@@ -0,0 +1,14 @@
+package slack
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+)
+
+// AlertsManager defines the behaviour of a component able to acknowledge alerts and to silence keys
+type AlertsManager interface {
+	Acknowledge(alertID string, by string) (alerts.ActiveAlert, error)
+	Silence(pubKey string, duration time.Duration, by string) (alerts.Silence, error)
+	IsInterfaceNil() bool
+}
@@ -1,7 +1,16 @@
 package slack
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
 	"github.com/multiversx/mx-chain-node-monitoring/config"
 	"github.com/multiversx/mx-chain-node-monitoring/data"
 	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
@@ -9,8 +18,22 @@ import (
 
 var log = logger.GetOrCreate("slackNotifier")
 
-type payload struct {
-	Text string `json:"text"`
+const (
+	postMessagePath = "/chat.postMessage"
+	resolvedColor   = "#2EB67D"
+)
+
+type postMessageResponse struct {
+	Ok      bool   `json:"ok"`
+	Error   string `json:"error"`
+	Channel string `json:"channel"`
+	Ts      string `json:"ts"`
+}
+
+// thread identifies the message an alert was first notified with, so the follow-ups are posted as its replies
+type thread struct {
+	channel string
+	ts      string
 }
 
 // ArgsSlackNotifier defines the arguments needed to create a new slack notifier
@@ -20,41 +43,285 @@ type ArgsSlackNotifier struct {
 }
 
 type slackNotifier struct {
-	url        string
-	httpClient notifiers.HTTPClient
+	url           string
+	apiUrl        string
+	botToken      string
+	channel       string
+	levelChannels map[common.EventLevel]string
+	explorerUrl   string
+	interactive   bool
+	httpClient    notifiers.HTTPClient
+	threads       map[string]thread
+	mutThreads    sync.Mutex
 }
 
-// NewSlackNotifier will create a new email notifier instance
+// NewSlackNotifier will create a new slack notifier instance. When a bot token is provided, the notifications are
+// posted with the web api instead of the incoming webhook
 func NewSlackNotifier(args ArgsSlackNotifier) (*slackNotifier, error) {
 	err := checkArgs(args)
 	if err != nil {
 		return nil, err
 	}
 
+	levelChannels := make(map[common.EventLevel]string)
+	for levelName, channel := range args.Config.LevelChannels {
+		level, err := common.EventLevelFromString(levelName)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s for channel %s", ErrInvalidLevel, levelName, channel)
+		}
+		levelChannels[level] = channel
+	}
+
 	return &slackNotifier{
-		url:        args.Config.URL,
-		httpClient: args.HTTPClient,
+		url:           args.Config.URL,
+		apiUrl:        strings.TrimSuffix(args.Config.ApiUrl, "/"),
+		botToken:      args.Config.BotToken,
+		channel:       args.Config.Channel,
+		levelChannels: levelChannels,
+		explorerUrl:   args.Config.ExplorerUrl,
+		interactive:   InteractionsEnabled(args.Config),
+		httpClient:    args.HTTPClient,
+		threads:       make(map[string]thread),
 	}, nil
 }
 
 func checkArgs(args ArgsSlackNotifier) error {
-	if args.Config.URL == "" {
-		return ErrInvalidSlackURL
-	}
-	if args.HTTPClient == nil {
+	if check.IfNil(args.HTTPClient) {
 		return ErrNilHTTPClient
 	}
+	if args.Config.BotToken == "" {
+		if args.Config.URL == "" {
+			return ErrInvalidSlackURL
+		}
+		return nil
+	}
+
+	if args.Config.ApiUrl == "" {
+		return ErrInvalidApiUrl
+	}
+	if args.Config.Channel == "" {
+		return ErrEmptyChannel
+	}
 
 	return nil
 }
 
-// PushMessage will push the notification
+// PushMessage will push the notification as a Block Kit message, with one section for every affected node.
+// In bot token mode, the alerts already notified and the resolved ones are posted as replies in the thread
+// of the message which notified them first
 func (sn *slackNotifier) PushMessage(msg data.NotificationMessage) error {
-	msgPayload := payload{
-		Text: msg.Message,
+	if !sn.isBotMode() {
+		msgPayload := sn.createPayload(notifiers.Title(msg.Level), msg.Level, extraText(msg), msg.Alerts, true)
+		return sn.httpClient.CallPostRestEndPoint(sn.url, "", msgPayload)
+	}
+
+	sn.mutThreads.Lock()
+	defer sn.mutThreads.Unlock()
+
+	return sn.pushToThreads(msg)
+}
+
+func (sn *slackNotifier) isBotMode() bool {
+	return sn.botToken != ""
+}
+
+func (sn *slackNotifier) pushToThreads(msg data.NotificationMessage) error {
+	newAlerts := make([]data.Alert, 0, len(msg.Alerts))
+	followUps := make(map[thread][]data.Alert)
+	for _, alert := range msg.Alerts {
+		alertThread, ok := sn.threads[dedupKey(alert)]
+		if !ok {
+			newAlerts = append(newAlerts, alert)
+			continue
+		}
+
+		followUps[alertThread] = append(followUps[alertThread], alert)
+	}
+
+	resolved := make(map[thread][]data.Alert)
+	for _, alert := range msg.Resolved {
+		key := dedupKey(alert)
+		alertThread, ok := sn.threads[key]
+		if !ok {
+			continue
+		}
+
+		resolved[alertThread] = append(resolved[alertThread], alert)
+		delete(sn.threads, key)
+	}
+
+	// the text which is not about a single alert, as the summary of the grouped notifications, is posted as well
+	text := extraText(msg)
+	var lastErr error
+	if len(newAlerts) > 0 || text != "" || (len(msg.Alerts) == 0 && len(msg.Resolved) == 0) {
+		lastErr = sn.postNewMessage(msg.Level, text, newAlerts)
+	}
+	for _, alertThread := range sortedThreads(followUps) {
+		alerts := followUps[alertThread]
+		msgPayload := sn.createPayload("Update - "+notifiers.Title(maxLevel(alerts)), maxLevel(alerts), "", alerts, true)
+		err := sn.postReply(alertThread, msgPayload)
+		if err != nil {
+			lastErr = err
+		}
+	}
+	for _, alertThread := range sortedThreads(resolved) {
+		alerts := resolved[alertThread]
+		msgPayload := sn.createPayload("Resolved - Node monitoring", common.NoEvent, "", alerts, false)
+		msgPayload.Attachments[0].Color = resolvedColor
+		err := sn.postReply(alertThread, msgPayload)
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func (sn *slackNotifier) postNewMessage(level common.EventLevel, text string, alerts []data.Alert) error {
+	if len(alerts) > 0 {
+		level = maxLevel(alerts)
+	}
+
+	msgPayload := sn.createPayload(notifiers.Title(level), level, text, alerts, true)
+	msgPayload.Channel = sn.channelForLevel(level)
+	response, err := sn.postMessage(msgPayload)
+	if err != nil {
+		return err
+	}
+	if response.Ts == "" {
+		return nil
+	}
+
+	messageThread := thread{channel: response.Channel, ts: response.Ts}
+	for _, alert := range alerts {
+		sn.threads[dedupKey(alert)] = messageThread
+	}
+
+	return nil
+}
+
+func (sn *slackNotifier) postReply(alertThread thread, msgPayload payload) error {
+	msgPayload.Channel = alertThread.channel
+	msgPayload.ThreadTs = alertThread.ts
+	_, err := sn.postMessage(msgPayload)
+
+	return err
+}
+
+func (sn *slackNotifier) channelForLevel(level common.EventLevel) string {
+	channel, ok := sn.levelChannels[level]
+	if !ok {
+		return sn.channel
+	}
+
+	return channel
+}
+
+// postMessage calls the chat.postMessage method. An empty response, as returned by the dry-run client, is
+// accepted and the message will not be replied to
+func (sn *slackNotifier) postMessage(msgPayload payload) (postMessageResponse, error) {
+	body, err := json.Marshal(msgPayload)
+	if err != nil {
+		return postMessageResponse{}, err
+	}
+
+	responseBody, err := sn.httpClient.CallRestEndPoint(data.HTTPRequest{
+		Method: http.MethodPost,
+		URL:    sn.apiUrl + postMessagePath,
+		Headers: map[string]string{
+			"Content-Type":  "application/json; charset=utf-8",
+			"Authorization": "Bearer " + sn.botToken,
+		},
+		Body: body,
+	})
+	if err != nil {
+		return postMessageResponse{}, err
+	}
+
+	response := postMessageResponse{}
+	if len(responseBody) == 0 {
+		return response, nil
+	}
+
+	err = json.Unmarshal(responseBody, &response)
+	if err != nil {
+		return postMessageResponse{}, err
+	}
+	if !response.Ok {
+		return postMessageResponse{}, fmt.Errorf("%w: %s", ErrSlackAPI, response.Error)
+	}
+	log.Debug("slack message posted", "channel", response.Channel, "ts", response.Ts, "thread", msgPayload.ThreadTs)
+
+	return response, nil
+}
+
+// extraText returns the lines of the message which do not belong to its alerts. The line of an alert starts with its
+// id, or is the alert message, and the indented lines which follow it, as the members of an incident, belong to it
+func extraText(msg data.NotificationMessage) string {
+	if len(msg.Alerts) == 0 && len(msg.Resolved) == 0 {
+		return strings.TrimSpace(msg.Message)
+	}
+
+	alertLines := make(map[string]struct{})
+	prefixes := make([]string, 0, len(msg.Alerts)+len(msg.Resolved))
+	for _, alert := range append(append([]data.Alert{}, msg.Alerts...), msg.Resolved...) {
+		alertLines[alert.Message] = struct{}{}
+		prefixes = append(prefixes, fmt.Sprintf("[%s] ", alert.ID))
+	}
+
+	kept := make([]string, 0)
+	inAlert := false
+	for _, line := range strings.Split(msg.Message, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if inAlert && strings.HasPrefix(line, " ") {
+			continue
+		}
+
+		_, inAlert = alertLines[line]
+		for _, prefix := range prefixes {
+			inAlert = inAlert || strings.HasPrefix(line, prefix)
+		}
+		if !inAlert {
+			kept = append(kept, line)
+		}
+	}
+
+	return strings.Join(kept, "\n")
+}
+
+func dedupKey(alert data.Alert) string {
+	return data.NewDedupKey(alert.ClientID, alert.PubKey, alert.Condition)
+}
+
+func maxLevel(alerts []data.Alert) common.EventLevel {
+	level := common.NoEvent
+	for _, alert := range alerts {
+		if alert.Level > level {
+			level = alert.Level
+		}
+	}
+
+	return level
+}
+
+// sortedThreads returns the threads in the order the messages were posted, so the replies are deterministic
+func sortedThreads(alertsByThread map[thread][]data.Alert) []thread {
+	threads := make([]thread, 0, len(alertsByThread))
+	for alertThread := range alertsByThread {
+		threads = append(threads, alertThread)
 	}
+	sort.Slice(threads, func(i, j int) bool {
+		return threads[i].ts < threads[j].ts
+	})
+
+	return threads
+}
 
-	return sn.httpClient.CallPostRestEndPoint(sn.url, "", msgPayload)
+// NotifiesResolved returns true in bot token mode, where the resolved alerts are posted in the alert threads
+func (sn *slackNotifier) NotifiesResolved() bool {
+	return sn.isBotMode()
 }
 
 // GetID will return the identifier for slack notifier
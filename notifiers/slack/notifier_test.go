@@ -1,25 +1,84 @@
 package slack_test
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-node-monitoring/common"
 	"github.com/multiversx/mx-chain-node-monitoring/config"
 	"github.com/multiversx/mx-chain-node-monitoring/data"
 	"github.com/multiversx/mx-chain-node-monitoring/mocks"
 	"github.com/multiversx/mx-chain-node-monitoring/notifiers/slack"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockSlackNotifierArgs() slack.ArgsSlackNotifier {
 	return slack.ArgsSlackNotifier{
 		Config: &config.Slack{
-			Enabled: true,
-			URL:     "http://localhost",
+			Enabled:     true,
+			URL:         "http://localhost",
+			ExplorerUrl: "https://explorer.multiversx.com",
 		},
 		HTTPClient: &mocks.HTTPClientStub{},
 	}
 }
 
+func createMockBotArgs() slack.ArgsSlackNotifier {
+	args := createMockSlackNotifierArgs()
+	args.Config.URL = ""
+	args.Config.BotToken = "xoxb-token"
+	args.Config.ApiUrl = "https://slack.com/api/"
+	args.Config.Channel = "C-default"
+	args.Config.LevelChannels = map[string]string{"critical": "C-critical"}
+
+	return args
+}
+
+func toGenericJSON(t *testing.T, value interface{}) map[string]interface{} {
+	buff, err := json.Marshal(value)
+	require.Nil(t, err)
+
+	result := make(map[string]interface{})
+	require.Nil(t, json.Unmarshal(buff, &result))
+
+	return result
+}
+
+// botReceiver simulates the chat.postMessage method and keeps the posted messages
+type botReceiver struct {
+	posted []map[string]interface{}
+}
+
+func (br *botReceiver) handler(t *testing.T) func(request data.HTTPRequest) ([]byte, error) {
+	return func(request data.HTTPRequest) ([]byte, error) {
+		assert.Equal(t, "https://slack.com/api/chat.postMessage", request.URL)
+		assert.Equal(t, "Bearer xoxb-token", request.Headers["Authorization"])
+
+		posted := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(request.Body, &posted))
+		br.posted = append(br.posted, posted)
+
+		ts := fmt.Sprintf("1700000000.%06d", len(br.posted))
+		return []byte(fmt.Sprintf(`{"ok":true,"channel":%q,"ts":%q}`, posted["channel"], ts)), nil
+	}
+}
+
+func getBlocks(t *testing.T, msgPayload map[string]interface{}) (string, []interface{}) {
+	attachments := msgPayload["attachments"].([]interface{})
+	require.Len(t, attachments, 1)
+	attachment := attachments[0].(map[string]interface{})
+
+	return attachment["color"].(string), attachment["blocks"].([]interface{})
+}
+
+func getText(block interface{}) string {
+	return block.(map[string]interface{})["text"].(map[string]interface{})["text"].(string)
+}
+
 func TestNewSlackNotifier(t *testing.T) {
 	t.Parallel()
 
@@ -45,32 +104,293 @@ func TestNewSlackNotifier(t *testing.T) {
 		require.Equal(t, slack.ErrNilHTTPClient, err)
 	})
 
+	t.Run("bot mode without api url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBotArgs()
+		args.Config.ApiUrl = ""
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, sn)
+		require.Equal(t, slack.ErrInvalidApiUrl, err)
+	})
+
+	t.Run("bot mode without channel", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBotArgs()
+		args.Config.Channel = ""
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, sn)
+		require.Equal(t, slack.ErrEmptyChannel, err)
+	})
+
+	t.Run("channel for unknown level", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBotArgs()
+		args.Config.LevelChannels = map[string]string{"urgent": "C-urgent"}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, sn)
+		require.True(t, errors.Is(err, slack.ErrInvalidLevel))
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		sn, err := slack.NewSlackNotifier(createMockSlackNotifierArgs())
 		require.NotNil(t, sn)
 		require.Nil(t, err)
+		assert.False(t, sn.NotifiesResolved())
+
+		sn, err = slack.NewSlackNotifier(createMockBotArgs())
+		require.Nil(t, err)
+		assert.True(t, sn.NotifiesResolved())
 	})
 }
 
 func TestPushMessage(t *testing.T) {
 	t.Parallel()
 
-	args := createMockSlackNotifierArgs()
-
-	wasCalled := false
-	args.HTTPClient = &mocks.HTTPClientStub{
-		CallPostRestEndPointCalled: func(address, path string, data interface{}) error {
-			wasCalled = true
-			return nil
-		},
+	alert := data.Alert{
+		ID:            "id1",
+		ClientID:      "NodeRating",
+		Condition:     "ratingDecrease",
+		Level:         common.CriticalEvent,
+		Message:       "rating decreased",
+		PubKey:        "key1",
+		NodeName:      "node-1",
+		CurrentValue:  90,
+		PreviousValue: 95.5,
 	}
 
-	sn, err := slack.NewSlackNotifier(args)
-	require.Nil(t, err)
+	t.Run("webhook block kit message", func(t *testing.T) {
+		t.Parallel()
 
-	err = sn.PushMessage(data.NotificationMessage{})
-	require.Nil(t, err)
-	require.True(t, wasCalled)
+		var sent map[string]interface{}
+		args := createMockSlackNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallPostRestEndPointCalled: func(address, path string, data interface{}) error {
+				assert.Equal(t, "http://localhost", address)
+				sent = toGenericJSON(t, data)
+				return nil
+			},
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		err = sn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+		require.Nil(t, err)
+
+		assert.Equal(t, "Node monitoring - CRITICAL\nnode-1: rating decreased", sent["text"])
+		assert.Nil(t, sent["channel"])
+
+		color, blocks := getBlocks(t, sent)
+		assert.Equal(t, "#E74C3C", color)
+		require.Len(t, blocks, 4)
+		assert.Equal(t, "header", blocks[0].(map[string]interface{})["type"])
+		assert.Equal(t, "Node monitoring - CRITICAL", getText(blocks[0]))
+
+		section := blocks[1].(map[string]interface{})
+		assert.Equal(t, "*node-1*\nrating decreased", getText(section))
+		fields := section["fields"].([]interface{})
+		require.Len(t, fields, 2)
+		assert.Equal(t, "*Temp rating*\n95.50 → 90.00", fields[0].(map[string]interface{})["text"])
+		assert.Equal(t, "*Alert*\n`id1` CRITICAL", fields[1].(map[string]interface{})["text"])
+
+		button := blocks[2].(map[string]interface{})["elements"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "button", button["type"])
+		assert.Equal(t, "https://explorer.multiversx.com/nodes/key1", button["url"])
+		assert.Equal(t, "context", blocks[3].(map[string]interface{})["type"])
+	})
+
+	t.Run("webhook message without alerts", func(t *testing.T) {
+		t.Parallel()
+
+		var sent map[string]interface{}
+		args := createMockSlackNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallPostRestEndPointCalled: func(address, path string, data interface{}) error {
+				sent = toGenericJSON(t, data)
+				return nil
+			},
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		err = sn.PushMessage(data.NotificationMessage{Message: "test message", Level: common.WarningEvent})
+		require.Nil(t, err)
+
+		color, blocks := getBlocks(t, sent)
+		assert.Equal(t, "#F1C40F", color)
+		require.Len(t, blocks, 3)
+		assert.Equal(t, "test message", getText(blocks[1]))
+	})
+
+	t.Run("too many nodes are summarized", func(t *testing.T) {
+		t.Parallel()
+
+		var sent map[string]interface{}
+		args := createMockSlackNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallPostRestEndPointCalled: func(address, path string, data interface{}) error {
+				sent = toGenericJSON(t, data)
+				return nil
+			},
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		alerts := make([]data.Alert, 30)
+		for idx := range alerts {
+			alerts[idx] = alert
+		}
+		err = sn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: alerts})
+		require.Nil(t, err)
+
+		_, blocks := getBlocks(t, sent)
+		require.Len(t, blocks, 49)
+		assert.Equal(t, "_and 7 more_", getText(blocks[47]))
+	})
+
+	t.Run("bot mode posts follow-ups and resolves in the alert thread", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := &botReceiver{}
+		args := createMockBotArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: receiver.handler(t),
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		warningAlert := alert
+		warningAlert.PubKey = "key2"
+		warningAlert.Condition = "tempRatingThreshold"
+		warningAlert.Level = common.WarningEvent
+
+		err = sn.PushMessage(data.NotificationMessage{Level: common.WarningEvent, Alerts: []data.Alert{warningAlert}})
+		require.Nil(t, err)
+		err = sn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+		require.Nil(t, err)
+
+		// key2 goes critical and key1 resolves
+		criticalAlert := warningAlert
+		criticalAlert.Level = common.CriticalEvent
+		err = sn.PushMessage(data.NotificationMessage{
+			Level:    common.CriticalEvent,
+			Alerts:   []data.Alert{criticalAlert},
+			Resolved: []data.Alert{alert},
+		})
+		require.Nil(t, err)
+
+		// key1 fires again, in a new thread
+		err = sn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+		require.Nil(t, err)
+
+		require.Len(t, receiver.posted, 5)
+		assert.Equal(t, "C-default", receiver.posted[0]["channel"])
+		assert.Nil(t, receiver.posted[0]["thread_ts"])
+		assert.Equal(t, "C-critical", receiver.posted[1]["channel"])
+		assert.Nil(t, receiver.posted[1]["thread_ts"])
+
+		assert.Equal(t, "C-default", receiver.posted[2]["channel"])
+		assert.Equal(t, "1700000000.000001", receiver.posted[2]["thread_ts"])
+		_, blocks := getBlocks(t, receiver.posted[2])
+		assert.Equal(t, "Update - Node monitoring - CRITICAL", getText(blocks[0]))
+
+		assert.Equal(t, "C-critical", receiver.posted[3]["channel"])
+		assert.Equal(t, "1700000000.000002", receiver.posted[3]["thread_ts"])
+		color, blocks := getBlocks(t, receiver.posted[3])
+		assert.Equal(t, "#2EB67D", color)
+		assert.True(t, strings.HasPrefix(getText(blocks[0]), "Resolved"))
+
+		assert.Equal(t, "C-critical", receiver.posted[4]["channel"])
+		assert.Nil(t, receiver.posted[4]["thread_ts"])
+	})
+
+	t.Run("webhook message keeps the text beyond the alert lines", func(t *testing.T) {
+		t.Parallel()
+
+		var sent map[string]interface{}
+		args := createMockSlackNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallPostRestEndPointCalled: func(address, path string, data interface{}) error {
+				sent = toGenericJSON(t, data)
+				return nil
+			},
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		err = sn.PushMessage(data.NotificationMessage{
+			Level:   common.CriticalEvent,
+			Message: "2 notifications grouped: 1 critical, 1 resolved\n[id1] rating decreased\n  - member line\n",
+			Alerts:  []data.Alert{alert},
+		})
+		require.Nil(t, err)
+
+		_, blocks := getBlocks(t, sent)
+		require.Len(t, blocks, 5)
+		assert.Equal(t, "2 notifications grouped: 1 critical, 1 resolved", getText(blocks[1]))
+		assert.Equal(t, "*node-1*\nrating decreased", getText(blocks[2]))
+	})
+
+	t.Run("bot mode posts the text of follow-ups on its own", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := &botReceiver{}
+		args := createMockBotArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: receiver.handler(t),
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		err = sn.PushMessage(data.NotificationMessage{Level: common.CriticalEvent, Message: "[id1] rating decreased\n", Alerts: []data.Alert{alert}})
+		require.Nil(t, err)
+		require.Len(t, receiver.posted, 1)
+		_, blocks := getBlocks(t, receiver.posted[0])
+		assert.Len(t, blocks, 4)
+
+		// only follow-ups, together with the summary of the dropped notifications
+		err = sn.PushMessage(data.NotificationMessage{
+			Level:   common.CriticalEvent,
+			Message: "3 notifications dropped by the rate limit\n[id1] rating decreased\n",
+			Alerts:  []data.Alert{alert},
+		})
+		require.Nil(t, err)
+		require.Len(t, receiver.posted, 3)
+		assert.Nil(t, receiver.posted[1]["thread_ts"])
+		_, blocks = getBlocks(t, receiver.posted[1])
+		require.Len(t, blocks, 3)
+		assert.Equal(t, "3 notifications dropped by the rate limit", getText(blocks[1]))
+		assert.Equal(t, "1700000000.000001", receiver.posted[2]["thread_ts"])
+	})
+
+	t.Run("bot mode api error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBotArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return []byte(`{"ok":false,"error":"channel_not_found"}`), nil
+			},
+		}
+
+		sn, err := slack.NewSlackNotifier(args)
+		require.Nil(t, err)
+
+		err = sn.PushMessage(data.NotificationMessage{Message: "message", Level: common.InfoEvent})
+		require.True(t, errors.Is(err, slack.ErrSlackAPI))
+		assert.Contains(t, err.Error(), "channel_not_found")
+	})
 }
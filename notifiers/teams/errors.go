@@ -0,0 +1,9 @@
+package teams
+
+import "errors"
+
+// ErrInvalidTeamsURL signals that an empty url has been provided
+var ErrInvalidTeamsURL = errors.New("empty teams url has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
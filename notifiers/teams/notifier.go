@@ -0,0 +1,210 @@
+package teams
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	adaptiveCardVersion     = "1.4"
+)
+
+// adaptive card text colors, see https://adaptivecards.io/explorer/TextBlock.html
+var levelColors = map[common.EventLevel]string{
+	common.NoEvent:       "Default",
+	common.InfoEvent:     "Accent",
+	common.WarningEvent:  "Warning",
+	common.CriticalEvent: "Attention",
+}
+
+type payload struct {
+	Type        string       `json:"type"`
+	Attachments []attachment `json:"attachments"`
+}
+
+type attachment struct {
+	ContentType string       `json:"contentType"`
+	Content     adaptiveCard `json:"content"`
+}
+
+type adaptiveCard struct {
+	Schema  string                 `json:"$schema"`
+	Type    string                 `json:"type"`
+	Version string                 `json:"version"`
+	Body    []cardElement          `json:"body"`
+	MSTeams map[string]interface{} `json:"msteams"`
+}
+
+// cardElement holds the fields of the adaptive card elements used: TextBlock, Container and ActionSet
+type cardElement struct {
+	Type      string        `json:"type"`
+	Text      string        `json:"text,omitempty"`
+	Size      string        `json:"size,omitempty"`
+	Weight    string        `json:"weight,omitempty"`
+	Color     string        `json:"color,omitempty"`
+	IsSubtle  bool          `json:"isSubtle,omitempty"`
+	Wrap      bool          `json:"wrap,omitempty"`
+	Separator bool          `json:"separator,omitempty"`
+	Items     []cardElement `json:"items,omitempty"`
+	Actions   []cardAction  `json:"actions,omitempty"`
+}
+
+type cardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// ArgsTeamsNotifier defines the arguments needed to create a new microsoft teams notifier
+type ArgsTeamsNotifier struct {
+	Config     *config.Teams
+	HTTPClient notifiers.HTTPClient
+}
+
+type teamsNotifier struct {
+	url         string
+	explorerUrl string
+	httpClient  notifiers.HTTPClient
+}
+
+// NewTeamsNotifier will create a new microsoft teams notifier instance
+func NewTeamsNotifier(args ArgsTeamsNotifier) (*teamsNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &teamsNotifier{
+		url:         args.Config.URL,
+		explorerUrl: args.Config.ExplorerUrl,
+		httpClient:  args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsTeamsNotifier) error {
+	if args.Config.URL == "" {
+		return ErrInvalidTeamsURL
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+// PushMessage will push the notification as an adaptive card, with one section for every affected node
+func (tn *teamsNotifier) PushMessage(msg data.NotificationMessage) error {
+	msgPayload := payload{
+		Type: "message",
+		Attachments: []attachment{
+			{
+				ContentType: adaptiveCardContentType,
+				Content:     tn.createCard(msg),
+			},
+		},
+	}
+
+	return tn.httpClient.CallPostRestEndPoint(tn.url, "", msgPayload)
+}
+
+func (tn *teamsNotifier) createCard(msg data.NotificationMessage) adaptiveCard {
+	body := []cardElement{
+		{
+			Type:   "TextBlock",
+			Text:   notifiers.Title(msg.Level),
+			Size:   "Large",
+			Weight: "Bolder",
+			Color:  levelColors[msg.Level],
+			Wrap:   true,
+		},
+	}
+
+	if len(msg.Alerts) == 0 {
+		body = append(body, cardElement{
+			Type: "TextBlock",
+			Text: msg.Message,
+			Wrap: true,
+		})
+	}
+	for _, alert := range msg.Alerts {
+		body = append(body, tn.createNodeContainer(alert))
+	}
+
+	body = append(body, cardElement{
+		Type:      "TextBlock",
+		Text:      fmt.Sprintf("%s - %s", notifiers.Footer, time.Now().UTC().Format(time.RFC1123)),
+		Size:      "Small",
+		IsSubtle:  true,
+		Wrap:      true,
+		Separator: true,
+	})
+
+	return adaptiveCard{
+		Schema:  adaptiveCardSchema,
+		Type:    "AdaptiveCard",
+		Version: adaptiveCardVersion,
+		Body:    body,
+		MSTeams: map[string]interface{}{"width": "Full"},
+	}
+}
+
+func (tn *teamsNotifier) createNodeContainer(alert data.Alert) cardElement {
+	container := cardElement{
+		Type:      "Container",
+		Separator: true,
+		Items: []cardElement{
+			{
+				Type:   "TextBlock",
+				Text:   alert.DisplayName(),
+				Weight: "Bolder",
+				Color:  levelColors[alert.Level],
+				Wrap:   true,
+			},
+			{
+				Type: "TextBlock",
+				Text: alert.Message,
+				Wrap: true,
+			},
+			{
+				Type:     "TextBlock",
+				Text:     fmt.Sprintf("Alert %s", alert.ID),
+				Size:     "Small",
+				IsSubtle: true,
+			},
+		},
+	}
+
+	explorerURL := notifiers.NodeExplorerURL(tn.explorerUrl, alert.PubKey)
+	if explorerURL != "" {
+		container.Items = append(container.Items, cardElement{
+			Type: "ActionSet",
+			Actions: []cardAction{
+				{
+					Type:  "Action.OpenUrl",
+					Title: "View in explorer",
+					URL:   explorerURL,
+				},
+			},
+		})
+	}
+
+	return container
+}
+
+// GetID will return the identifier for microsoft teams notifier
+func (tn *teamsNotifier) GetID() string {
+	return "Teams"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tn *teamsNotifier) IsInterfaceNil() bool {
+	return tn == nil
+}
@@ -0,0 +1,152 @@
+package teams_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTeamsNotifierArgs() teams.ArgsTeamsNotifier {
+	return teams.ArgsTeamsNotifier{
+		Config: &config.Teams{
+			Enabled:     true,
+			URL:         "http://localhost",
+			ExplorerUrl: "https://explorer.multiversx.com",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+// pushToReceiver will push the message to a local receiver and will return the decoded request body
+func pushToReceiver(t *testing.T, msg data.NotificationMessage) map[string]interface{} {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+		require.Nil(t, json.Unmarshal(body, &received))
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 5})
+	require.Nil(t, err)
+
+	args := createMockTeamsNotifierArgs()
+	args.Config.URL = server.URL
+	args.HTTPClient = httpClient
+	tn, err := teams.NewTeamsNotifier(args)
+	require.Nil(t, err)
+
+	err = tn.PushMessage(msg)
+	require.Nil(t, err)
+
+	return received
+}
+
+func getCardBody(t *testing.T, received map[string]interface{}) []interface{} {
+	assert.Equal(t, "message", received["type"])
+
+	attachments := received["attachments"].([]interface{})
+	require.Len(t, attachments, 1)
+	attachment := attachments[0].(map[string]interface{})
+	assert.Equal(t, "application/vnd.microsoft.card.adaptive", attachment["contentType"])
+
+	card := attachment["content"].(map[string]interface{})
+	assert.Equal(t, "AdaptiveCard", card["type"])
+
+	return card["body"].([]interface{})
+}
+
+func TestNewTeamsNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url string", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTeamsNotifierArgs()
+		args.Config.URL = ""
+
+		tn, err := teams.NewTeamsNotifier(args)
+		require.Nil(t, tn)
+		require.Equal(t, teams.ErrInvalidTeamsURL, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTeamsNotifierArgs()
+		args.HTTPClient = nil
+
+		tn, err := teams.NewTeamsNotifier(args)
+		require.Nil(t, tn)
+		require.Equal(t, teams.ErrNilHTTPClient, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tn, err := teams.NewTeamsNotifier(createMockTeamsNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, tn.IsInterfaceNil())
+		assert.Equal(t, "Teams", tn.GetID())
+	})
+}
+
+func TestTeamsNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("message without alerts", func(t *testing.T) {
+		t.Parallel()
+
+		received := pushToReceiver(t, data.NotificationMessage{Message: "test message", Level: common.WarningEvent})
+		body := getCardBody(t, received)
+		require.Len(t, body, 3)
+
+		header := body[0].(map[string]interface{})
+		assert.Equal(t, "Node monitoring - WARNING", header["text"])
+		assert.Equal(t, "Warning", header["color"])
+		assert.Equal(t, "test message", body[1].(map[string]interface{})["text"])
+	})
+
+	t.Run("one container per node", func(t *testing.T) {
+		t.Parallel()
+
+		alerts := []data.Alert{
+			{ID: "id1", PubKey: "key1", NodeName: "node-1", Level: common.CriticalEvent, Message: "node-1 message"},
+			{ID: "id2", PubKey: "key2", Level: common.WarningEvent, Message: "key2 message"},
+		}
+		received := pushToReceiver(t, data.NotificationMessage{Level: common.CriticalEvent, Alerts: alerts})
+		body := getCardBody(t, received)
+		require.Len(t, body, 4)
+		assert.Equal(t, "Attention", body[0].(map[string]interface{})["color"])
+
+		container := body[2].(map[string]interface{})
+		assert.Equal(t, "Container", container["type"])
+		items := container["items"].([]interface{})
+		require.Len(t, items, 4)
+		assert.Equal(t, "key2", items[0].(map[string]interface{})["text"])
+		assert.Equal(t, "Warning", items[0].(map[string]interface{})["color"])
+		assert.Equal(t, "key2 message", items[1].(map[string]interface{})["text"])
+		assert.Equal(t, "Alert id2", items[2].(map[string]interface{})["text"])
+
+		actions := items[3].(map[string]interface{})["actions"].([]interface{})
+		require.Len(t, actions, 1)
+		action := actions[0].(map[string]interface{})
+		assert.Equal(t, "Action.OpenUrl", action["type"])
+		assert.Equal(t, "https://explorer.multiversx.com/nodes/key2", action["url"])
+	})
+}
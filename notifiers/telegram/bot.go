@@ -0,0 +1,274 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	getUpdatesPath = "/bot%s/getUpdates"
+	// pollTimeoutSec should stay below the request timeout of the http client
+	pollTimeoutSec = 5
+	retryDelay     = 5 * time.Second
+)
+
+type getUpdatesResponse struct {
+	Ok          bool     `json:"ok"`
+	Description string   `json:"description"`
+	Result      []update `json:"result"`
+}
+
+type update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *message `json:"message"`
+}
+
+type message struct {
+	MessageID int64  `json:"message_id"`
+	Text      string `json:"text"`
+	Chat      chat   `json:"chat"`
+	From      *user  `json:"from"`
+}
+
+type chat struct {
+	ID int64 `json:"id"`
+}
+
+type user struct {
+	Username  string `json:"username"`
+	FirstName string `json:"first_name"`
+}
+
+// ArgsTelegramBot defines the arguments needed to create a new telegram bot
+type ArgsTelegramBot struct {
+	Config         *config.Telegram
+	HTTPClient     notifiers.HTTPClient
+	StatusProvider NodesStatusProvider
+	AlertsManager  AlertsManager
+}
+
+type telegramBot struct {
+	apiUrl         string
+	updatesPath    string
+	messagesPath   string
+	allowedChatIDs map[int64]struct{}
+	httpClient     notifiers.HTTPClient
+	statusProvider NodesStatusProvider
+	alertsManager  AlertsManager
+	offset         int64
+	cancelFunc     func()
+	done           chan struct{}
+}
+
+// NewTelegramBot will create a new telegram bot which answers the commands received from the allowed chats
+func NewTelegramBot(args ArgsTelegramBot) (*telegramBot, error) {
+	err := checkBotArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedChatIDs := make(map[int64]struct{})
+	for _, chatID := range args.Config.AllowedChatIDs {
+		id, errParse := strconv.ParseInt(chatID, 10, 64)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w: invalid allowed chat id %s", errParse, chatID)
+		}
+
+		allowedChatIDs[id] = struct{}{}
+	}
+
+	return &telegramBot{
+		apiUrl:         strings.TrimSuffix(args.Config.ApiUrl, "/"),
+		updatesPath:    fmt.Sprintf(getUpdatesPath, args.Config.BotToken),
+		messagesPath:   fmt.Sprintf(sendMessagePath, args.Config.BotToken),
+		allowedChatIDs: allowedChatIDs,
+		httpClient:     args.HTTPClient,
+		statusProvider: args.StatusProvider,
+		alertsManager:  args.AlertsManager,
+	}, nil
+}
+
+func checkBotArgs(args ArgsTelegramBot) error {
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+	if check.IfNil(args.StatusProvider) {
+		return ErrNilNodesStatusProvider
+	}
+	if check.IfNil(args.AlertsManager) {
+		return ErrNilAlertsManager
+	}
+	if args.Config.ApiUrl == "" {
+		return ErrEmptyApiUrl
+	}
+	if args.Config.BotToken == "" {
+		return ErrEmptyBotToken
+	}
+	if len(args.Config.AllowedChatIDs) == 0 {
+		return ErrEmptyAllowedChatIDs
+	}
+
+	return nil
+}
+
+// Start will start long polling the telegram api for new commands
+func (tb *telegramBot) Start() {
+	var ctx context.Context
+	ctx, tb.cancelFunc = context.WithCancel(context.Background())
+	tb.done = make(chan struct{})
+
+	go tb.run(ctx)
+}
+
+func (tb *telegramBot) run(ctx context.Context) {
+	defer close(tb.done)
+	log.Info("telegram bot started, waiting for commands")
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("telegram bot is stopping...")
+			return
+		default:
+		}
+
+		err := tb.pollUpdates()
+		if err == nil {
+			continue
+		}
+
+		log.Warn("failed to get telegram updates", "error", err.Error(), "retry in", retryDelay)
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+func (tb *telegramBot) pollUpdates() error {
+	updates, err := tb.getUpdates(pollTimeoutSec, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, receivedUpdate := range updates {
+		tb.offset = receivedUpdate.UpdateID + 1
+		if receivedUpdate.Message == nil {
+			continue
+		}
+
+		tb.handleMessage(receivedUpdate.Message)
+	}
+
+	return nil
+}
+
+// getUpdates returns the updates after the offset, which also confirms the previous ones to the telegram api
+func (tb *telegramBot) getUpdates(timeoutSec int, limit int) ([]update, error) {
+	query := url.Values{}
+	query.Set("offset", strconv.FormatInt(tb.offset, 10))
+	query.Set("timeout", strconv.Itoa(timeoutSec))
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	query.Set("allowed_updates", `["message"]`)
+
+	buff, err := tb.httpClient.CallGetRestEndPoint(tb.apiUrl, tb.updatesPath+"?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	response := getUpdatesResponse{}
+	err = json.Unmarshal(buff, &response)
+	if err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		return nil, fmt.Errorf("%w: %s", ErrTelegramAPI, response.Description)
+	}
+
+	return response.Result, nil
+}
+
+// confirmUpdates confirms the handled updates, so that the commands are not handled again by the next bot, as the
+// one started on config reload or after a restart
+func (tb *telegramBot) confirmUpdates() {
+	if tb.offset == 0 {
+		return
+	}
+
+	_, err := tb.getUpdates(0, 1)
+	if err != nil {
+		log.Warn("failed to confirm the handled telegram updates", "offset", tb.offset, "error", err.Error())
+	}
+}
+
+func (tb *telegramBot) handleMessage(msg *message) {
+	if !strings.HasPrefix(msg.Text, "/") {
+		return
+	}
+
+	_, allowed := tb.allowedChatIDs[msg.Chat.ID]
+	if !allowed {
+		log.Warn("telegram command received from a chat which is not allowed", "chat id", msg.Chat.ID)
+		return
+	}
+
+	by := senderName(msg)
+	log.Info("telegram command received", "command", msg.Text, "chat id", msg.Chat.ID, "from", by)
+
+	replyLines := tb.handleCommand(msg.Text, by)
+	chunks := splitMessage("", replyLines, maxMessageLength, noEscape)
+	for _, chunk := range chunks {
+		reply := sendMessagePayload{
+			ChatID:                strconv.FormatInt(msg.Chat.ID, 10),
+			Text:                  chunk,
+			DisableWebPagePreview: true,
+			ReplyToMessageID:      msg.MessageID,
+		}
+
+		err := tb.httpClient.CallPostRestEndPoint(tb.apiUrl, tb.messagesPath, reply)
+		if err != nil {
+			log.Warn("failed to answer telegram command", "chat id", msg.Chat.ID, "error", err.Error())
+			return
+		}
+	}
+}
+
+func senderName(msg *message) string {
+	if msg.From == nil {
+		return strconv.FormatInt(msg.Chat.ID, 10)
+	}
+	if msg.From.Username != "" {
+		return "@" + msg.From.Username
+	}
+
+	return msg.From.FirstName
+}
+
+// Close will stop polling for new commands and will confirm the handled ones. It returns once the polling stopped
+func (tb *telegramBot) Close() error {
+	if tb.cancelFunc == nil {
+		return nil
+	}
+
+	tb.cancelFunc()
+	<-tb.done
+	tb.confirmUpdates()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tb *telegramBot) IsInterfaceNil() bool {
+	return tb == nil
+}
@@ -0,0 +1,283 @@
+package telegram_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/telegram"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testKey1 = strings.Repeat("a1", 96)
+	testKey2 = strings.Repeat("b2", 96)
+)
+
+func createStatusProvider() *mocks.NodesStatusProviderStub {
+	return &mocks.NodesStatusProviderStub{
+		NodesStatusCalled: func() []status.NodeStatus {
+			return []status.NodeStatus{
+				{PubKey: testKey1, Alias: "validator-01", Name: "node-1", Online: true, Shard: 1, TempRating: 99.5, Rating: 100},
+				{PubKey: testKey2, Name: "node-2", Shard: 0, TempRating: 50, Rating: 90},
+			}
+		},
+		MonitoredNodesCalled: func() []config.NodeLabels {
+			return []config.NodeLabels{
+				{PubKey: testKey1, Alias: "validator-01"},
+				{PubKey: testKey2},
+			}
+		},
+	}
+}
+
+func createAlertsManager(t *testing.T) telegram.AlertsManager {
+	alertsState, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+	require.Nil(t, err)
+
+	return alertsState
+}
+
+func createMockTelegramBotArgs(t *testing.T) telegram.ArgsTelegramBot {
+	return telegram.ArgsTelegramBot{
+		Config: &config.Telegram{
+			Enabled:         true,
+			ApiUrl:          "https://api.telegram.org",
+			BotToken:        "123:token",
+			ChatIDs:         []string{"-100"},
+			CommandsEnabled: true,
+			AllowedChatIDs:  []string{"-100"},
+		},
+		HTTPClient:     &mocks.HTTPClientStub{},
+		StatusProvider: createStatusProvider(),
+		AlertsManager:  createAlertsManager(t),
+	}
+}
+
+func TestNewTelegramBot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramBotArgs(t)
+		args.HTTPClient = nil
+
+		tb, err := telegram.NewTelegramBot(args)
+		require.Nil(t, tb)
+		require.Equal(t, telegram.ErrNilHTTPClient, err)
+	})
+
+	t.Run("nil status provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramBotArgs(t)
+		args.StatusProvider = nil
+
+		tb, err := telegram.NewTelegramBot(args)
+		require.Nil(t, tb)
+		require.Equal(t, telegram.ErrNilNodesStatusProvider, err)
+	})
+
+	t.Run("nil alerts manager", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramBotArgs(t)
+		args.AlertsManager = nil
+
+		tb, err := telegram.NewTelegramBot(args)
+		require.Nil(t, tb)
+		require.Equal(t, telegram.ErrNilAlertsManager, err)
+	})
+
+	t.Run("no allowed chat ids", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramBotArgs(t)
+		args.Config.AllowedChatIDs = nil
+
+		tb, err := telegram.NewTelegramBot(args)
+		require.Nil(t, tb)
+		require.Equal(t, telegram.ErrEmptyAllowedChatIDs, err)
+	})
+
+	t.Run("invalid allowed chat id", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramBotArgs(t)
+		args.Config.AllowedChatIDs = []string{"@channel"}
+
+		tb, err := telegram.NewTelegramBot(args)
+		require.Nil(t, tb)
+		require.NotNil(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tb, err := telegram.NewTelegramBot(createMockTelegramBotArgs(t))
+		require.Nil(t, err)
+		require.False(t, tb.IsInterfaceNil())
+	})
+}
+
+func TestTelegramBot_PollsCommands(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	offsets := make([]string, 0)
+	timeouts := make([]string, 0)
+	replies := make([]map[string]interface{}, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getUpdates") {
+			offset := r.URL.Query().Get("offset")
+			mut.Lock()
+			offsets = append(offsets, offset)
+			timeouts = append(timeouts, r.URL.Query().Get("timeout"))
+			mut.Unlock()
+
+			if offset != "0" {
+				time.Sleep(time.Millisecond * 50)
+				_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+				return
+			}
+
+			_, _ = fmt.Fprintf(w, `{"ok":true,"result":[
+				{"update_id":10,"message":{"message_id":1,"text":"/keys","chat":{"id":-100},"from":{"username":"oncall"}}},
+				{"update_id":11,"message":{"message_id":2,"text":"/keys","chat":{"id":-200}}},
+				{"update_id":12,"message":{"message_id":3,"text":"hello","chat":{"id":-100}}}
+			]}`)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		payload := make(map[string]interface{})
+		_ = json.Unmarshal(body, &payload)
+		assert.Equal(t, "/bot123:token/sendMessage", r.URL.Path)
+
+		mut.Lock()
+		replies = append(replies, payload)
+		mut.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 5})
+	require.Nil(t, err)
+
+	args := createMockTelegramBotArgs(t)
+	args.Config.ApiUrl = server.URL
+	args.HTTPClient = httpClient
+	tb, err := telegram.NewTelegramBot(args)
+	require.Nil(t, err)
+
+	tb.Start()
+	require.Eventually(t, func() bool {
+		mut.Lock()
+		defer mut.Unlock()
+
+		return len(offsets) >= 2
+	}, time.Second*5, time.Millisecond*10)
+
+	// the handled updates are confirmed once the polling stopped
+	_ = tb.Close()
+	mut.Lock()
+	defer mut.Unlock()
+
+	assert.Equal(t, "13", offsets[1])
+	assert.Equal(t, "13", offsets[len(offsets)-1])
+	assert.Equal(t, "0", timeouts[len(timeouts)-1])
+	numRequests := len(offsets)
+	mut.Unlock()
+
+	// no more polling after close
+	time.Sleep(time.Millisecond * 200)
+	mut.Lock()
+	assert.Equal(t, numRequests, len(offsets))
+	require.Len(t, replies, 1)
+	assert.Equal(t, "-100", replies[0]["chat_id"])
+	assert.Equal(t, float64(1), replies[0]["reply_to_message_id"])
+	assert.Contains(t, replies[0]["text"], "validator-01: "+testKey1)
+}
+
+func TestTelegramBot_Commands(t *testing.T) {
+	t.Parallel()
+
+	t.Run("help", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := telegram.NewTelegramBot(createMockTelegramBotArgs(t))
+		lines := tb.HandleCommand("/help", "user")
+		assert.Equal(t, "Available commands:", lines[0])
+		assert.Equal(t, lines, tb.HandleCommand("/start", "user"))
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := telegram.NewTelegramBot(createMockTelegramBotArgs(t))
+		lines := tb.HandleCommand("/missing@my_bot", "user")
+		assert.Equal(t, []string{"Unknown command /missing, see /help"}, lines)
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		t.Parallel()
+
+		tb, _ := telegram.NewTelegramBot(createMockTelegramBotArgs(t))
+		lines := tb.HandleCommand("/keys@my_bot", "user")
+		assert.Equal(t, []string{"Monitored keys (2):", "validator-01: " + testKey1, testKey2}, lines)
+	})
+
+	t.Run("silence, ack and status", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramBotArgs(t)
+		alertsState, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: &mocks.StorerStub{}})
+		require.Nil(t, err)
+		args.AlertsManager = alertsState
+		tb, _ := telegram.NewTelegramBot(args)
+
+		alert := data.Alert{
+			ID:       data.NewAlertID("client", testKey2, "condition"),
+			ClientID: "client",
+			PubKey:   testKey2,
+			NodeName: "node-2",
+			Level:    common.CriticalEvent,
+			Message:  "node-2 rating decreased",
+			Changed:  true,
+		}
+		_ = alertsState.ProcessEvent("client", data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+
+		assert.Equal(t, []string{"Usage: /silence <key> <duration>, like /silence validator-01 2h"}, tb.HandleCommand("/silence validator-01", "user"))
+		assert.Contains(t, tb.HandleCommand("/silence missing 2h", "user")[0], "unknown key missing")
+		assert.Contains(t, tb.HandleCommand("/silence validator-01 2x", "user")[0], "Invalid duration 2x")
+		assert.Contains(t, tb.HandleCommand("/silence VALIDATOR-01 2h", "user")[0], "Alerts of validator-01 silenced until")
+		assert.Contains(t, tb.HandleCommand("/silence node-2 1d", "user")[0], "Alerts of node-2 silenced until")
+		assert.Contains(t, tb.HandleCommand("/silence "+testKey1[:8]+" 30m", "user")[0], "Alerts of validator-01 silenced until")
+
+		assert.Contains(t, tb.HandleCommand("/ack missing", "user")[0], "unknown alert missing")
+		assert.Contains(t, tb.HandleCommand("/ack "+alert.ID, "@oncall")[0], "Alert "+alert.ID+" acknowledged")
+
+		lines := tb.HandleCommand("/status", "user")
+		text := strings.Join(lines, "\n")
+		assert.Contains(t, text, "Nodes (2):\nnode-2: offline, shard 0, temp rating 50.00, rating 90\nvalidator-01: online, shard 1, temp rating 99.50, rating 100")
+		assert.Contains(t, text, "Active alerts (1):\n["+alert.ID+"] critical since")
+		assert.Contains(t, text, "(acknowledged by @oncall)")
+		assert.Contains(t, text, "Silences (2):\nvalidator-01 until")
+		assert.Contains(t, text, "node-2 until")
+	})
+}
@@ -0,0 +1,217 @@
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+)
+
+const (
+	timeFormat      = "2006-01-02 15:04 MST"
+	shortKeyLength  = 12
+	minKeyPrefixLen = 6
+)
+
+var helpLines = []string{
+	"Available commands:",
+	"/status - the state of the monitored nodes, the active alerts and the silences",
+	"/keys - the monitored keys",
+	"/silence <key> <duration> - mute the alerts of a key (alias, node name or key prefix), like /silence validator-01 2h",
+	"/ack <alert id> - stop notifying an alert until it is resolved",
+}
+
+func (tb *telegramBot) handleCommand(text string, by string) []string {
+	fields := strings.Fields(text)
+	command := strings.ToLower(fields[0])
+	// commands sent in groups might be addressed to the bot, like /status@my_bot
+	command = strings.SplitN(command, "@", 2)[0]
+	params := fields[1:]
+
+	switch command {
+	case "/start", "/help":
+		return helpLines
+	case "/status":
+		return tb.statusReply()
+	case "/keys":
+		return tb.keysReply()
+	case "/silence":
+		return tb.silenceReply(params, by)
+	case "/ack":
+		return tb.ackReply(params, by)
+	default:
+		return []string{fmt.Sprintf("Unknown command %s, see /help", command)}
+	}
+}
+
+func (tb *telegramBot) statusReply() []string {
+	statuses := tb.statusProvider.NodesStatus()
+	_ = status.Sort(statuses, "node")
+
+	lines := []string{fmt.Sprintf("Nodes (%d):", len(statuses))}
+	for _, nodeStatus := range statuses {
+		online := "offline"
+		if nodeStatus.Online {
+			online = "online"
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %s, shard %d, temp rating %.2f, rating %d",
+			nodeStatus.DisplayName(), online, nodeStatus.Shard, nodeStatus.TempRating, nodeStatus.Rating))
+	}
+
+	activeAlerts := tb.alertsManager.ActiveAlerts()
+	lines = append(lines, "", fmt.Sprintf("Active alerts (%d):", len(activeAlerts)))
+	for _, activeAlert := range activeAlerts {
+		line := fmt.Sprintf("[%s] %s since %s - %s",
+			activeAlert.ID, activeAlert.Level.String(), activeAlert.FiredAt.Format(timeFormat), activeAlert.Message)
+		if activeAlert.Acknowledged {
+			line += fmt.Sprintf(" (acknowledged by %s)", activeAlert.AcknowledgedBy)
+		}
+
+		lines = append(lines, line)
+	}
+
+	silences := tb.alertsManager.Silences()
+	if len(silences) > 0 {
+		names := tb.displayNames()
+		lines = append(lines, "", fmt.Sprintf("Silences (%d):", len(silences)))
+		for _, silence := range silences {
+			lines = append(lines, fmt.Sprintf("%s until %s (by %s)",
+				displayName(names, silence.PubKey), silence.Until.Format(timeFormat), silence.By))
+		}
+	}
+
+	return lines
+}
+
+func (tb *telegramBot) keysReply() []string {
+	monitoredNodes := tb.statusProvider.MonitoredNodes()
+
+	lines := []string{fmt.Sprintf("Monitored keys (%d):", len(monitoredNodes))}
+	for _, nodeLabels := range monitoredNodes {
+		line := nodeLabels.PubKey
+		if nodeLabels.Alias != "" {
+			line = fmt.Sprintf("%s: %s", nodeLabels.Alias, nodeLabels.PubKey)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func (tb *telegramBot) silenceReply(params []string, by string) []string {
+	if len(params) != 2 {
+		return []string{"Usage: /silence <key> <duration>, like /silence validator-01 2h"}
+	}
+
+	pubKey, err := tb.resolveKey(params[0])
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	duration, err := parseDuration(params[1])
+	if err != nil {
+		return []string{fmt.Sprintf("Invalid duration %s, use values like 30m, 2h or 1d", params[1])}
+	}
+
+	silence, err := tb.alertsManager.Silence(pubKey, duration, by)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	return []string{fmt.Sprintf("Alerts of %s silenced until %s",
+		displayName(tb.displayNames(), pubKey), silence.Until.Format(timeFormat))}
+}
+
+func (tb *telegramBot) ackReply(params []string, by string) []string {
+	if len(params) != 1 {
+		return []string{"Usage: /ack <alert id>"}
+	}
+
+	activeAlert, err := tb.alertsManager.Acknowledge(params[0], by)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	return []string{fmt.Sprintf("Alert %s acknowledged, it will not be notified again until it is resolved: %s",
+		activeAlert.ID, activeAlert.Message)}
+}
+
+// resolveKey returns the monitored public key matching the provided alias, node name or key prefix
+func (tb *telegramBot) resolveKey(key string) (string, error) {
+	monitoredNodes := tb.statusProvider.MonitoredNodes()
+	for _, nodeLabels := range monitoredNodes {
+		if nodeLabels.PubKey == key || (nodeLabels.Alias != "" && strings.EqualFold(nodeLabels.Alias, key)) {
+			return nodeLabels.PubKey, nil
+		}
+	}
+
+	for _, nodeStatus := range tb.statusProvider.NodesStatus() {
+		if strings.EqualFold(nodeStatus.Name, key) {
+			return nodeStatus.PubKey, nil
+		}
+	}
+
+	if len(key) < minKeyPrefixLen {
+		return "", fmt.Errorf("%w %s, see /keys", ErrUnknownKey, key)
+	}
+
+	matches := make([]string, 0, 1)
+	for _, nodeLabels := range monitoredNodes {
+		if strings.HasPrefix(nodeLabels.PubKey, key) {
+			matches = append(matches, nodeLabels.PubKey)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w %s, see /keys", ErrUnknownKey, key)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%w %s, matches %d keys", ErrAmbiguousKey, key, len(matches))
+	}
+}
+
+func (tb *telegramBot) displayNames() map[string]string {
+	names := make(map[string]string)
+	for _, nodeStatus := range tb.statusProvider.NodesStatus() {
+		names[nodeStatus.PubKey] = nodeStatus.DisplayName()
+	}
+	for _, nodeLabels := range tb.statusProvider.MonitoredNodes() {
+		if nodeLabels.Alias != "" {
+			names[nodeLabels.PubKey] = nodeLabels.Alias
+		}
+	}
+
+	return names
+}
+
+func displayName(names map[string]string, pubKey string) string {
+	name, ok := names[pubKey]
+	if ok && name != "" {
+		return name
+	}
+	if len(pubKey) > shortKeyLength {
+		return pubKey[:shortKeyLength] + "..."
+	}
+
+	return pubKey
+}
+
+// parseDuration parses the go durations, adding support for days, like 1d
+func parseDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,33 @@
+package telegram
+
+import "errors"
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
+
+// ErrEmptyApiUrl signals that an empty telegram api url has been provided
+var ErrEmptyApiUrl = errors.New("empty telegram api url has been provided")
+
+// ErrEmptyBotToken signals that an empty bot token has been provided
+var ErrEmptyBotToken = errors.New("empty telegram bot token has been provided")
+
+// ErrEmptyChatIDs signals that no chat id has been provided
+var ErrEmptyChatIDs = errors.New("no telegram chat ids have been provided")
+
+// ErrNilNodesStatusProvider signals that a nil nodes status provider has been provided
+var ErrNilNodesStatusProvider = errors.New("nil nodes status provider")
+
+// ErrNilAlertsManager signals that a nil alerts manager has been provided
+var ErrNilAlertsManager = errors.New("nil alerts manager")
+
+// ErrEmptyAllowedChatIDs signals that no chat is allowed to send commands
+var ErrEmptyAllowedChatIDs = errors.New("no telegram chat ids allowed to send commands")
+
+// ErrTelegramAPI signals that the telegram api rejected the request
+var ErrTelegramAPI = errors.New("telegram api error")
+
+// ErrUnknownKey signals that the provided key does not match any monitored node
+var ErrUnknownKey = errors.New("unknown key")
+
+// ErrAmbiguousKey signals that the provided key matches more than one monitored node
+var ErrAmbiguousKey = errors.New("ambiguous key")
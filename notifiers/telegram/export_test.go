@@ -0,0 +1,19 @@
+package telegram
+
+// MaxMessageLength -
+const MaxMessageLength = maxMessageLength
+
+// EscapeMarkdownV2 -
+func EscapeMarkdownV2(text string) string {
+	return escapeMarkdownV2(text)
+}
+
+// SplitMessage -
+func SplitMessage(header string, rawLines []string, maxLength int) []string {
+	return splitMessage(header, rawLines, maxLength, escapeMarkdownV2)
+}
+
+// HandleCommand -
+func (tb *telegramBot) HandleCommand(text string, by string) []string {
+	return tb.handleCommand(text, by)
+}
@@ -0,0 +1,25 @@
+package telegram
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+)
+
+// NodesStatusProvider defines the behaviour of a component able to provide the state of the monitored nodes
+type NodesStatusProvider interface {
+	NodesStatus() []status.NodeStatus
+	MonitoredNodes() []config.NodeLabels
+	IsInterfaceNil() bool
+}
+
+// AlertsManager defines the behaviour of a component able to manage the active alerts
+type AlertsManager interface {
+	Acknowledge(alertID string, by string) (alerts.ActiveAlert, error)
+	Silence(pubKey string, duration time.Duration, by string) (alerts.Silence, error)
+	ActiveAlerts() []alerts.ActiveAlert
+	Silences() []alerts.Silence
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,106 @@
+package telegram
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxMessageLength is the maximum number of characters telegram accepts in a single message
+const maxMessageLength = 4096
+
+// markdownV2Escaper escapes all the characters having a special meaning in telegram MarkdownV2 formatting
+var markdownV2Escaper = strings.NewReplacer(
+	`\`, `\\`,
+	"_", `\_`,
+	"*", `\*`,
+	"[", `\[`,
+	"]", `\]`,
+	"(", `\(`,
+	")", `\)`,
+	"~", `\~`,
+	"`", "\\`",
+	">", `\>`,
+	"#", `\#`,
+	"+", `\+`,
+	"-", `\-`,
+	"=", `\=`,
+	"|", `\|`,
+	"{", `\{`,
+	"}", `\}`,
+	".", `\.`,
+	"!", `\!`,
+)
+
+func escapeMarkdownV2(text string) string {
+	return markdownV2Escaper.Replace(text)
+}
+
+func noEscape(text string) string {
+	return text
+}
+
+// splitMessage will escape the raw lines and will split them, after the already formatted header, in chunks of
+// at most maxLength characters. The lines are kept whole whenever possible, otherwise the raw line is split so
+// that no escape sequence is cut in half
+func splitMessage(header string, rawLines []string, maxLength int, escape func(text string) string) []string {
+	chunks := make([]string, 0, 1)
+	current := header
+	currentLength := utf8.RuneCountInString(header)
+
+	appendPart := func(part string) {
+		partLength := utf8.RuneCountInString(part)
+		if currentLength > 0 && currentLength+1+partLength > maxLength {
+			chunks = append(chunks, current)
+			current = ""
+			currentLength = 0
+		}
+		if currentLength > 0 {
+			current += "\n"
+			currentLength++
+		}
+		current += part
+		currentLength += partLength
+	}
+
+	for _, rawLine := range rawLines {
+		escapedLine := escape(rawLine)
+		if utf8.RuneCountInString(escapedLine) <= maxLength {
+			appendPart(escapedLine)
+			continue
+		}
+
+		for _, part := range splitLongLine(rawLine, maxLength, escape) {
+			appendPart(part)
+		}
+	}
+
+	if currentLength > 0 {
+		chunks = append(chunks, current)
+	}
+
+	return chunks
+}
+
+func splitLongLine(rawLine string, maxLength int, escape func(text string) string) []string {
+	parts := make([]string, 0)
+	builder := strings.Builder{}
+	length := 0
+	for _, r := range rawLine {
+		escaped := escape(string(r))
+		escapedLength := utf8.RuneCountInString(escaped)
+		if length+escapedLength > maxLength {
+			parts = append(parts, builder.String())
+			builder.Reset()
+			length = 0
+		}
+
+		builder.WriteString(escaped)
+		length += escapedLength
+	}
+
+	if length > 0 {
+		parts = append(parts, builder.String())
+	}
+
+	return parts
+}
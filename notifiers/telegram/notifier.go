@@ -0,0 +1,128 @@
+package telegram
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+var log = logger.GetOrCreate("telegramNotifier")
+
+const (
+	sendMessagePath = "/bot%s/sendMessage"
+	parseMode       = "MarkdownV2"
+)
+
+type sendMessagePayload struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode,omitempty"`
+	DisableNotification   bool   `json:"disable_notification"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+	ReplyToMessageID      int64  `json:"reply_to_message_id,omitempty"`
+}
+
+// ArgsTelegramNotifier defines the arguments needed to create a new telegram notifier
+type ArgsTelegramNotifier struct {
+	Config     *config.Telegram
+	HTTPClient notifiers.HTTPClient
+}
+
+type telegramNotifier struct {
+	apiUrl     string
+	path       string
+	chatIDs    []string
+	httpClient notifiers.HTTPClient
+}
+
+// NewTelegramNotifier will create a new telegram notifier instance
+func NewTelegramNotifier(args ArgsTelegramNotifier) (*telegramNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &telegramNotifier{
+		apiUrl:     strings.TrimSuffix(args.Config.ApiUrl, "/"),
+		path:       fmt.Sprintf(sendMessagePath, args.Config.BotToken),
+		chatIDs:    args.Config.ChatIDs,
+		httpClient: args.HTTPClient,
+	}, nil
+}
+
+func checkArgs(args ArgsTelegramNotifier) error {
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+	if args.Config.ApiUrl == "" {
+		return ErrEmptyApiUrl
+	}
+	if args.Config.BotToken == "" {
+		return ErrEmptyBotToken
+	}
+	if len(args.Config.ChatIDs) == 0 {
+		return ErrEmptyChatIDs
+	}
+
+	return nil
+}
+
+// PushMessage will push the notification to all the configured chats. Messages longer than telegram allows are
+// sent in multiple parts and the info level ones are delivered silently
+func (tn *telegramNotifier) PushMessage(msg data.NotificationMessage) error {
+	header := fmt.Sprintf("*%s*", escapeMarkdownV2(strings.ToUpper(msg.Level.String())))
+	lines := strings.Split(strings.TrimRight(msg.Message, "\n"), "\n")
+	chunks := splitMessage(header, lines, maxMessageLength, escapeMarkdownV2)
+
+	numFailed := 0
+	var lastErr error
+	for _, chatID := range tn.chatIDs {
+		err := tn.sendChunks(chatID, chunks, msg.Level <= common.InfoEvent)
+		if err != nil {
+			log.Warn("failed to send telegram message", "chat id", chatID, "error", err.Error())
+			numFailed++
+			lastErr = err
+		}
+	}
+
+	if lastErr != nil {
+		return fmt.Errorf("failed to send the message to %d out of %d chats, last error: %w", numFailed, len(tn.chatIDs), lastErr)
+	}
+
+	return nil
+}
+
+func (tn *telegramNotifier) sendChunks(chatID string, chunks []string, silent bool) error {
+	for _, chunk := range chunks {
+		msgPayload := sendMessagePayload{
+			ChatID:                chatID,
+			Text:                  chunk,
+			ParseMode:             parseMode,
+			DisableNotification:   silent,
+			DisableWebPagePreview: true,
+		}
+
+		err := tn.httpClient.CallPostRestEndPoint(tn.apiUrl, tn.path, msgPayload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetID will return the identifier for telegram notifier
+func (tn *telegramNotifier) GetID() string {
+	return "Telegram"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tn *telegramNotifier) IsInterfaceNil() bool {
+	return tn == nil
+}
@@ -0,0 +1,272 @@
+package telegram_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/telegram"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedMessage struct {
+	path    string
+	payload map[string]interface{}
+}
+
+type telegramStubServer struct {
+	*httptest.Server
+	mut      sync.Mutex
+	messages []receivedMessage
+}
+
+func newTelegramStubServer(t *testing.T, failingChatID string) *telegramStubServer {
+	stub := &telegramStubServer{}
+	stub.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.Nil(t, err)
+
+		payload := make(map[string]interface{})
+		err = json.Unmarshal(body, &payload)
+		require.Nil(t, err)
+
+		if payload["chat_id"] == failingChatID {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+			return
+		}
+
+		stub.mut.Lock()
+		stub.messages = append(stub.messages, receivedMessage{path: r.URL.Path, payload: payload})
+		stub.mut.Unlock()
+
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+
+	return stub
+}
+
+func createMockTelegramNotifierArgs() telegram.ArgsTelegramNotifier {
+	return telegram.ArgsTelegramNotifier{
+		Config: &config.Telegram{
+			Enabled:  true,
+			ApiUrl:   "https://api.telegram.org",
+			BotToken: "123:token",
+			ChatIDs:  []string{"-100", "@channel"},
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+func createArgsWithHTTPClient(t *testing.T) *telegram.ArgsTelegramNotifier {
+	args := createMockTelegramNotifierArgs()
+	httpClient, err := clients.NewHTTPClientWrapper(clients.HTTPClientWrapperArgs{ReqTimeoutSec: 5})
+	require.Nil(t, err)
+	args.HTTPClient = httpClient
+
+	return &args
+}
+
+func TestNewTelegramNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramNotifierArgs()
+		args.HTTPClient = nil
+
+		tn, err := telegram.NewTelegramNotifier(args)
+		require.Nil(t, tn)
+		require.Equal(t, telegram.ErrNilHTTPClient, err)
+	})
+
+	t.Run("empty api url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramNotifierArgs()
+		args.Config.ApiUrl = ""
+
+		tn, err := telegram.NewTelegramNotifier(args)
+		require.Nil(t, tn)
+		require.Equal(t, telegram.ErrEmptyApiUrl, err)
+	})
+
+	t.Run("empty bot token", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramNotifierArgs()
+		args.Config.BotToken = ""
+
+		tn, err := telegram.NewTelegramNotifier(args)
+		require.Nil(t, tn)
+		require.Equal(t, telegram.ErrEmptyBotToken, err)
+	})
+
+	t.Run("empty chat ids", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTelegramNotifierArgs()
+		args.Config.ChatIDs = nil
+
+		tn, err := telegram.NewTelegramNotifier(args)
+		require.Nil(t, tn)
+		require.Equal(t, telegram.ErrEmptyChatIDs, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tn, err := telegram.NewTelegramNotifier(createMockTelegramNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, tn.IsInterfaceNil())
+		assert.Equal(t, "Telegram", tn.GetID())
+	})
+}
+
+func TestTelegramNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should send to all chats", func(t *testing.T) {
+		t.Parallel()
+
+		server := newTelegramStubServer(t, "")
+		defer server.Close()
+
+		args := createArgsWithHTTPClient(t)
+		args.Config.ApiUrl = server.URL + "/"
+		tn, err := telegram.NewTelegramNotifier(*args)
+		require.Nil(t, err)
+
+		err = tn.PushMessage(data.NotificationMessage{
+			Message: "NodeName: node-1 - TempRating decreased, current value: 90.50\n",
+			Level:   common.CriticalEvent,
+		})
+		require.Nil(t, err)
+
+		require.Len(t, server.messages, 2)
+		for idx, chatID := range args.Config.ChatIDs {
+			msg := server.messages[idx]
+			assert.Equal(t, "/bot123:token/sendMessage", msg.path)
+			assert.Equal(t, chatID, msg.payload["chat_id"])
+			assert.Equal(t, "MarkdownV2", msg.payload["parse_mode"])
+			assert.Equal(t, false, msg.payload["disable_notification"])
+			assert.Equal(t, "*CRITICAL*\nNodeName: node\\-1 \\- TempRating decreased, current value: 90\\.50", msg.payload["text"])
+		}
+	})
+
+	t.Run("info level should be silent", func(t *testing.T) {
+		t.Parallel()
+
+		server := newTelegramStubServer(t, "")
+		defer server.Close()
+
+		args := createArgsWithHTTPClient(t)
+		args.Config.ApiUrl = server.URL
+		args.Config.ChatIDs = []string{"-100"}
+		tn, err := telegram.NewTelegramNotifier(*args)
+		require.Nil(t, err)
+
+		err = tn.PushMessage(data.NotificationMessage{Message: "info", Level: common.InfoEvent})
+		require.Nil(t, err)
+
+		require.Len(t, server.messages, 1)
+		assert.Equal(t, true, server.messages[0].payload["disable_notification"])
+	})
+
+	t.Run("long message should be split", func(t *testing.T) {
+		t.Parallel()
+
+		server := newTelegramStubServer(t, "")
+		defer server.Close()
+
+		args := createArgsWithHTTPClient(t)
+		args.Config.ApiUrl = server.URL
+		args.Config.ChatIDs = []string{"-100"}
+		tn, err := telegram.NewTelegramNotifier(*args)
+		require.Nil(t, err)
+
+		line := strings.Repeat("a", 99)
+		err = tn.PushMessage(data.NotificationMessage{
+			Message: strings.Repeat(line+"\n", 100),
+			Level:   common.WarningEvent,
+		})
+		require.Nil(t, err)
+
+		require.Len(t, server.messages, 3)
+		for _, msg := range server.messages {
+			assert.LessOrEqual(t, utf8.RuneCountInString(msg.payload["text"].(string)), telegram.MaxMessageLength)
+		}
+		assert.True(t, strings.HasPrefix(server.messages[0].payload["text"].(string), "*WARNING*\n"))
+	})
+
+	t.Run("failing chat should not stop the others", func(t *testing.T) {
+		t.Parallel()
+
+		server := newTelegramStubServer(t, "-100")
+		defer server.Close()
+
+		args := createArgsWithHTTPClient(t)
+		args.Config.ApiUrl = server.URL
+		tn, err := telegram.NewTelegramNotifier(*args)
+		require.Nil(t, err)
+
+		err = tn.PushMessage(data.NotificationMessage{Message: "msg", Level: common.CriticalEvent})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "1 out of 2 chats")
+
+		var statusErr *common.HTTPStatusError
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusBadRequest, statusErr.StatusCode)
+
+		require.Len(t, server.messages, 1)
+		assert.Equal(t, "@channel", server.messages[0].payload["chat_id"])
+	})
+}
+
+func TestEscapeMarkdownV2(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "plain text", telegram.EscapeMarkdownV2("plain text"))
+	assert.Equal(t,
+		`\_\*\[\]\(\)\~`+"\\`"+`\>\#\+\-\=\|\{\}\.\!\\`,
+		telegram.EscapeMarkdownV2("_*[]()~`>#+-=|{}.!\\"),
+	)
+}
+
+func TestSplitMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("short lines are kept together", func(t *testing.T) {
+		t.Parallel()
+
+		chunks := telegram.SplitMessage("*H*", []string{"a.b", "c"}, 20)
+		assert.Equal(t, []string{"*H*\na\\.b\nc"}, chunks)
+	})
+
+	t.Run("lines are not split unless needed", func(t *testing.T) {
+		t.Parallel()
+
+		chunks := telegram.SplitMessage("*H*", []string{"aaaa", "bbbb", "cccc"}, 9)
+		assert.Equal(t, []string{"*H*\naaaa", "bbbb\ncccc"}, chunks)
+	})
+
+	t.Run("long line should not cut escape sequences", func(t *testing.T) {
+		t.Parallel()
+
+		chunks := telegram.SplitMessage("", []string{"a....."}, 4)
+		assert.Equal(t, []string{`a\.`, `\.\.`, `\.\.`}, chunks)
+	})
+}
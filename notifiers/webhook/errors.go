@@ -0,0 +1,12 @@
+package webhook
+
+import "errors"
+
+// ErrInvalidWebhookURL signals that an empty url has been provided
+var ErrInvalidWebhookURL = errors.New("empty webhook url has been provided")
+
+// ErrNilHTTPClient signals that a nil http client has been provided
+var ErrNilHTTPClient = errors.New("nil http client")
+
+// ErrInvalidBody signals that the body rendered from the template is not a valid json
+var ErrInvalidBody = errors.New("the rendered webhook body is not a valid json")
@@ -0,0 +1,8 @@
+package webhook
+
+import "time"
+
+// SetGetTimeHandler -
+func (wn *webhookNotifier) SetGetTimeHandler(handler func() time.Time) {
+	wn.getTimeHandler = handler
+}
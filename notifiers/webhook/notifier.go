@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+const (
+	defaultSignatureHeader = "X-Signature-256"
+	defaultTimestampHeader = "X-Signature-Timestamp"
+	signaturePrefix        = "sha256="
+)
+
+// ArgsWebhookNotifier defines the arguments needed to create a new generic webhook notifier
+type ArgsWebhookNotifier struct {
+	Config     *config.Webhook
+	HTTPClient notifiers.HTTPClient
+}
+
+type webhookNotifier struct {
+	url             string
+	method          string
+	headers         map[string]string
+	bodyTemplate    *template.Template
+	secret          []byte
+	signatureHeader string
+	timestampHeader string
+	httpClient      notifiers.HTTPClient
+	getTimeHandler  func() time.Time
+}
+
+// NewWebhookNotifier will create a new generic webhook notifier instance
+func NewWebhookNotifier(args ArgsWebhookNotifier) (*webhookNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyTemplate, err := parseBodyTemplate(args.Config.BodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook body template: %w", err)
+	}
+
+	method := strings.ToUpper(args.Config.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	return &webhookNotifier{
+		url:             args.Config.URL,
+		method:          method,
+		headers:         args.Config.Headers,
+		bodyTemplate:    bodyTemplate,
+		secret:          []byte(args.Config.Secret),
+		signatureHeader: valueOrDefault(args.Config.SignatureHeader, defaultSignatureHeader),
+		timestampHeader: valueOrDefault(args.Config.TimestampHeader, defaultTimestampHeader),
+		httpClient:      args.HTTPClient,
+		getTimeHandler:  time.Now,
+	}, nil
+}
+
+func checkArgs(args ArgsWebhookNotifier) error {
+	if args.Config.URL == "" {
+		return ErrInvalidWebhookURL
+	}
+	if check.IfNil(args.HTTPClient) {
+		return ErrNilHTTPClient
+	}
+
+	return nil
+}
+
+func valueOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+// PushMessage will render the body out of the notification and will send it to the configured url. If a secret
+// is configured, the request is signed with HMAC-SHA256 over the timestamp and the body
+func (wn *webhookNotifier) PushMessage(msg data.NotificationMessage) error {
+	now := wn.getTimeHandler()
+
+	buff := bytes.Buffer{}
+	err := wn.bodyTemplate.Execute(&buff, newTemplateData(msg, now))
+	if err != nil {
+		return fmt.Errorf("failed to render the webhook body: %w", err)
+	}
+	body := buff.Bytes()
+	if !json.Valid(body) {
+		return ErrInvalidBody
+	}
+
+	headers := make(map[string]string, len(wn.headers)+2)
+	for name, value := range wn.headers {
+		headers[name] = value
+	}
+	if len(wn.secret) > 0 {
+		timestamp := strconv.FormatInt(now.Unix(), 10)
+		headers[wn.timestampHeader] = timestamp
+		headers[wn.signatureHeader] = signaturePrefix + wn.sign(timestamp, body)
+	}
+
+	_, err = wn.httpClient.CallRestEndPoint(data.HTTPRequest{
+		Method:  wn.method,
+		URL:     wn.url,
+		Headers: headers,
+		Body:    body,
+	})
+
+	return err
+}
+
+// sign computes the signature over "<timestamp>.<body>", so a captured request cannot be replayed with another timestamp
+func (wn *webhookNotifier) sign(timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, wn.secret)
+	_, _ = mac.Write([]byte(timestamp + "."))
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NotifiesResolved returns true as the resolved alerts are available to the body template
+func (wn *webhookNotifier) NotifiesResolved() bool {
+	return true
+}
+
+// GetID will return the identifier for generic webhook notifier
+func (wn *webhookNotifier) GetID() string {
+	return "Webhook"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wn *webhookNotifier) IsInterfaceNil() bool {
+	return wn == nil
+}
@@ -0,0 +1,213 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Unix(1700000000, 0)
+
+func createMockWebhookNotifierArgs() webhook.ArgsWebhookNotifier {
+	return webhook.ArgsWebhookNotifier{
+		Config: &config.Webhook{
+			Enabled: true,
+			URL:     "http://localhost/hook",
+		},
+		HTTPClient: &mocks.HTTPClientStub{},
+	}
+}
+
+// pushAndCapture will push the message with the provided config and will return the request sent
+func pushAndCapture(t *testing.T, cfg *config.Webhook, msg data.NotificationMessage) data.HTTPRequest {
+	var sentRequest data.HTTPRequest
+	args := createMockWebhookNotifierArgs()
+	args.Config = cfg
+	args.HTTPClient = &mocks.HTTPClientStub{
+		CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+			sentRequest = request
+			return nil, nil
+		},
+	}
+
+	wn, err := webhook.NewWebhookNotifier(args)
+	require.Nil(t, err)
+	wn.SetGetTimeHandler(func() time.Time {
+		return testTime
+	})
+
+	err = wn.PushMessage(msg)
+	require.Nil(t, err)
+
+	return sentRequest
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWebhookNotifierArgs()
+		args.Config.URL = ""
+
+		wn, err := webhook.NewWebhookNotifier(args)
+		require.Nil(t, wn)
+		require.Equal(t, webhook.ErrInvalidWebhookURL, err)
+	})
+
+	t.Run("nil http client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWebhookNotifierArgs()
+		args.HTTPClient = nil
+
+		wn, err := webhook.NewWebhookNotifier(args)
+		require.Nil(t, wn)
+		require.Equal(t, webhook.ErrNilHTTPClient, err)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWebhookNotifierArgs()
+		args.Config.BodyTemplate = `{"text": {{json .Message}`
+
+		wn, err := webhook.NewWebhookNotifier(args)
+		require.Nil(t, wn)
+		assert.Contains(t, err.Error(), "invalid webhook body template")
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wn, err := webhook.NewWebhookNotifier(createMockWebhookNotifierArgs())
+		require.Nil(t, err)
+		require.False(t, wn.IsInterfaceNil())
+		assert.Equal(t, "Webhook", wn.GetID())
+		assert.True(t, wn.NotifiesResolved())
+	})
+}
+
+func TestWebhookNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	alert := data.Alert{
+		ID:           "id1",
+		ClientID:     "NodeRating",
+		Condition:    "ratingDecrease",
+		Level:        common.CriticalEvent,
+		Message:      `rating "decreased"`,
+		PubKey:       "key1",
+		NodeName:     "node-1",
+		Tags:         []string{"prod"},
+		CurrentValue: 90,
+	}
+
+	t.Run("default body", func(t *testing.T) {
+		t.Parallel()
+
+		request := pushAndCapture(t, createMockWebhookNotifierArgs().Config, data.NotificationMessage{
+			Message: "message",
+			Level:   common.CriticalEvent,
+			Alerts:  []data.Alert{alert},
+		})
+		assert.Equal(t, "POST", request.Method)
+		assert.Equal(t, "http://localhost/hook", request.URL)
+		assert.Empty(t, request.Headers)
+
+		body := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(request.Body, &body))
+		assert.Equal(t, "critical", body["level"])
+		assert.Equal(t, "Node monitoring - CRITICAL", body["title"])
+		assert.Equal(t, "message", body["message"])
+		assert.Equal(t, "2023-11-14T22:13:20Z", body["timestamp"])
+		assert.Empty(t, body["resolved"])
+
+		alerts := body["alerts"].([]interface{})
+		require.Len(t, alerts, 1)
+		receivedAlert := alerts[0].(map[string]interface{})
+		assert.Equal(t, "id1", receivedAlert["id"])
+		assert.Equal(t, `rating "decreased"`, receivedAlert["message"])
+		assert.Equal(t, "critical", receivedAlert["level"])
+		assert.Equal(t, []interface{}{"prod"}, receivedAlert["tags"])
+		assert.Equal(t, data.NewDedupKey("NodeRating", "key1", "ratingDecrease"), receivedAlert["dedupKey"])
+	})
+
+	t.Run("custom method, headers and template", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Webhook{
+			URL:     "http://localhost/hook",
+			Method:  "put",
+			Headers: map[string]string{"Authorization": "Bearer token"},
+			BodyTemplate: `{"severity": {{json .Level}}, "nodes": [{{range $idx, $alert := .Alerts}}` +
+				`{{if $idx}},{{end}}{{json $alert.NodeName}}{{end}}]}`,
+		}
+		request := pushAndCapture(t, cfg, data.NotificationMessage{
+			Level:  common.WarningEvent,
+			Alerts: []data.Alert{alert, alert},
+		})
+		assert.Equal(t, "PUT", request.Method)
+		assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, request.Headers)
+		assert.JSONEq(t, `{"severity": "warning", "nodes": ["node-1", "node-1"]}`, string(request.Body))
+	})
+
+	t.Run("signed request", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Webhook{
+			URL:             "http://localhost/hook",
+			Secret:          "secret",
+			SignatureHeader: "X-Hub-Signature",
+		}
+		request := pushAndCapture(t, cfg, data.NotificationMessage{Message: "message", Level: common.InfoEvent})
+		assert.Equal(t, "1700000000", request.Headers["X-Signature-Timestamp"])
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		_, _ = mac.Write([]byte("1700000000." + string(request.Body)))
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), request.Headers["X-Hub-Signature"])
+	})
+
+	t.Run("rendered body is not a valid json", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWebhookNotifierArgs()
+		args.Config.BodyTemplate = `{"text": {{.Message}}}`
+		wn, err := webhook.NewWebhookNotifier(args)
+		require.Nil(t, err)
+
+		err = wn.PushMessage(data.NotificationMessage{Message: "not quoted"})
+		assert.Equal(t, webhook.ErrInvalidBody, err)
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockWebhookNotifierArgs()
+		args.HTTPClient = &mocks.HTTPClientStub{
+			CallRestEndPointCalled: func(request data.HTTPRequest) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		wn, err := webhook.NewWebhookNotifier(args)
+		require.Nil(t, err)
+
+		err = wn.PushMessage(data.NotificationMessage{Message: strings.Repeat("a", 10)})
+		assert.Equal(t, expectedErr, err)
+	})
+}
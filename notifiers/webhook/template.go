@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"encoding/json"
+	"text/template"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/notifiers"
+)
+
+// defaultBodyTemplate is used when no template is configured and contains all the notification fields
+const defaultBodyTemplate = `{"level":{{json .Level}},"title":{{json .Title}},"message":{{json .Message}},` +
+	`"timestamp":{{json .Timestamp}},"alerts":{{json .Alerts}},"resolved":{{json .Resolved}}}`
+
+// templateData holds the fields the body template is executed with
+type templateData struct {
+	Level     string
+	Title     string
+	Message   string
+	Timestamp string
+	Alerts    []templateAlert
+	Resolved  []templateAlert
+}
+
+type templateAlert struct {
+	ID            string   `json:"id"`
+	ClientID      string   `json:"clientId"`
+	Condition     string   `json:"condition"`
+	Level         string   `json:"level"`
+	Message       string   `json:"message"`
+	PubKey        string   `json:"pubKey"`
+	NodeName      string   `json:"nodeName"`
+	Alias         string   `json:"alias,omitempty"`
+	Identity      string   `json:"identity,omitempty"`
+	Provider      string   `json:"provider,omitempty"`
+	Shard         int      `json:"shard"`
+	Tags          []string `json:"tags,omitempty"`
+	CurrentValue  float64  `json:"currentValue"`
+	PreviousValue float64  `json:"previousValue,omitempty"`
+	Threshold     float64  `json:"threshold,omitempty"`
+	Flapping      bool     `json:"flapping,omitempty"`
+	DedupKey      string   `json:"dedupKey"`
+}
+
+var templateFuncs = template.FuncMap{
+	// json renders a value as json, so the strings coming from the notifications are always escaped
+	"json": func(value interface{}) (string, error) {
+		buff, err := json.Marshal(value)
+		return string(buff), err
+	},
+}
+
+func parseBodyTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = defaultBodyTemplate
+	}
+
+	return template.New("body").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+func newTemplateData(msg data.NotificationMessage, timestamp time.Time) templateData {
+	return templateData{
+		Level:     msg.Level.String(),
+		Title:     notifiers.Title(msg.Level),
+		Message:   msg.Message,
+		Timestamp: timestamp.UTC().Format(time.RFC3339),
+		Alerts:    newTemplateAlerts(msg.Alerts),
+		Resolved:  newTemplateAlerts(msg.Resolved),
+	}
+}
+
+func newTemplateAlerts(alerts []data.Alert) []templateAlert {
+	templateAlerts := make([]templateAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		templateAlerts = append(templateAlerts, templateAlert{
+			ID:            alert.ID,
+			ClientID:      alert.ClientID,
+			Condition:     alert.Condition,
+			Level:         alert.Level.String(),
+			Message:       alert.Message,
+			PubKey:        alert.PubKey,
+			NodeName:      alert.NodeName,
+			Alias:         alert.Alias,
+			Identity:      alert.Identity,
+			Provider:      alert.Provider,
+			Shard:         alert.Shard,
+			Tags:          alert.Tags,
+			CurrentValue:  alert.CurrentValue,
+			PreviousValue: alert.PreviousValue,
+			Threshold:     alert.Threshold,
+			Flapping:      alert.Flapping,
+			DedupKey:      data.NewDedupKey(alert.ClientID, alert.PubKey, alert.Condition),
+		})
+	}
+
+	return templateAlerts
+}
@@ -0,0 +1,38 @@
+package process
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+type alertsHandlersChain struct {
+	handlers []AlertsHandler
+}
+
+// NewAlertsHandlersChain will create an alerts handler which passes the events through the provided handlers,
+// in order: every handler processes the event returned by the previous one
+func NewAlertsHandlersChain(handlers ...AlertsHandler) (*alertsHandlersChain, error) {
+	for _, handler := range handlers {
+		if check.IfNil(handler) {
+			return nil, ErrNilAlertsHandler
+		}
+	}
+
+	return &alertsHandlersChain{
+		handlers: handlers,
+	}, nil
+}
+
+// ProcessEvent will process the event with every handler of the chain
+func (ahc *alertsHandlersChain) ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage {
+	for _, handler := range ahc.handlers {
+		event = handler.ProcessEvent(clientID, event)
+	}
+
+	return event
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ahc *alertsHandlersChain) IsInterfaceNil() bool {
+	return ahc == nil
+}
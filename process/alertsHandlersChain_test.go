@@ -0,0 +1,48 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+	"github.com/multiversx/mx-chain-node-monitoring/process/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAlertsHandlersChain(t *testing.T) {
+	t.Parallel()
+
+	chain, err := process.NewAlertsHandlersChain(&mocks.AlertsHandlerStub{}, nil)
+	assert.Nil(t, chain)
+	assert.Equal(t, process.ErrNilAlertsHandler, err)
+
+	chain, err = process.NewAlertsHandlersChain()
+	require.Nil(t, err)
+	assert.False(t, chain.IsInterfaceNil())
+}
+
+func TestAlertsHandlersChain_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	first := &mocks.AlertsHandlerStub{
+		ProcessEventCalled: func(clientID string, event data.NotificationMessage) data.NotificationMessage {
+			assert.Equal(t, "client", clientID)
+			event.Message += " first"
+			return event
+		},
+	}
+	second := &mocks.AlertsHandlerStub{
+		ProcessEventCalled: func(clientID string, event data.NotificationMessage) data.NotificationMessage {
+			assert.Equal(t, "client", clientID)
+			event.Message += " second"
+			event.Level = common.WarningEvent
+			return event
+		},
+	}
+	chain, _ := process.NewAlertsHandlersChain(first, second)
+
+	event := chain.ProcessEvent("client", data.NotificationMessage{Message: "event", Level: common.InfoEvent})
+	assert.Equal(t, data.NotificationMessage{Message: "event first second", Level: common.WarningEvent}, event)
+}
@@ -7,3 +7,18 @@ var ErrNilClient = errors.New("nil client")
 
 // ErrNilPusher signals that a nil pusher instance have been provided
 var ErrNilPusher = errors.New("nil pusher instance")
+
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
+// ErrNilAlertsHandler signals that a nil alerts handler has been provided
+var ErrNilAlertsHandler = errors.New("nil alerts handler")
+
+// ErrNilEventsRecorder signals that a nil events recorder has been provided
+var ErrNilEventsRecorder = errors.New("nil events recorder")
+
+// ErrNilAlertsMuter signals that a nil alerts muter has been provided
+var ErrNilAlertsMuter = errors.New("nil alerts muter")
+
+// ErrNilFiringAlertsProvider signals that a nil firing alerts provider has been provided
+var ErrNilFiringAlertsProvider = errors.New("nil firing alerts provider")
@@ -3,21 +3,31 @@ package process
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
 )
 
 var log = logger.GetOrCreate("process")
 
 const minTriggerIntervalSec = 1
 
+// AvailabilityClientID is the client id of the alerts raised when a client fails to fetch its data. The condition
+// of such an alert is the id of the failing client
+const AvailabilityClientID = "Availability"
+
 // ArgsEventsProcessor defines the arguments needed for events processor creation
 type ArgsEventsProcessor struct {
 	Pusher             Pusher
+	AlertsHandler      AlertsHandler
+	EventsRecorder     EventsRecorder
+	MetricsHandler     MetricsHandler
+	FiringAlerts       FiringAlertsProvider
 	TriggerInternalSec int
 }
 
@@ -25,7 +35,12 @@ type eventsProcessor struct {
 	clients            map[string]Connector
 	mutClients         sync.RWMutex
 	pusher             Pusher
+	alertsHandler      AlertsHandler
+	eventsRecorder     EventsRecorder
+	metricsHandler     MetricsHandler
 	triggerInternalSec int
+	firingAlerts       FiringAlertsProvider
+	unreachable        map[string]struct{}
 	cancelFunc         func()
 }
 
@@ -39,7 +54,12 @@ func NewEventsProcessor(args ArgsEventsProcessor) (*eventsProcessor, error) {
 	return &eventsProcessor{
 		clients:            make(map[string]Connector),
 		pusher:             args.Pusher,
+		alertsHandler:      args.AlertsHandler,
+		eventsRecorder:     args.EventsRecorder,
+		metricsHandler:     args.MetricsHandler,
+		firingAlerts:       args.FiringAlerts,
 		triggerInternalSec: args.TriggerInternalSec,
+		unreachable:        make(map[string]struct{}),
 	}, nil
 }
 
@@ -47,6 +67,18 @@ func checkArgs(args ArgsEventsProcessor) error {
 	if check.IfNil(args.Pusher) {
 		return ErrNilPusher
 	}
+	if check.IfNil(args.AlertsHandler) {
+		return ErrNilAlertsHandler
+	}
+	if check.IfNil(args.EventsRecorder) {
+		return ErrNilEventsRecorder
+	}
+	if check.IfNil(args.MetricsHandler) {
+		return ErrNilMetricsHandler
+	}
+	if check.IfNil(args.FiringAlerts) {
+		return ErrNilFiringAlertsProvider
+	}
 	if args.TriggerInternalSec < minTriggerIntervalSec {
 		return fmt.Errorf("%w: minimum trigger interval in seconds %d, provided %d", common.ErrInvalidValue, args.TriggerInternalSec, minTriggerIntervalSec)
 	}
@@ -85,27 +117,123 @@ func (ep *eventsProcessor) run(ctx context.Context) {
 }
 
 func (ep *eventsProcessor) handleEvents() {
+	startTime := time.Now()
+	defer func() {
+		ep.metricsHandler.ObserveCycleDuration(time.Since(startTime))
+	}()
+
 	ep.mutClients.RLock()
 	defer ep.mutClients.RUnlock()
 
+	failed := make(map[string]error)
 	for id, client := range ep.clients {
 		event, err := client.GetEvent()
 		if err != nil {
 			log.Error("failed to get event for client", "client", id, "error", err.Error())
-			return
+			ep.metricsHandler.IncrementClientErrors(id)
+			failed[id] = err
+			continue
+		}
+
+		event = ep.alertsHandler.ProcessEvent(id, event)
+		ep.eventsRecorder.RecordEvent(id, event)
+		ep.pushEvent(id, event)
+	}
+
+	// the availability alerts are raised before the alerts of the failing clients are raised again, so they can
+	// inhibit them, and they are resolved after the recovered clients sent their events, so the alerts which are
+	// no longer firing are not released from inhibition
+	ep.handleAvailability(failed)
+	for _, id := range sortedKeys(failed) {
+		ep.handleFailedClient(id)
+	}
+}
+
+// handleFailedClient raises again, without changes, the alerts of a failing client which are still firing, so they
+// are not resolved and can be inhibited during the outage. The event is not recorded, as no data was fetched
+func (ep *eventsProcessor) handleFailedClient(id string) {
+	firingAlerts := ep.firingAlerts.FiringAlerts(id)
+	if len(firingAlerts) == 0 {
+		return
+	}
+
+	event := data.NotificationMessage{
+		Level:  common.NoEvent,
+		Alerts: make([]data.Alert, 0, len(firingAlerts)),
+	}
+	for _, alert := range firingAlerts {
+		alert.Changed = false
+		event.Alerts = append(event.Alerts, alert)
+	}
+
+	event = ep.alertsHandler.ProcessEvent(id, event)
+	ep.pushEvent(id, event)
+}
+
+// handleAvailability raises an alert for every client which failed to fetch its data. The alert is notified when
+// the client becomes unreachable and it is resolved when the client fetches its data again
+func (ep *eventsProcessor) handleAvailability(failed map[string]error) {
+	if len(failed) == 0 && len(ep.unreachable) == 0 {
+		return
+	}
+
+	event := data.NotificationMessage{Level: common.NoEvent}
+	for _, id := range sortedKeys(failed) {
+		_, wasUnreachable := ep.unreachable[id]
+		alert := data.Alert{
+			ID:        data.NewAlertID(AvailabilityClientID, "", id),
+			ClientID:  AvailabilityClientID,
+			Condition: id,
+			Level:     common.CriticalEvent,
+			Message:   fmt.Sprintf("%s client failed to fetch its data: %s", id, failed[id].Error()),
+			Changed:   !wasUnreachable,
+			NodeName:  id,
+		}
+		event.Alerts = append(event.Alerts, alert)
+		if alert.Changed {
+			event.Level = common.CriticalEvent
+			event.Message += alert.Message + "\n"
 		}
+	}
 
-		switch event.Level {
-		case common.CriticalEvent:
-			log.Info("Critical Event received. Will try to send event.", "clientID", id)
+	ep.unreachable = make(map[string]struct{})
+	for id := range failed {
+		ep.unreachable[id] = struct{}{}
+	}
+
+	event = ep.alertsHandler.ProcessEvent(AvailabilityClientID, event)
+	ep.pushEvent(AvailabilityClientID, event)
+}
+
+func sortedKeys(failed map[string]error) []string {
+	ids := make([]string, 0, len(failed))
+	for id := range failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (ep *eventsProcessor) pushEvent(id string, event data.NotificationMessage) {
+	switch event.Level {
+	case common.CriticalEvent:
+		log.Info("Critical Event received. Will try to send event.", "clientID", id)
+		ep.pusher.PushMessage(event)
+	case common.WarningEvent:
+		log.Info("Warning Event received. Will try to send event.", "clientID", id)
+		ep.pusher.PushMessage(event)
+	case common.InfoEvent:
+		if len(event.Resolved) > 0 {
+			log.Info("Resolved alerts received. Will try to send event.", "clientID", id, "num resolved", len(event.Resolved))
 			ep.pusher.PushMessage(event)
-		case common.InfoEvent:
-			log.Info("Info event received. Will not send notification.", "clientID", id)
-		case common.NoEvent:
-			log.Debug("No event received. Will not send notification.", "clientID", id)
-		default:
-			log.Error("Invalid event level", "clientID", id)
+			return
 		}
+		log.Info("Info event received. Will not send notification.", "clientID", id)
+	case common.NoEvent:
+		log.Debug("No event received. Will not send notification.", "clientID", id)
+	default:
+		log.Error("Invalid event level", "clientID", id)
 	}
 }
 
@@ -6,8 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-node-monitoring/alerts"
 	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
 	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/inhibition"
+	commonMocks "github.com/multiversx/mx-chain-node-monitoring/mocks"
 	"github.com/multiversx/mx-chain-node-monitoring/process"
 	"github.com/multiversx/mx-chain-node-monitoring/process/mocks"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +21,10 @@ import (
 func createNewEventMockArgs() process.ArgsEventsProcessor {
 	return process.ArgsEventsProcessor{
 		Pusher:             &mocks.PusherStub{},
+		AlertsHandler:      &mocks.AlertsHandlerStub{},
+		EventsRecorder:     &mocks.EventsRecorderStub{},
+		MetricsHandler:     &mocks.MetricsHandlerStub{},
+		FiringAlerts:       &mocks.FiringAlertsProviderStub{},
 		TriggerInternalSec: 1,
 	}
 }
@@ -35,6 +43,50 @@ func TestNewEventsProcessor(t *testing.T) {
 		assert.Equal(t, process.ErrNilPusher, err)
 	})
 
+	t.Run("nil alerts handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createNewEventMockArgs()
+		args.AlertsHandler = nil
+
+		ep, err := process.NewEventsProcessor(args)
+		require.Nil(t, ep)
+		assert.Equal(t, process.ErrNilAlertsHandler, err)
+	})
+
+	t.Run("nil events recorder", func(t *testing.T) {
+		t.Parallel()
+
+		args := createNewEventMockArgs()
+		args.EventsRecorder = nil
+
+		ep, err := process.NewEventsProcessor(args)
+		require.Nil(t, ep)
+		assert.Equal(t, process.ErrNilEventsRecorder, err)
+	})
+
+	t.Run("nil metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createNewEventMockArgs()
+		args.MetricsHandler = nil
+
+		ep, err := process.NewEventsProcessor(args)
+		require.Nil(t, ep)
+		assert.Equal(t, process.ErrNilMetricsHandler, err)
+	})
+
+	t.Run("nil firing alerts provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createNewEventMockArgs()
+		args.FiringAlerts = nil
+
+		ep, err := process.NewEventsProcessor(args)
+		require.Nil(t, ep)
+		assert.Equal(t, process.ErrNilFiringAlertsProvider, err)
+	})
+
 	t.Run("wrong trigger interval", func(t *testing.T) {
 		t.Parallel()
 
@@ -68,6 +120,36 @@ func TestRun(t *testing.T) {
 		},
 	}
 
+	numProcessed := uint32(0)
+	args.AlertsHandler = &mocks.AlertsHandlerStub{
+		ProcessEventCalled: func(clientID string, event data.NotificationMessage) data.NotificationMessage {
+			atomic.AddUint32(&numProcessed, 1)
+			return data.NotificationMessage{Level: common.CriticalEvent}
+		},
+	}
+
+	numRecorded := uint32(0)
+	args.EventsRecorder = &mocks.EventsRecorderStub{
+		RecordEventCalled: func(clientID string, event data.NotificationMessage) {
+			assert.Equal(t, common.CriticalEvent, event.Level)
+			atomic.AddUint32(&numRecorded, 1)
+		},
+	}
+
+	numPushed := uint32(0)
+	args.Pusher = &mocks.PusherStub{
+		PushMessageCalled: func(msg data.NotificationMessage) {
+			atomic.AddUint32(&numPushed, 1)
+		},
+	}
+
+	numCycles := uint32(0)
+	args.MetricsHandler = &mocks.MetricsHandlerStub{
+		ObserveCycleDurationCalled: func(duration time.Duration) {
+			atomic.AddUint32(&numCycles, 1)
+		},
+	}
+
 	ep, err := process.NewEventsProcessor(args)
 	require.Nil(t, err)
 
@@ -80,4 +162,234 @@ func TestRun(t *testing.T) {
 	ep.Close()
 
 	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCycles))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numProcessed))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numRecorded))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numPushed))
+}
+
+func TestEventsProcessor_HandleEventsClientFailure(t *testing.T) {
+	t.Parallel()
+
+	args := createNewEventMockArgs()
+
+	var clientErr error
+	client := &mocks.ConnectorStub{
+		GetEventCalled: func() (data.NotificationMessage, error) {
+			return data.NotificationMessage{}, clientErr
+		},
+		GetIDCalled: func() string {
+			return "NodeRating"
+		},
+	}
+
+	processed := make([]data.NotificationMessage, 0)
+	args.AlertsHandler = &mocks.AlertsHandlerStub{
+		ProcessEventCalled: func(clientID string, event data.NotificationMessage) data.NotificationMessage {
+			if clientID == process.AvailabilityClientID {
+				processed = append(processed, event)
+			}
+			return event
+		},
+	}
+	pushed := make([]data.NotificationMessage, 0)
+	args.Pusher = &mocks.PusherStub{
+		PushMessageCalled: func(msg data.NotificationMessage) {
+			pushed = append(pushed, msg)
+		},
+	}
+
+	ep, err := process.NewEventsProcessor(args)
+	require.Nil(t, err)
+	ep.AddClients(client)
+
+	// no availability event while the client works
+	ep.HandleEvents()
+	assert.Empty(t, processed)
+
+	clientErr = errors.New("api unreachable")
+	ep.HandleEvents()
+	require.Len(t, processed, 1)
+	require.Len(t, processed[0].Alerts, 1)
+	alert := processed[0].Alerts[0]
+	assert.Equal(t, process.AvailabilityClientID, alert.ClientID)
+	assert.Equal(t, "NodeRating", alert.Condition)
+	assert.Equal(t, common.CriticalEvent, alert.Level)
+	assert.True(t, alert.Changed)
+	assert.Equal(t, "NodeRating client failed to fetch its data: api unreachable", alert.Message)
+	require.Len(t, pushed, 1)
+	assert.Equal(t, common.CriticalEvent, pushed[0].Level)
+
+	// still firing, but not changed
+	ep.HandleEvents()
+	require.Len(t, processed, 2)
+	require.Len(t, processed[1].Alerts, 1)
+	assert.False(t, processed[1].Alerts[0].Changed)
+	assert.Equal(t, common.NoEvent, processed[1].Level)
+	assert.Len(t, pushed, 1)
+
+	// an empty event lets the alerts handler resolve the alert
+	clientErr = nil
+	ep.HandleEvents()
+	require.Len(t, processed, 3)
+	assert.Empty(t, processed[2].Alerts)
+
+	ep.HandleEvents()
+	assert.Len(t, processed, 3)
+}
+
+func TestEventsProcessor_HandleEventsRaisesTheFiringAlertsOfFailingClients(t *testing.T) {
+	t.Parallel()
+
+	args := createNewEventMockArgs()
+
+	client := &mocks.ConnectorStub{
+		GetEventCalled: func() (data.NotificationMessage, error) {
+			return data.NotificationMessage{}, errors.New("api unreachable")
+		},
+		GetIDCalled: func() string {
+			return "NodeRating"
+		},
+	}
+
+	firingAlert := data.Alert{
+		ID:        data.NewAlertID("NodeRating", "key1", "tempRatingThreshold"),
+		ClientID:  "NodeRating",
+		Condition: "tempRatingThreshold",
+		Level:     common.WarningEvent,
+		Changed:   true,
+		PubKey:    "key1",
+	}
+	args.FiringAlerts = &mocks.FiringAlertsProviderStub{
+		FiringAlertsCalled: func(clientID string) []data.Alert {
+			assert.Equal(t, "NodeRating", clientID)
+			return []data.Alert{firingAlert}
+		},
+	}
+
+	processedClients := make([]string, 0)
+	processed := make([]data.NotificationMessage, 0)
+	args.AlertsHandler = &mocks.AlertsHandlerStub{
+		ProcessEventCalled: func(clientID string, event data.NotificationMessage) data.NotificationMessage {
+			processedClients = append(processedClients, clientID)
+			processed = append(processed, event)
+			return event
+		},
+	}
+	args.EventsRecorder = &mocks.EventsRecorderStub{
+		RecordEventCalled: func(clientID string, event data.NotificationMessage) {
+			assert.Fail(t, "should not record the events of a failing client")
+		},
+	}
+	pushed := make([]data.NotificationMessage, 0)
+	args.Pusher = &mocks.PusherStub{
+		PushMessageCalled: func(msg data.NotificationMessage) {
+			pushed = append(pushed, msg)
+		},
+	}
+
+	ep, err := process.NewEventsProcessor(args)
+	require.Nil(t, err)
+	ep.AddClients(client)
+
+	ep.HandleEvents()
+	// the availability alert is raised first, so it can inhibit the alerts of the client
+	assert.Equal(t, []string{process.AvailabilityClientID, "NodeRating"}, processedClients)
+	require.Len(t, processed[1].Alerts, 1)
+	unchangedAlert := firingAlert
+	unchangedAlert.Changed = false
+	assert.Equal(t, unchangedAlert, processed[1].Alerts[0])
+	assert.Empty(t, processed[1].Resolved)
+	assert.Equal(t, common.NoEvent, processed[1].Level)
+	// only the availability alert is notified
+	require.Len(t, pushed, 1)
+	assert.Equal(t, process.AvailabilityClientID, pushed[0].Alerts[0].ClientID)
+}
+
+func TestEventsProcessor_HandleEventsInhibitsTheAlertsOfFailingClients(t *testing.T) {
+	t.Parallel()
+
+	storer := &commonMocks.StorerStub{}
+	alertsState, err := alerts.NewAlertsState(alerts.ArgsAlertsState{Storer: storer})
+	require.Nil(t, err)
+	inhibitor, err := inhibition.NewInhibitor(inhibition.ArgsInhibitor{
+		Config: &config.Inhibition{
+			Enabled: true,
+			Rules: []config.InhibitionRule{
+				{
+					Name:   "api unreachable",
+					Source: config.AlertSelector{ClientIDs: []string{process.AvailabilityClientID}},
+					Target: config.AlertSelector{ClientIDs: []string{"NodeRating"}},
+				},
+			},
+		},
+		Storer: storer,
+	})
+	require.Nil(t, err)
+	alertsHandler, err := process.NewAlertsHandlersChain(alertsState, inhibitor)
+	require.Nil(t, err)
+
+	nodeAlert := data.Alert{
+		ID:        data.NewAlertID("NodeRating", "key1", "tempRatingThreshold"),
+		ClientID:  "NodeRating",
+		Condition: "tempRatingThreshold",
+		Level:     common.WarningEvent,
+		Message:   "key1 temp rating below threshold",
+		Changed:   true,
+		PubKey:    "key1",
+	}
+	var clientErr error
+	clientAlerts := []data.Alert{nodeAlert}
+	client := &mocks.ConnectorStub{
+		GetEventCalled: func() (data.NotificationMessage, error) {
+			return data.NotificationMessage{Level: common.WarningEvent, Alerts: clientAlerts}, clientErr
+		},
+		GetIDCalled: func() string {
+			return "NodeRating"
+		},
+	}
+
+	args := createNewEventMockArgs()
+	args.AlertsHandler = alertsHandler
+	args.FiringAlerts = alertsState
+	pushed := make([]data.NotificationMessage, 0)
+	args.Pusher = &mocks.PusherStub{
+		PushMessageCalled: func(msg data.NotificationMessage) {
+			pushed = append(pushed, msg)
+		},
+	}
+	ep, err := process.NewEventsProcessor(args)
+	require.Nil(t, err)
+	ep.AddClients(client)
+
+	ep.HandleEvents()
+	require.Len(t, pushed, 1)
+	require.Len(t, pushed[0].Alerts, 1)
+	assert.True(t, pushed[0].Alerts[0].Changed)
+
+	// the node alert is kept firing and it is inhibited by the availability alert
+	clientErr = errors.New("api unreachable")
+	ep.HandleEvents()
+	require.Len(t, pushed, 2)
+	require.Len(t, pushed[1].Alerts, 1)
+	assert.Equal(t, process.AvailabilityClientID, pushed[1].Alerts[0].ClientID)
+	assert.Empty(t, pushed[1].Resolved)
+	inhibited := inhibitor.InhibitedAlerts()
+	require.Len(t, inhibited, 1)
+	assert.Equal(t, nodeAlert.ID, inhibited[0].Alert.ID)
+	assert.True(t, inhibited[0].Notified)
+	require.Len(t, alertsState.ActiveAlerts(), 2)
+
+	// the alert which cleared meanwhile is resolved once the api recovers, without being notified as firing
+	clientErr = nil
+	clientAlerts = nil
+	ep.HandleEvents()
+	require.Len(t, pushed, 4)
+	assert.Empty(t, pushed[2].Alerts)
+	require.Len(t, pushed[2].Resolved, 1)
+	assert.Equal(t, nodeAlert.ID, pushed[2].Resolved[0].ID)
+	assert.Empty(t, pushed[3].Alerts)
+	require.Len(t, pushed[3].Resolved, 1)
+	assert.Equal(t, process.AvailabilityClientID, pushed[3].Resolved[0].ClientID)
+	assert.Empty(t, inhibitor.InhibitedAlerts())
 }
@@ -0,0 +1,6 @@
+package process
+
+// HandleEvents -
+func (ep *eventsProcessor) HandleEvents() {
+	ep.handleEvents()
+}
@@ -1,6 +1,10 @@
 package process
 
-import "github.com/multiversx/mx-chain-node-monitoring/data"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
 
 // Notifier defines the behaviour of a notifier instance
 type Notifier interface {
@@ -8,6 +12,12 @@ type Notifier interface {
 	GetID() string
 }
 
+// ResolveNotifier defines the behaviour of a notifier which is able to close the incidents of the resolved
+// alerts. Only these notifiers receive the messages which carry resolved alerts without a warning
+type ResolveNotifier interface {
+	NotifiesResolved() bool
+}
+
 // Connector defines the behaviour of a client connector which will fetch the event
 type Connector interface {
 	GetEvent() (data.NotificationMessage, error)
@@ -20,3 +30,39 @@ type Pusher interface {
 	PushMessage(msg data.NotificationMessage)
 	IsInterfaceNil() bool
 }
+
+// AlertsHandler defines the behaviour of a component which keeps track of the alerts raised by the clients
+// and decides which of them are to be notified
+type AlertsHandler interface {
+	ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage
+	IsInterfaceNil() bool
+}
+
+// AlertsMuter defines the behaviour of a component able to tell whether the notifications of an alert are muted,
+// as the ones of the acknowledged alerts and of the silenced nodes are
+type AlertsMuter interface {
+	IsMuted(alert data.Alert) bool
+	IsInterfaceNil() bool
+}
+
+// FiringAlertsProvider defines the behaviour of a component able to provide the alerts of a client which are
+// still firing
+type FiringAlertsProvider interface {
+	FiringAlerts(clientID string) []data.Alert
+	IsInterfaceNil() bool
+}
+
+// EventsRecorder defines the behaviour of a component which keeps the history of the processed events,
+// to be used by the reports
+type EventsRecorder interface {
+	RecordEvent(clientID string, event data.NotificationMessage)
+	IsInterfaceNil() bool
+}
+
+// MetricsHandler defines the behaviour of a component able to export the internal metrics
+type MetricsHandler interface {
+	ObserveCycleDuration(duration time.Duration)
+	IncrementClientErrors(clientID string)
+	IncrementNotifierResult(notifierID string, success bool)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
+// AlertsHandlerStub implements process.AlertsHandler
+type AlertsHandlerStub struct {
+	ProcessEventCalled func(clientID string, event data.NotificationMessage) data.NotificationMessage
+}
+
+// ProcessEvent -
+func (ahs *AlertsHandlerStub) ProcessEvent(clientID string, event data.NotificationMessage) data.NotificationMessage {
+	if ahs.ProcessEventCalled != nil {
+		return ahs.ProcessEventCalled(clientID, event)
+	}
+
+	return event
+}
+
+// IsInterfaceNil -
+func (ahs *AlertsHandlerStub) IsInterfaceNil() bool {
+	return ahs == nil
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
+// AlertsMuterStub implements process.AlertsMuter interface
+type AlertsMuterStub struct {
+	IsMutedCalled func(alert data.Alert) bool
+}
+
+// IsMuted -
+func (ams *AlertsMuterStub) IsMuted(alert data.Alert) bool {
+	if ams.IsMutedCalled != nil {
+		return ams.IsMutedCalled(alert)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (ams *AlertsMuterStub) IsInterfaceNil() bool {
+	return ams == nil
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
+// EventsRecorderStub implements process.EventsRecorder
+type EventsRecorderStub struct {
+	RecordEventCalled func(clientID string, event data.NotificationMessage)
+}
+
+// RecordEvent -
+func (ers *EventsRecorderStub) RecordEvent(clientID string, event data.NotificationMessage) {
+	if ers.RecordEventCalled != nil {
+		ers.RecordEventCalled(clientID, event)
+	}
+}
+
+// IsInterfaceNil -
+func (ers *EventsRecorderStub) IsInterfaceNil() bool {
+	return ers == nil
+}
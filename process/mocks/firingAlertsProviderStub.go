@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-node-monitoring/data"
+
+// FiringAlertsProviderStub implements process.FiringAlertsProvider interface
+type FiringAlertsProviderStub struct {
+	FiringAlertsCalled func(clientID string) []data.Alert
+}
+
+// FiringAlerts -
+func (faps *FiringAlertsProviderStub) FiringAlerts(clientID string) []data.Alert {
+	if faps.FiringAlertsCalled != nil {
+		return faps.FiringAlertsCalled(clientID)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (faps *FiringAlertsProviderStub) IsInterfaceNil() bool {
+	return faps == nil
+}
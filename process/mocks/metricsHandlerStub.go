@@ -0,0 +1,36 @@
+package mocks
+
+import "time"
+
+// MetricsHandlerStub implements process.MetricsHandler interface
+type MetricsHandlerStub struct {
+	ObserveCycleDurationCalled    func(duration time.Duration)
+	IncrementClientErrorsCalled   func(clientID string)
+	IncrementNotifierResultCalled func(notifierID string, success bool)
+}
+
+// ObserveCycleDuration -
+func (mhs *MetricsHandlerStub) ObserveCycleDuration(duration time.Duration) {
+	if mhs.ObserveCycleDurationCalled != nil {
+		mhs.ObserveCycleDurationCalled(duration)
+	}
+}
+
+// IncrementClientErrors -
+func (mhs *MetricsHandlerStub) IncrementClientErrors(clientID string) {
+	if mhs.IncrementClientErrorsCalled != nil {
+		mhs.IncrementClientErrorsCalled(clientID)
+	}
+}
+
+// IncrementNotifierResult -
+func (mhs *MetricsHandlerStub) IncrementNotifierResult(notifierID string, success bool) {
+	if mhs.IncrementNotifierResultCalled != nil {
+		mhs.IncrementNotifierResultCalled(notifierID, success)
+	}
+}
+
+// IsInterfaceNil -
+func (mhs *MetricsHandlerStub) IsInterfaceNil() bool {
+	return mhs == nil
+}
@@ -0,0 +1,16 @@
+package mocks
+
+// ResolveNotifierStub implements process.Notifier and process.ResolveNotifier interfaces
+type ResolveNotifierStub struct {
+	NotifierStub
+	NotifiesResolvedCalled func() bool
+}
+
+// NotifiesResolved -
+func (rns *ResolveNotifierStub) NotifiesResolved() bool {
+	if rns.NotifiesResolvedCalled != nil {
+		return rns.NotifiesResolvedCalled()
+	}
+
+	return true
+}
@@ -1,21 +1,42 @@
 package process
 
 import (
+	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
 	"github.com/multiversx/mx-chain-node-monitoring/data"
 )
 
+// ArgsNotifyProcessor defines the arguments needed for notify processor creation
+type ArgsNotifyProcessor struct {
+	MetricsHandler MetricsHandler
+	AlertsMuter    AlertsMuter
+}
+
 type notifyProcessor struct {
-	workers    map[string]Notifier
-	mutWorkers sync.RWMutex
+	workers        map[string]Notifier
+	mutWorkers     sync.RWMutex
+	metricsHandler MetricsHandler
+	alertsMuter    AlertsMuter
 }
 
 // NewNotifyProcessor will create a new notify processor instance
-func NewNotifyProcessor() *notifyProcessor {
-	return &notifyProcessor{
-		workers: make(map[string]Notifier),
+func NewNotifyProcessor(args ArgsNotifyProcessor) (*notifyProcessor, error) {
+	if check.IfNil(args.MetricsHandler) {
+		return nil, ErrNilMetricsHandler
 	}
+	if check.IfNil(args.AlertsMuter) {
+		return nil, ErrNilAlertsMuter
+	}
+
+	return &notifyProcessor{
+		workers:        make(map[string]Notifier),
+		metricsHandler: args.MetricsHandler,
+		alertsMuter:    args.AlertsMuter,
+	}, nil
 }
 
 // AddNotifier will add a notifier instance to workers list
@@ -25,15 +46,125 @@ func (np *notifyProcessor) AddNotifier(notifier Notifier) {
 	np.mutWorkers.Unlock()
 }
 
-// PushMessage will push notification message to all registered workers
+// RemoveNotifier will remove the notifier instance with the provided id from workers list
+func (np *notifyProcessor) RemoveNotifier(id string) {
+	np.mutWorkers.Lock()
+	delete(np.workers, id)
+	np.mutWorkers.Unlock()
+}
+
+// PushMessage will push notification message to all registered workers. Only the changed alerts which are not muted
+// and the resolved alerts which are not one-shot are delivered; the message is dropped if none of its alerts is left.
+// The messages which only carry resolved alerts are pushed only to the workers able to notify the resolved alerts
 func (np *notifyProcessor) PushMessage(msg data.NotificationMessage) {
+	msg, ok := np.filterMessage(msg)
+	if !ok {
+		return
+	}
+
+	onlyResolved := len(msg.Resolved) > 0 && msg.Level < common.WarningEvent
+
 	np.mutWorkers.RLock()
 	for _, worker := range np.workers {
-		go worker.PushMessage(msg)
+		if onlyResolved && !notifiesResolved(worker) {
+			continue
+		}
+
+		go np.pushMessage(worker, msg)
 	}
 	np.mutWorkers.RUnlock()
 }
 
+// filterMessage removes the alerts which are not to be delivered: the unchanged ones, which are still firing but
+// have already been notified, the muted ones, together with their lines, and the resolves of the one-shot alerts,
+// which would close the incidents they opened. It returns false if the message carried alerts and none of them is left
+func (np *notifyProcessor) filterMessage(msg data.NotificationMessage) (data.NotificationMessage, bool) {
+	if len(msg.Alerts) == 0 && len(msg.Resolved) == 0 {
+		return msg, true
+	}
+
+	resolved := make([]data.Alert, 0, len(msg.Resolved))
+	for _, alert := range msg.Resolved {
+		if alert.OneShot {
+			continue
+		}
+
+		resolved = append(resolved, alert)
+	}
+
+	alerts := make([]data.Alert, 0, len(msg.Alerts))
+	mutedLines := make([]string, 0)
+	for _, alert := range msg.Alerts {
+		if !alert.Changed {
+			continue
+		}
+		if np.alertsMuter.IsMuted(alert) {
+			log.Debug("alert muted", "alert", alert.ID, "node", alert.DisplayName())
+			mutedLines = append(mutedLines, fmt.Sprintf("[%s] ", alert.ID))
+			continue
+		}
+
+		alerts = append(alerts, alert)
+	}
+	if len(alerts) == 0 && len(resolved) == 0 {
+		return data.NotificationMessage{}, false
+	}
+
+	filtered := msg
+	filtered.Alerts = alerts
+	if len(msg.Resolved) > 0 {
+		filtered.Resolved = resolved
+	}
+	if len(mutedLines) == 0 {
+		return filtered, true
+	}
+
+	filtered.Message = removeLines(msg.Message, mutedLines)
+	filtered.Level = common.InfoEvent
+	for _, alert := range alerts {
+		if alert.Level > filtered.Level {
+			filtered.Level = alert.Level
+		}
+	}
+
+	return filtered, true
+}
+
+// removeLines removes the lines of the message starting with any of the provided prefixes
+func removeLines(message string, prefixes []string) string {
+	lines := strings.SplitAfter(message, "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		muted := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(line, prefix) {
+				muted = true
+				break
+			}
+		}
+		if !muted {
+			kept = append(kept, line)
+		}
+	}
+
+	return strings.Join(kept, "")
+}
+
+func notifiesResolved(worker Notifier) bool {
+	resolveNotifier, ok := worker.(ResolveNotifier)
+
+	return ok && resolveNotifier.NotifiesResolved()
+}
+
+func (np *notifyProcessor) pushMessage(worker Notifier, msg data.NotificationMessage) {
+	err := worker.PushMessage(msg)
+	if err != nil {
+		log.Error("failed to push notification", "notifier", worker.GetID(), "error", err.Error())
+	}
+
+	np.metricsHandler.IncrementNotifierResult(worker.GetID(), err == nil)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (np *notifyProcessor) IsInterfaceNil() bool {
 	return np == nil
@@ -1,10 +1,13 @@
 package process_test
 
 import (
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-node-monitoring/common"
 	"github.com/multiversx/mx-chain-node-monitoring/data"
 	"github.com/multiversx/mx-chain-node-monitoring/process"
 	"github.com/multiversx/mx-chain-node-monitoring/process/mocks"
@@ -12,10 +15,62 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewNotifyProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+			AlertsMuter: &mocks.AlertsMuterStub{},
+		})
+		require.Nil(t, np)
+		assert.Equal(t, process.ErrNilMetricsHandler, err)
+	})
+
+	t.Run("nil alerts muter", func(t *testing.T) {
+		t.Parallel()
+
+		np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+			MetricsHandler: &mocks.MetricsHandlerStub{},
+		})
+		require.Nil(t, np)
+		assert.Equal(t, process.ErrNilAlertsMuter, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+			MetricsHandler: &mocks.MetricsHandlerStub{},
+			AlertsMuter:    &mocks.AlertsMuterStub{},
+		})
+		require.Nil(t, err)
+		assert.NotNil(t, np)
+	})
+}
+
 func TestNotifyProcessor(t *testing.T) {
 	t.Parallel()
 
-	np := process.NewNotifyProcessor()
+	wg := sync.WaitGroup{}
+
+	results := make(map[string]bool)
+	mutResults := sync.Mutex{}
+	metricsHandler := &mocks.MetricsHandlerStub{
+		IncrementNotifierResultCalled: func(notifierID string, success bool) {
+			mutResults.Lock()
+			results[notifierID] = success
+			mutResults.Unlock()
+			wg.Done()
+		},
+	}
+
+	np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+		MetricsHandler: metricsHandler,
+		AlertsMuter:    &mocks.AlertsMuterStub{},
+	})
+	require.Nil(t, err)
 	require.False(t, np.IsInterfaceNil())
 
 	notification := data.NotificationMessage{
@@ -23,14 +78,11 @@ func TestNotifyProcessor(t *testing.T) {
 		Level:   1,
 	}
 
-	wg := sync.WaitGroup{}
-
 	numCalls := uint32(0)
 	notifier := &mocks.NotifierStub{
 		PushMessageCalled: func(msg data.NotificationMessage) error {
 			assert.Equal(t, notification, msg)
 			atomic.AddUint32(&numCalls, 1)
-			wg.Done()
 			return nil
 		},
 		GetIDCalled: func() string {
@@ -38,13 +90,176 @@ func TestNotifyProcessor(t *testing.T) {
 		},
 	}
 
+	failingNotifier := &mocks.NotifierStub{
+		PushMessageCalled: func(msg data.NotificationMessage) error {
+			return errors.New("push error")
+		},
+		GetIDCalled: func() string {
+			return "failingID"
+		},
+	}
+
 	np.AddNotifier(notifier)
+	np.AddNotifier(failingNotifier)
 
-	wg.Add(1)
+	wg.Add(2)
 
 	np.PushMessage(notification)
 
 	wg.Wait()
 
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	assert.Equal(t, map[string]bool{"ID": true, "failingID": false}, results)
+}
+
+func TestNotifyProcessor_RemoveNotifier(t *testing.T) {
+	t.Parallel()
+
+	np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+		MetricsHandler: &mocks.MetricsHandlerStub{},
+		AlertsMuter:    &mocks.AlertsMuterStub{},
+	})
+	require.Nil(t, err)
+
+	notifier := &mocks.NotifierStub{
+		PushMessageCalled: func(msg data.NotificationMessage) error {
+			assert.Fail(t, "should not push to a removed notifier")
+			return nil
+		},
+	}
+
+	np.AddNotifier(notifier)
+	np.RemoveNotifier(notifier.GetID())
+
+	np.PushMessage(data.NotificationMessage{})
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestNotifyProcessor_ResolvedAlerts(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+		MetricsHandler: &mocks.MetricsHandlerStub{
+			IncrementNotifierResultCalled: func(notifierID string, success bool) {
+				wg.Done()
+			},
+		},
+		AlertsMuter: &mocks.AlertsMuterStub{},
+	})
+	require.Nil(t, err)
+
+	mutPushed := sync.Mutex{}
+	pushed := make(map[string]int)
+	createPushHandler := func(id string) func(msg data.NotificationMessage) error {
+		return func(msg data.NotificationMessage) error {
+			mutPushed.Lock()
+			pushed[id]++
+			mutPushed.Unlock()
+			return nil
+		}
+	}
+
+	np.AddNotifier(&mocks.NotifierStub{
+		PushMessageCalled: createPushHandler("plain"),
+		GetIDCalled: func() string {
+			return "plain"
+		},
+	})
+	np.AddNotifier(&mocks.ResolveNotifierStub{
+		NotifierStub: mocks.NotifierStub{
+			PushMessageCalled: createPushHandler("resolve"),
+			GetIDCalled: func() string {
+				return "resolve"
+			},
+		},
+	})
+
+	resolved := []data.Alert{{ID: "id"}}
+
+	wg.Add(1)
+	np.PushMessage(data.NotificationMessage{Level: common.InfoEvent, Resolved: resolved})
+	wg.Wait()
+
+	wg.Add(2)
+	np.PushMessage(data.NotificationMessage{Level: common.WarningEvent, Message: "message", Resolved: resolved})
+	wg.Wait()
+
+	assert.Equal(t, map[string]int{"plain": 1, "resolve": 2}, pushed)
+}
+
+func TestNotifyProcessor_MutedAlerts(t *testing.T) {
+	t.Parallel()
+
+	pushed := make(chan data.NotificationMessage, 10)
+	np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+		MetricsHandler: &mocks.MetricsHandlerStub{},
+		AlertsMuter: &mocks.AlertsMuterStub{
+			IsMutedCalled: func(alert data.Alert) bool {
+				return alert.ID == "muted"
+			},
+		},
+	})
+	require.Nil(t, err)
+	np.AddNotifier(&mocks.NotifierStub{
+		PushMessageCalled: func(msg data.NotificationMessage) error {
+			pushed <- msg
+			return nil
+		},
+	})
+
+	notified := data.Alert{ID: "notified", Level: common.WarningEvent, Message: "notified message", Changed: true}
+	muted := data.Alert{ID: "muted", Level: common.CriticalEvent, Message: "muted message", Changed: true}
+	unchanged := data.Alert{ID: "unchanged", Level: common.CriticalEvent, Message: "unchanged message"}
+
+	// only the changed alerts which are not muted are delivered
+	np.PushMessage(data.NotificationMessage{
+		Level:   common.CriticalEvent,
+		Message: "[muted] muted message\n[notified] notified message\n",
+		Alerts:  []data.Alert{muted, notified, unchanged},
+	})
+	msg := <-pushed
+	assert.Equal(t, common.WarningEvent, msg.Level)
+	assert.Equal(t, "[notified] notified message\n", msg.Message)
+	assert.Equal(t, []data.Alert{notified}, msg.Alerts)
+
+	// nothing left to deliver
+	np.PushMessage(data.NotificationMessage{
+		Level:   common.CriticalEvent,
+		Message: "[muted] muted message\n",
+		Alerts:  []data.Alert{muted, unchanged},
+	})
+	time.Sleep(time.Millisecond * 100)
+	assert.Empty(t, pushed)
+}
+
+func TestNotifyProcessor_OneShotResolvedAlerts(t *testing.T) {
+	t.Parallel()
+
+	pushed := make(chan data.NotificationMessage, 10)
+	np, err := process.NewNotifyProcessor(process.ArgsNotifyProcessor{
+		MetricsHandler: &mocks.MetricsHandlerStub{},
+		AlertsMuter:    &mocks.AlertsMuterStub{},
+	})
+	require.Nil(t, err)
+	np.AddNotifier(&mocks.ResolveNotifierStub{
+		NotifierStub: mocks.NotifierStub{
+			PushMessageCalled: func(msg data.NotificationMessage) error {
+				pushed <- msg
+				return nil
+			},
+		},
+	})
+
+	oneShot := data.Alert{ID: "one-shot", OneShot: true}
+	resolved := data.Alert{ID: "resolved"}
+
+	// the resolve of a one-shot alert is not delivered
+	np.PushMessage(data.NotificationMessage{Level: common.InfoEvent, Resolved: []data.Alert{oneShot, resolved}})
+	msg := <-pushed
+	assert.Equal(t, []data.Alert{resolved}, msg.Resolved)
+
+	np.PushMessage(data.NotificationMessage{Level: common.InfoEvent, Resolved: []data.Alert{oneShot}})
+	time.Sleep(time.Millisecond * 100)
+	assert.Empty(t, pushed)
 }
@@ -0,0 +1,245 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+const (
+	frequencyDaily  = "daily"
+	frequencyWeekly = "weekly"
+
+	periodTimeFormat = "02 Jan 2006 15:04"
+)
+
+// ArgsDigestScheduler defines the arguments needed to create a new digest scheduler
+type ArgsDigestScheduler struct {
+	Config         *config.Digest
+	PeriodProvider PeriodProvider
+	Sender         ReportSender
+}
+
+type digestScheduler struct {
+	frequency      string
+	weekday        time.Weekday
+	hour           int
+	minute         int
+	location       *time.Location
+	notifiers      []string
+	periodProvider PeriodProvider
+	sender         ReportSender
+	getTimeHandler func() time.Time
+	cancelFunc     func()
+}
+
+// NewDigestScheduler will create a component which sends the summary of the monitored nodes, daily or weekly,
+// at the configured local time
+func NewDigestScheduler(args ArgsDigestScheduler) (*digestScheduler, error) {
+	err := checkDigestArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := args.Config
+	weekday := time.Monday
+	if cfg.Frequency == frequencyWeekly {
+		weekday, err = common.ParseWeekday(cfg.Weekday)
+		if err != nil {
+			return nil, err
+		}
+	}
+	hour, minute, err := common.ParseTimeOfDay(cfg.Time)
+	if err != nil {
+		return nil, err
+	}
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", common.ErrInvalidValue, cfg.Timezone)
+	}
+
+	return &digestScheduler{
+		frequency:      cfg.Frequency,
+		weekday:        weekday,
+		hour:           hour,
+		minute:         minute,
+		location:       location,
+		notifiers:      cfg.Notifiers,
+		periodProvider: args.PeriodProvider,
+		sender:         args.Sender,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+func checkDigestArgs(args ArgsDigestScheduler) error {
+	if args.Config == nil {
+		return ErrNilConfig
+	}
+	if check.IfNil(args.PeriodProvider) {
+		return ErrNilPeriodProvider
+	}
+	if check.IfNil(args.Sender) {
+		return ErrNilReportSender
+	}
+	if args.Config.Frequency != frequencyDaily && args.Config.Frequency != frequencyWeekly {
+		return fmt.Errorf("%w: digest frequency should be daily or weekly, provided %q", common.ErrInvalidValue, args.Config.Frequency)
+	}
+	if len(args.Config.Notifiers) == 0 {
+		return ErrNoNotifiers
+	}
+
+	return nil
+}
+
+// Start will send the digest at the scheduled times, on a separate go routine
+func (ds *digestScheduler) Start() {
+	var ctx context.Context
+	ctx, ds.cancelFunc = context.WithCancel(context.Background())
+
+	go ds.run(ctx)
+}
+
+func (ds *digestScheduler) run(ctx context.Context) {
+	for {
+		next := ds.nextRunTime(ds.getTimeHandler())
+		log.Info("next digest scheduled", "frequency", ds.frequency, "at", next.Format(time.RFC1123Z))
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Info("digest scheduler is stopping...")
+			return
+		case <-timer.C:
+			ds.sendDigest(ds.getTimeHandler())
+		}
+	}
+}
+
+// nextRunTime returns the first scheduled time after the provided one, in the configured timezone
+func (ds *digestScheduler) nextRunTime(now time.Time) time.Time {
+	localNow := now.In(ds.location)
+	next := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), ds.hour, ds.minute, 0, 0, ds.location)
+
+	daysStep := 1
+	if ds.frequency == frequencyWeekly {
+		daysStep = 7
+		daysUntilWeekday := (int(ds.weekday) - int(next.Weekday()) + 7) % 7
+		next = time.Date(next.Year(), next.Month(), next.Day()+daysUntilWeekday, ds.hour, ds.minute, 0, 0, ds.location)
+	}
+	if !next.After(now) {
+		next = time.Date(next.Year(), next.Month(), next.Day()+daysStep, ds.hour, ds.minute, 0, 0, ds.location)
+	}
+
+	return next
+}
+
+func (ds *digestScheduler) sendDigest(now time.Time) {
+	period := ds.periodProvider.TakePeriod(now)
+	msg := data.NotificationMessage{
+		Level:   common.InfoEvent,
+		Message: ds.createDigestMessage(period),
+	}
+
+	err := ds.sender.SendReport(ds.notifiers, msg)
+	if err != nil {
+		log.Error("failed to send the digest", "error", err.Error())
+		return
+	}
+
+	log.Info("digest sent", "notifiers", strings.Join(ds.notifiers, ", "), "num nodes", len(period.Nodes))
+}
+
+func (ds *digestScheduler) createDigestMessage(period Period) string {
+	title := "Daily digest"
+	if ds.frequency == frequencyWeekly {
+		title = "Weekly digest"
+	}
+
+	lines := []string{fmt.Sprintf("%s, %s - %s (%s)",
+		title,
+		period.Start.In(ds.location).Format(periodTimeFormat),
+		period.End.In(ds.location).Format(periodTimeFormat),
+		ds.location.String(),
+	)}
+
+	nodes := make([]*NodeStats, 0, len(period.Nodes))
+	for _, stats := range period.Nodes {
+		nodes = append(nodes, stats)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return strings.ToLower(nodes[i].Name) < strings.ToLower(nodes[j].Name)
+	})
+
+	if len(nodes) == 0 {
+		lines = append(lines, "No samples collected in this period")
+	}
+	for _, stats := range nodes {
+		lines = append(lines, formatNodeStats(stats))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatNodeStats(stats *NodeStats) string {
+	state := "offline"
+	if stats.Online {
+		state = "online"
+	}
+	if stats.Status != "" {
+		state += ", " + stats.Status
+	}
+
+	return fmt.Sprintf("%s (shard %d, %s): rating %d → %d, temp rating %.2f → %.2f (min %.2f, max %.2f), %s, offline %s, %s, now %s",
+		stats.Name,
+		stats.Shard,
+		formatVersion(stats.Version),
+		stats.StartRating,
+		stats.EndRating,
+		stats.StartTempRating,
+		stats.EndTempRating,
+		stats.MinTempRating,
+		stats.MaxTempRating,
+		plural(stats.Alerts, "alert"),
+		stats.Offline.Round(time.Second).String(),
+		plural(stats.StatusChanges, "status change"),
+		state,
+	)
+}
+
+func formatVersion(version string) string {
+	if version == "" {
+		return "unknown version"
+	}
+
+	return version
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
+}
+
+// Close will stop the scheduler
+func (ds *digestScheduler) Close() error {
+	if ds.cancelFunc != nil {
+		ds.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ds *digestScheduler) IsInterfaceNil() bool {
+	return ds == nil
+}
@@ -0,0 +1,234 @@
+package reports_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/reports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// periodProviderStub implements reports.PeriodProvider
+type periodProviderStub struct {
+	takePeriodCalled func(end time.Time) reports.Period
+}
+
+func (pps *periodProviderStub) TakePeriod(end time.Time) reports.Period {
+	return pps.takePeriodCalled(end)
+}
+
+func (pps *periodProviderStub) IsInterfaceNil() bool {
+	return pps == nil
+}
+
+func createMockDigestSchedulerArgs() reports.ArgsDigestScheduler {
+	return reports.ArgsDigestScheduler{
+		Config: &config.Digest{
+			Enabled:   true,
+			Frequency: "daily",
+			Time:      "09:00",
+			Timezone:  "Europe/Bucharest",
+			Notifiers: []string{"Slack", "Email"},
+		},
+		PeriodProvider: &periodProviderStub{},
+		Sender:         &mocks.ReportSenderStub{},
+	}
+}
+
+func TestNewDigestScheduler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDigestSchedulerArgs()
+		args.Config = nil
+
+		ds, err := reports.NewDigestScheduler(args)
+		assert.Nil(t, ds)
+		assert.Equal(t, reports.ErrNilConfig, err)
+	})
+
+	t.Run("nil period provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDigestSchedulerArgs()
+		args.PeriodProvider = nil
+
+		ds, err := reports.NewDigestScheduler(args)
+		assert.Nil(t, ds)
+		assert.Equal(t, reports.ErrNilPeriodProvider, err)
+	})
+
+	t.Run("nil sender", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDigestSchedulerArgs()
+		args.Sender = nil
+
+		ds, err := reports.NewDigestScheduler(args)
+		assert.Nil(t, ds)
+		assert.Equal(t, reports.ErrNilReportSender, err)
+	})
+
+	t.Run("no notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDigestSchedulerArgs()
+		args.Config.Notifiers = nil
+
+		ds, err := reports.NewDigestScheduler(args)
+		assert.Nil(t, ds)
+		assert.Equal(t, reports.ErrNoNotifiers, err)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		invalidConfigs := []func(cfg *config.Digest){
+			func(cfg *config.Digest) { cfg.Frequency = "hourly" },
+			func(cfg *config.Digest) { cfg.Frequency, cfg.Weekday = "weekly", "someday" },
+			func(cfg *config.Digest) { cfg.Time = "9" },
+			func(cfg *config.Digest) { cfg.Timezone = "Europe/Unknown" },
+		}
+		for _, setInvalidValue := range invalidConfigs {
+			args := createMockDigestSchedulerArgs()
+			setInvalidValue(args.Config)
+
+			ds, err := reports.NewDigestScheduler(args)
+			assert.Nil(t, ds)
+			assert.True(t, errors.Is(err, common.ErrInvalidValue))
+		}
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ds, err := reports.NewDigestScheduler(createMockDigestSchedulerArgs())
+		require.Nil(t, err)
+		assert.False(t, ds.IsInterfaceNil())
+
+		ds.Start()
+		assert.Nil(t, ds.Close())
+	})
+}
+
+func TestDigestScheduler_NextRunTime(t *testing.T) {
+	t.Parallel()
+
+	location, err := time.LoadLocation("Europe/Bucharest")
+	require.Nil(t, err)
+
+	t.Run("daily", func(t *testing.T) {
+		t.Parallel()
+
+		ds, _ := reports.NewDigestScheduler(createMockDigestSchedulerArgs())
+
+		// 06:59 UTC is 08:59 in Bucharest (UTC+2)
+		next := ds.NextRunTime(time.Date(2024, 3, 1, 6, 59, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, 3, 1, 9, 0, 0, 0, location), next)
+
+		next = ds.NextRunTime(time.Date(2024, 3, 1, 7, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, 3, 2, 9, 0, 0, 0, location), next)
+
+		// daylight saving time starts on 31 Mar 2024, the digest is kept at 09:00 local time
+		next = ds.NextRunTime(time.Date(2024, 3, 31, 6, 30, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, 4, 1, 9, 0, 0, 0, location), next)
+		assert.Equal(t, time.Date(2024, 4, 1, 6, 0, 0, 0, time.UTC), next.UTC())
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockDigestSchedulerArgs()
+		args.Config.Frequency = "weekly"
+		args.Config.Weekday = "monday"
+		ds, _ := reports.NewDigestScheduler(args)
+
+		// 1 Mar 2024 is a friday
+		next := ds.NextRunTime(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, 3, 4, 9, 0, 0, 0, location), next)
+
+		next = ds.NextRunTime(time.Date(2024, 3, 4, 6, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, 3, 4, 9, 0, 0, 0, location), next)
+
+		next = ds.NextRunTime(time.Date(2024, 3, 4, 7, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, 3, 11, 9, 0, 0, 0, location), next)
+	})
+}
+
+func TestDigestScheduler_SendDigest(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 1, 7, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	t.Run("summary of every node", func(t *testing.T) {
+		t.Parallel()
+
+		var sentNotifiers []string
+		var sentMessage data.NotificationMessage
+		args := createMockDigestSchedulerArgs()
+		args.PeriodProvider = &periodProviderStub{
+			takePeriodCalled: func(periodEnd time.Time) reports.Period {
+				assert.Equal(t, end, periodEnd)
+				return reports.Period{
+					Start: start,
+					End:   periodEnd,
+					Nodes: map[string]*reports.NodeStats{
+						"key2": {Name: "node-b", Shard: 0, Version: "v1.6.1", StartRating: 100, EndRating: 100, StartTempRating: 100, EndTempRating: 100, MinTempRating: 100, MaxTempRating: 100, Online: true, Status: "eligible"},
+						"key1": {Name: "Node-A", Shard: 1, StartRating: 100, EndRating: 98, StartTempRating: 99, EndTempRating: 94.5, MinTempRating: 90, MaxTempRating: 99, Alerts: 1, Offline: 90 * time.Second, StatusChanges: 2},
+					},
+				}
+			},
+		}
+		args.Sender = &mocks.ReportSenderStub{
+			SendReportCalled: func(notifierNames []string, msg data.NotificationMessage) error {
+				sentNotifiers = notifierNames
+				sentMessage = msg
+				return nil
+			},
+		}
+		ds, _ := reports.NewDigestScheduler(args)
+
+		ds.SendDigest(end)
+		assert.Equal(t, []string{"Slack", "Email"}, sentNotifiers)
+		assert.Equal(t, common.InfoEvent, sentMessage.Level)
+		assert.Empty(t, sentMessage.Alerts)
+
+		expectedMessage := "Daily digest, 01 Mar 2024 09:00 - 02 Mar 2024 09:00 (Europe/Bucharest)\n" +
+			"Node-A (shard 1, unknown version): rating 100 → 98, temp rating 99.00 → 94.50 (min 90.00, max 99.00), 1 alert, offline 1m30s, 2 status changes, now offline\n" +
+			"node-b (shard 0, v1.6.1): rating 100 → 100, temp rating 100.00 → 100.00 (min 100.00, max 100.00), 0 alerts, offline 0s, 0 status changes, now online, eligible"
+		assert.Equal(t, expectedMessage, sentMessage.Message)
+	})
+
+	t.Run("empty period", func(t *testing.T) {
+		t.Parallel()
+
+		var sentMessage data.NotificationMessage
+		args := createMockDigestSchedulerArgs()
+		args.Config.Frequency = "weekly"
+		args.Config.Weekday = "friday"
+		args.PeriodProvider = &periodProviderStub{
+			takePeriodCalled: func(periodEnd time.Time) reports.Period {
+				return reports.Period{Start: start, End: periodEnd}
+			},
+		}
+		args.Sender = &mocks.ReportSenderStub{
+			SendReportCalled: func(notifierNames []string, msg data.NotificationMessage) error {
+				sentMessage = msg
+				return errors.New("expected error")
+			},
+		}
+		ds, _ := reports.NewDigestScheduler(args)
+
+		ds.SendDigest(end)
+		assert.Equal(t, "Weekly digest, 01 Mar 2024 09:00 - 02 Mar 2024 09:00 (Europe/Bucharest)\nNo samples collected in this period", sentMessage.Message)
+	})
+}
@@ -0,0 +1,461 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+)
+
+const (
+	epochStorerKey = "EpochReport"
+	flaggedMarker  = "⚠️"
+)
+
+// EpochCounters holds the consensus counters of a node, as returned by api
+type EpochCounters struct {
+	ValidatorSuccess           int
+	ValidatorFailure           int
+	ValidatorIgnoredSignatures int
+	LeaderSuccess              int
+	LeaderFailure              int
+}
+
+func newEpochCounters(node clients.APINode) EpochCounters {
+	return EpochCounters{
+		ValidatorSuccess:           node.ValidatorSuccess,
+		ValidatorFailure:           node.ValidatorFailure,
+		ValidatorIgnoredSignatures: node.ValidatorIgnoredSignatures,
+		LeaderSuccess:              node.LeaderSuccess,
+		LeaderFailure:              node.LeaderFailure,
+	}
+}
+
+func (ec EpochCounters) sub(other EpochCounters) EpochCounters {
+	return EpochCounters{
+		ValidatorSuccess:           ec.ValidatorSuccess - other.ValidatorSuccess,
+		ValidatorFailure:           ec.ValidatorFailure - other.ValidatorFailure,
+		ValidatorIgnoredSignatures: ec.ValidatorIgnoredSignatures - other.ValidatorIgnoredSignatures,
+		LeaderSuccess:              ec.LeaderSuccess - other.LeaderSuccess,
+		LeaderFailure:              ec.LeaderFailure - other.LeaderFailure,
+	}
+}
+
+// isBelow returns true if any of the counters is lower than the other one's, which means they have been reset
+func (ec EpochCounters) isBelow(other EpochCounters) bool {
+	return ec.ValidatorSuccess < other.ValidatorSuccess ||
+		ec.ValidatorFailure < other.ValidatorFailure ||
+		ec.ValidatorIgnoredSignatures < other.ValidatorIgnoredSignatures ||
+		ec.LeaderSuccess < other.LeaderSuccess ||
+		ec.LeaderFailure < other.LeaderFailure
+}
+
+// EpochNodeState holds the values of a node at the start of the current epoch and at the last check
+type EpochNodeState struct {
+	StartRating     int
+	StartTempRating float64
+	Start           EpochCounters
+	Last            EpochCounters
+}
+
+// EpochState holds the epoch being tracked and the state of its nodes
+type EpochState struct {
+	Epoch uint32
+	Nodes map[string]*EpochNodeState
+}
+
+// ArgsEpochReporter defines the arguments needed to create a new epoch reporter
+type ArgsEpochReporter struct {
+	Config       *config.EpochReport
+	PubKeys      []string
+	Labels       []config.NodeLabels
+	StatsFetcher StatsFetcher
+	NodesFetcher NodesFetcher
+	Storer       clients.Storer
+	Sender       ReportSender
+}
+
+type epochReporter struct {
+	checkInterval   time.Duration
+	minSuccessRatio float64
+	notifiers       []string
+	pubKeys         []string
+	aliases         map[string]string
+	statsFetcher    StatsFetcher
+	nodesFetcher    NodesFetcher
+	storer          clients.Storer
+	sender          ReportSender
+	state           EpochState
+	cancelFunc      func()
+}
+
+// NewEpochReporter will create a component which watches the network epoch and, when it changes, sends the
+// performance of the monitored nodes during the epoch which just ended
+func NewEpochReporter(args ArgsEpochReporter) (*epochReporter, error) {
+	err := checkEpochArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[string]string)
+	for _, labels := range args.Labels {
+		aliases[labels.PubKey] = labels.Alias
+	}
+
+	er := &epochReporter{
+		checkInterval:   time.Duration(args.Config.CheckIntervalSec) * time.Second,
+		minSuccessRatio: args.Config.MinSuccessRatio,
+		notifiers:       args.Config.Notifiers,
+		pubKeys:         args.PubKeys,
+		aliases:         aliases,
+		statsFetcher:    args.StatsFetcher,
+		nodesFetcher:    args.NodesFetcher,
+		storer:          args.Storer,
+		sender:          args.Sender,
+	}
+	er.load()
+
+	return er, nil
+}
+
+func checkEpochArgs(args ArgsEpochReporter) error {
+	if args.Config == nil {
+		return ErrNilConfig
+	}
+	if check.IfNil(args.StatsFetcher) {
+		return ErrNilStatsFetcher
+	}
+	if check.IfNil(args.NodesFetcher) {
+		return ErrNilNodesFetcher
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Sender) {
+		return ErrNilReportSender
+	}
+	if args.Config.CheckIntervalSec < 1 {
+		return fmt.Errorf("%w: epoch check interval should be at least 1 second, provided %d", common.ErrInvalidValue, args.Config.CheckIntervalSec)
+	}
+	if args.Config.MinSuccessRatio < 0 || args.Config.MinSuccessRatio > 1 {
+		return fmt.Errorf("%w: min success ratio should be between 0 and 1, provided %v", common.ErrInvalidValue, args.Config.MinSuccessRatio)
+	}
+	if len(args.Config.Notifiers) == 0 {
+		return ErrNoNotifiers
+	}
+
+	return nil
+}
+
+func (er *epochReporter) load() {
+	state := EpochState{}
+	err := er.storer.Get(epochStorerKey, &state)
+	if err != nil || state.Nodes == nil {
+		er.state = EpochState{
+			Nodes: make(map[string]*EpochNodeState),
+		}
+		return
+	}
+
+	log.Info("loaded stored epoch report state", "epoch", state.Epoch, "num nodes", len(state.Nodes))
+	er.state = state
+}
+
+// Start will check the network epoch periodically, on a separate go routine
+func (er *epochReporter) Start() {
+	var ctx context.Context
+	ctx, er.cancelFunc = context.WithCancel(context.Background())
+
+	go er.run(ctx)
+}
+
+func (er *epochReporter) run(ctx context.Context) {
+	ticker := time.NewTicker(er.checkInterval)
+	defer ticker.Stop()
+
+	er.check()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("epoch reporter is stopping...")
+			return
+		case <-ticker.C:
+			er.check()
+		}
+	}
+}
+
+// check fetches the current epoch and the nodes. The report is sent when the epoch changed since the previous check
+func (er *epochReporter) check() {
+	stats, err := er.statsFetcher.FetchStats()
+	if err != nil {
+		log.Warn("failed to fetch the network stats", "error", err.Error())
+		return
+	}
+	if stats.Epoch == 0 {
+		// an empty or partial response, which would otherwise reset the counters of the epoch in progress
+		log.Warn("failed to fetch the network stats", "error", "no epoch in the api response")
+		return
+	}
+	nodes, err := er.nodesFetcher.FetchNodes(er.pubKeys)
+	if err != nil {
+		log.Warn("failed to fetch the nodes for the epoch report", "error", err.Error())
+		return
+	}
+
+	switch {
+	case er.state.Epoch == 0 || stats.Epoch < er.state.Epoch:
+		// nothing to report on the first check or if the api switched to another network
+		log.Info("epoch report started", "epoch", stats.Epoch)
+		er.startEpoch(stats.Epoch, nodes, nil)
+	case stats.Epoch == er.state.Epoch:
+		er.updateNodes(nodes)
+	default:
+		endedEpoch := er.state.Epoch
+		performances := er.endEpoch(stats.Epoch, nodes)
+		er.sendReport(endedEpoch, stats.Epoch, performances)
+	}
+
+	er.save()
+}
+
+func (er *epochReporter) updateNodes(nodes []clients.APINode) {
+	for _, node := range nodes {
+		nodeState, ok := er.state.Nodes[node.Bls]
+		if !ok {
+			er.state.Nodes[node.Bls] = newEpochNodeState(node, newEpochCounters(node))
+			continue
+		}
+
+		nodeState.Last = newEpochCounters(node)
+	}
+}
+
+// endEpoch returns the performance of the nodes during the tracked epoch and starts tracking the new one
+func (er *epochReporter) endEpoch(epoch uint32, nodes []clients.APINode) []nodePerformance {
+	performances := make([]nodePerformance, 0, len(nodes))
+	newStarts := make(map[string]EpochCounters)
+	for _, node := range nodes {
+		current := newEpochCounters(node)
+		newStarts[node.Bls] = current
+
+		nodeState, ok := er.state.Nodes[node.Bls]
+		if !ok {
+			continue
+		}
+
+		deltas := current.sub(nodeState.Start)
+		if current.isBelow(nodeState.Last) {
+			// the counters have been reset for the new epoch, so the last values are the ones of the ended epoch
+			deltas = nodeState.Last.sub(nodeState.Start)
+			newStarts[node.Bls] = EpochCounters{}
+		}
+
+		performances = append(performances, nodePerformance{
+			name:            er.displayName(node),
+			shard:           node.Shard,
+			status:          node.Status,
+			online:          node.Online,
+			deltas:          deltas,
+			startRating:     nodeState.StartRating,
+			endRating:       node.Rating,
+			startTempRating: nodeState.StartTempRating,
+			endTempRating:   node.TempRating,
+		})
+	}
+
+	er.startEpoch(epoch, nodes, newStarts)
+
+	return performances
+}
+
+func (er *epochReporter) startEpoch(epoch uint32, nodes []clients.APINode, starts map[string]EpochCounters) {
+	er.state = EpochState{
+		Epoch: epoch,
+		Nodes: make(map[string]*EpochNodeState),
+	}
+	for _, node := range nodes {
+		start, ok := starts[node.Bls]
+		if !ok {
+			start = newEpochCounters(node)
+		}
+
+		er.state.Nodes[node.Bls] = newEpochNodeState(node, start)
+	}
+}
+
+func newEpochNodeState(node clients.APINode, start EpochCounters) *EpochNodeState {
+	return &EpochNodeState{
+		StartRating:     node.Rating,
+		StartTempRating: node.TempRating,
+		Start:           start,
+		Last:            newEpochCounters(node),
+	}
+}
+
+func (er *epochReporter) displayName(node clients.APINode) string {
+	if er.aliases[node.Bls] != "" {
+		return er.aliases[node.Bls]
+	}
+	if node.Name != "" {
+		return node.Name
+	}
+
+	return node.Bls
+}
+
+func (er *epochReporter) sendReport(endedEpoch uint32, newEpoch uint32, performances []nodePerformance) {
+	msg := er.createReportMessage(endedEpoch, newEpoch, performances)
+
+	err := er.sender.SendReport(er.notifiers, msg)
+	if err != nil {
+		log.Error("failed to send the epoch report", "error", err.Error())
+		return
+	}
+
+	log.Info("epoch report sent", "epoch", endedEpoch, "notifiers", strings.Join(er.notifiers, ", "), "num nodes", len(performances))
+}
+
+func (er *epochReporter) createReportMessage(endedEpoch uint32, newEpoch uint32, performances []nodePerformance) data.NotificationMessage {
+	sort.Slice(performances, func(i, j int) bool {
+		return strings.ToLower(performances[i].name) < strings.ToLower(performances[j].name)
+	})
+
+	lines := make([]string, 0, len(performances)+1)
+	numFlagged := 0
+	for _, performance := range performances {
+		flagged := performance.isBelow(er.minSuccessRatio)
+		if flagged {
+			numFlagged++
+		}
+		lines = append(lines, performance.format(flagged))
+	}
+
+	title := fmt.Sprintf("Epoch %d report", endedEpoch)
+	if newEpoch-endedEpoch > 1 {
+		// some epoch changes have been missed, so the counters span over more epochs
+		title = fmt.Sprintf("Epochs %d - %d report", endedEpoch, newEpoch-1)
+	}
+	if er.minSuccessRatio > 0 {
+		title += fmt.Sprintf(", %d of %d nodes below %s success", numFlagged, len(performances), formatRatio(er.minSuccessRatio))
+	}
+	if len(performances) == 0 {
+		lines = append(lines, "No nodes tracked during the epoch")
+	}
+
+	level := common.InfoEvent
+	if numFlagged > 0 {
+		level = common.WarningEvent
+	}
+
+	return data.NotificationMessage{
+		Level:   level,
+		Message: strings.Join(append([]string{title}, lines...), "\n"),
+	}
+}
+
+// save persists the tracked epoch, so a restart during the epoch does not lose its start values
+func (er *epochReporter) save() {
+	err := er.storer.Put(epochStorerKey, er.state)
+	if err != nil {
+		log.Warn("failed to save the epoch report state", "error", err.Error())
+	}
+}
+
+// Close will stop the reporter
+func (er *epochReporter) Close() error {
+	if er.cancelFunc != nil {
+		er.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (er *epochReporter) IsInterfaceNil() bool {
+	return er == nil
+}
+
+type nodePerformance struct {
+	name            string
+	shard           int
+	status          string
+	online          bool
+	deltas          EpochCounters
+	startRating     int
+	endRating       int
+	startTempRating float64
+	endTempRating   float64
+}
+
+// successRatio returns the ratio of the successful rounds out of the total, or false if there were no rounds
+func successRatio(success int, failure int) (float64, bool) {
+	total := success + failure
+	if total <= 0 {
+		return 0, false
+	}
+
+	return float64(success) / float64(total), true
+}
+
+// isBelow returns true if the validator or the leader success ratio is below the provided minimum
+func (np nodePerformance) isBelow(minRatio float64) bool {
+	validatorRatio, ok := successRatio(np.deltas.ValidatorSuccess, np.deltas.ValidatorFailure)
+	if ok && validatorRatio < minRatio {
+		return true
+	}
+	leaderRatio, ok := successRatio(np.deltas.LeaderSuccess, np.deltas.LeaderFailure)
+
+	return ok && leaderRatio < minRatio
+}
+
+func (np nodePerformance) format(flagged bool) string {
+	state := "offline"
+	if np.online {
+		state = "online"
+	}
+	if np.status != "" {
+		state = np.status + ", " + state
+	}
+	name := np.name
+	if flagged {
+		name = flaggedMarker + " " + name
+	}
+
+	return fmt.Sprintf("%s (shard %d, %s): validator %d ok / %d failed / %d ignored (%s), leader %d ok / %d failed (%s), rating %d → %d, temp rating %.2f → %.2f",
+		name,
+		np.shard,
+		state,
+		np.deltas.ValidatorSuccess,
+		np.deltas.ValidatorFailure,
+		np.deltas.ValidatorIgnoredSignatures,
+		formatSuccessRatio(np.deltas.ValidatorSuccess, np.deltas.ValidatorFailure),
+		np.deltas.LeaderSuccess,
+		np.deltas.LeaderFailure,
+		formatSuccessRatio(np.deltas.LeaderSuccess, np.deltas.LeaderFailure),
+		np.startRating,
+		np.endRating,
+		np.startTempRating,
+		np.endTempRating,
+	)
+}
+
+func formatSuccessRatio(success int, failure int) string {
+	ratio, ok := successRatio(success, failure)
+	if !ok {
+		return "n/a"
+	}
+
+	return formatRatio(ratio)
+}
+
+func formatRatio(ratio float64) string {
+	return fmt.Sprintf("%.2f%%", ratio*100)
+}
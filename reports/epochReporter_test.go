@@ -0,0 +1,353 @@
+package reports_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/reports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// apiSequence returns the provided epochs and nodes, one pair on every check
+type apiSequence struct {
+	epochs []uint32
+	nodes  [][]clients.APINode
+	errs   map[int]error
+	index  int
+	err    error
+}
+
+func (as *apiSequence) FetchStats() (clients.APIStats, error) {
+	if as.err != nil {
+		return clients.APIStats{}, as.err
+	}
+
+	index := as.index
+	as.index++
+	if as.errs[index] != nil {
+		return clients.APIStats{}, as.errs[index]
+	}
+
+	return clients.APIStats{Epoch: as.epochs[index]}, nil
+}
+
+func (as *apiSequence) FetchNodes(_ []string) ([]clients.APINode, error) {
+	return as.nodes[as.index-1], nil
+}
+
+func (as *apiSequence) IsInterfaceNil() bool {
+	return as == nil
+}
+
+func createMockEpochReporterArgs() reports.ArgsEpochReporter {
+	return reports.ArgsEpochReporter{
+		Config: &config.EpochReport{
+			Enabled:          true,
+			CheckIntervalSec: 60,
+			MinSuccessRatio:  0.95,
+			Notifiers:        []string{"Slack"},
+		},
+		PubKeys:      []string{"key1", "key2"},
+		Labels:       []config.NodeLabels{{PubKey: "key2", Alias: "validator-02"}},
+		StatsFetcher: &apiSequence{},
+		NodesFetcher: &apiSequence{},
+		Storer:       &mocks.StorerStub{},
+		Sender:       &mocks.ReportSenderStub{},
+	}
+}
+
+func createAPINode(pubKey string, validatorSuccess int, validatorFailure int, leaderSuccess int, leaderFailure int, tempRating float64) clients.APINode {
+	return clients.APINode{
+		Bls:                        pubKey,
+		Name:                       "node-" + pubKey,
+		Shard:                      1,
+		Status:                     "eligible",
+		Online:                     true,
+		Rating:                     100,
+		TempRating:                 tempRating,
+		ValidatorSuccess:           validatorSuccess,
+		ValidatorFailure:           validatorFailure,
+		ValidatorIgnoredSignatures: validatorFailure / 2,
+		LeaderSuccess:              leaderSuccess,
+		LeaderFailure:              leaderFailure,
+	}
+}
+
+func createEpochReporter(t *testing.T, args reports.ArgsEpochReporter, sequence *apiSequence) (reporter interface{ Check() }, sent *[]data.NotificationMessage) {
+	messages := make([]data.NotificationMessage, 0)
+	args.StatsFetcher = sequence
+	args.NodesFetcher = sequence
+	args.Sender = &mocks.ReportSenderStub{
+		SendReportCalled: func(notifierNames []string, msg data.NotificationMessage) error {
+			assert.Equal(t, []string{"Slack"}, notifierNames)
+			messages = append(messages, msg)
+			return nil
+		},
+	}
+
+	er, err := reports.NewEpochReporter(args)
+	require.Nil(t, err)
+
+	return er, &messages
+}
+
+func TestNewEpochReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.Config = nil
+
+		er, err := reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.Equal(t, reports.ErrNilConfig, err)
+	})
+
+	t.Run("nil stats fetcher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.StatsFetcher = nil
+
+		er, err := reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.Equal(t, reports.ErrNilStatsFetcher, err)
+	})
+
+	t.Run("nil nodes fetcher", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.NodesFetcher = nil
+
+		er, err := reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.Equal(t, reports.ErrNilNodesFetcher, err)
+	})
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.Storer = nil
+
+		er, err := reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.Equal(t, reports.ErrNilStorer, err)
+	})
+
+	t.Run("nil sender", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.Sender = nil
+
+		er, err := reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.Equal(t, reports.ErrNilReportSender, err)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.Config.CheckIntervalSec = 0
+		er, err := reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.True(t, errors.Is(err, common.ErrInvalidValue))
+
+		args = createMockEpochReporterArgs()
+		args.Config.MinSuccessRatio = 95
+		er, err = reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.True(t, errors.Is(err, common.ErrInvalidValue))
+
+		args = createMockEpochReporterArgs()
+		args.Config.Notifiers = nil
+		er, err = reports.NewEpochReporter(args)
+		assert.Nil(t, er)
+		assert.Equal(t, reports.ErrNoNotifiers, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		er, err := reports.NewEpochReporter(createMockEpochReporterArgs())
+		require.Nil(t, err)
+		assert.False(t, er.IsInterfaceNil())
+	})
+}
+
+func TestEpochReporter_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cumulative counters", func(t *testing.T) {
+		t.Parallel()
+
+		sequence := &apiSequence{
+			epochs: []uint32{10, 10, 11},
+			nodes: [][]clients.APINode{
+				{createAPINode("key1", 100, 0, 10, 0, 100), createAPINode("key2", 100, 10, 10, 0, 99)},
+				{createAPINode("key1", 200, 0, 11, 0, 100), createAPINode("key2", 250, 40, 12, 1, 95)},
+				{createAPINode("key1", 300, 0, 12, 0, 100), createAPINode("key2", 300, 60, 12, 2, 90)},
+			},
+		}
+		er, sent := createEpochReporter(t, createMockEpochReporterArgs(), sequence)
+
+		er.Check()
+		er.Check()
+		assert.Empty(t, *sent)
+
+		er.Check()
+		require.Len(t, *sent, 1)
+		assert.Equal(t, common.WarningEvent, (*sent)[0].Level)
+
+		expectedMessage := "Epoch 10 report, 1 of 2 nodes below 95.00% success\n" +
+			"node-key1 (shard 1, eligible, online): validator 200 ok / 0 failed / 0 ignored (100.00%), leader 2 ok / 0 failed (100.00%), rating 100 → 100, temp rating 100.00 → 100.00\n" +
+			"⚠️ validator-02 (shard 1, eligible, online): validator 200 ok / 50 failed / 25 ignored (80.00%), leader 2 ok / 2 failed (50.00%), rating 100 → 100, temp rating 99.00 → 90.00"
+		assert.Equal(t, expectedMessage, (*sent)[0].Message)
+	})
+
+	t.Run("counters reset at the epoch start", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.Config.MinSuccessRatio = 0
+		sequence := &apiSequence{
+			epochs: []uint32{10, 10, 11, 11, 12},
+			nodes: [][]clients.APINode{
+				{createAPINode("key1", 5, 0, 1, 0, 100)},
+				{createAPINode("key1", 500, 4, 3, 0, 100)},
+				{createAPINode("key1", 3, 0, 0, 0, 100)},
+				{createAPINode("key1", 400, 0, 2, 0, 100)},
+				{createAPINode("key1", 420, 2, 2, 1, 100)},
+			},
+		}
+		er, sent := createEpochReporter(t, args, sequence)
+
+		for i := 0; i < 5; i++ {
+			er.Check()
+		}
+		require.Len(t, *sent, 2)
+		assert.Equal(t, common.InfoEvent, (*sent)[0].Level)
+		assert.Equal(t, "Epoch 10 report\n"+
+			"node-key1 (shard 1, eligible, online): validator 495 ok / 4 failed / 2 ignored (99.20%), leader 2 ok / 0 failed (100.00%), rating 100 → 100, temp rating 100.00 → 100.00",
+			(*sent)[0].Message)
+		// the values fetched right after the reset belong to the new epoch
+		assert.Equal(t, "Epoch 11 report\n"+
+			"node-key1 (shard 1, eligible, online): validator 420 ok / 2 failed / 1 ignored (99.53%), leader 2 ok / 1 failed (66.67%), rating 100 → 100, temp rating 100.00 → 100.00",
+			(*sent)[1].Message)
+	})
+
+	t.Run("missed epochs and nodes without rounds", func(t *testing.T) {
+		t.Parallel()
+
+		sequence := &apiSequence{
+			epochs: []uint32{10, 13},
+			nodes: [][]clients.APINode{
+				{createAPINode("key1", 0, 0, 0, 0, 100)},
+				{createAPINode("key1", 0, 0, 0, 0, 100), createAPINode("key2", 10, 0, 0, 0, 100)},
+			},
+		}
+		er, sent := createEpochReporter(t, createMockEpochReporterArgs(), sequence)
+
+		er.Check()
+		er.Check()
+		require.Len(t, *sent, 1)
+		assert.Equal(t, common.InfoEvent, (*sent)[0].Level)
+		assert.Equal(t, "Epochs 10 - 12 report, 0 of 1 nodes below 95.00% success\n"+
+			"node-key1 (shard 1, eligible, online): validator 0 ok / 0 failed / 0 ignored (n/a), leader 0 ok / 0 failed (n/a), rating 100 → 100, temp rating 100.00 → 100.00",
+			(*sent)[0].Message)
+	})
+
+	t.Run("fetch error should not change the state", func(t *testing.T) {
+		t.Parallel()
+
+		numPuts := 0
+		args := createMockEpochReporterArgs()
+		args.Storer = &mocks.StorerStub{
+			PutCalled: func(key string, value interface{}) error {
+				numPuts++
+				return nil
+			},
+		}
+		er, sent := createEpochReporter(t, args, &apiSequence{err: errors.New("expected error")})
+
+		er.Check()
+		assert.Empty(t, *sent)
+		assert.Zero(t, numPuts)
+	})
+
+	t.Run("api errors in the middle of the epoch keep the counters", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEpochReporterArgs()
+		args.Config.MinSuccessRatio = 0
+		sequence := &apiSequence{
+			epochs: []uint32{10, 0, 0, 10, 11},
+			nodes: [][]clients.APINode{
+				{createAPINode("key1", 100, 0, 1, 0, 100)},
+				nil,
+				nil,
+				{createAPINode("key1", 300, 0, 2, 0, 100)},
+				{createAPINode("key1", 400, 0, 3, 0, 100)},
+			},
+			errs: map[int]error{1: &common.HTTPStatusError{StatusCode: http.StatusInternalServerError}},
+		}
+		er, sent := createEpochReporter(t, args, sequence)
+
+		// the 500 response is a fetch error and the empty response holds no epoch, both are skipped
+		for i := 0; i < 5; i++ {
+			er.Check()
+		}
+		require.Len(t, *sent, 1)
+		assert.Equal(t, "Epoch 10 report\n"+
+			"node-key1 (shard 1, eligible, online): validator 300 ok / 0 failed / 0 ignored (100.00%), leader 2 ok / 0 failed (100.00%), rating 100 → 100, temp rating 100.00 → 100.00",
+			(*sent)[0].Message)
+	})
+
+	t.Run("state is restored after restart", func(t *testing.T) {
+		t.Parallel()
+
+		stored := make(map[string][]byte)
+		args := createMockEpochReporterArgs()
+		args.Storer = &mocks.StorerStub{
+			PutCalled: func(key string, value interface{}) error {
+				buff, err := json.Marshal(value)
+				stored[key] = buff
+				return err
+			},
+			GetCalled: func(key string, value interface{}) error {
+				buff, ok := stored[key]
+				if !ok {
+					return mocks.ErrKeyNotFound
+				}
+				return json.Unmarshal(buff, value)
+			},
+		}
+
+		er, _ := createEpochReporter(t, args, &apiSequence{
+			epochs: []uint32{10},
+			nodes:  [][]clients.APINode{{createAPINode("key1", 100, 0, 0, 0, 100)}},
+		})
+		er.Check()
+
+		er, sent := createEpochReporter(t, args, &apiSequence{
+			epochs: []uint32{11},
+			nodes:  [][]clients.APINode{{createAPINode("key1", 150, 0, 0, 0, 100)}},
+		})
+		er.Check()
+		require.Len(t, *sent, 1)
+		assert.Contains(t, (*sent)[0].Message, "validator 50 ok / 0 failed")
+	})
+}
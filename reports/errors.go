@@ -0,0 +1,27 @@
+package reports
+
+import "errors"
+
+// ErrNilNodesStatusProvider signals that a nil nodes status provider has been provided
+var ErrNilNodesStatusProvider = errors.New("nil nodes status provider")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilPeriodProvider signals that a nil period provider has been provided
+var ErrNilPeriodProvider = errors.New("nil period provider")
+
+// ErrNilReportSender signals that a nil report sender has been provided
+var ErrNilReportSender = errors.New("nil report sender")
+
+// ErrNilConfig signals that a nil config has been provided
+var ErrNilConfig = errors.New("nil config")
+
+// ErrNoNotifiers signals that no notifiers have been provided for a report
+var ErrNoNotifiers = errors.New("no notifiers provided for the report")
+
+// ErrNilStatsFetcher signals that a nil stats fetcher has been provided
+var ErrNilStatsFetcher = errors.New("nil stats fetcher")
+
+// ErrNilNodesFetcher signals that a nil nodes fetcher has been provided
+var ErrNilNodesFetcher = errors.New("nil nodes fetcher")
@@ -0,0 +1,23 @@
+package reports
+
+import "time"
+
+// SetGetTimeHandler -
+func (sc *samplesCollector) SetGetTimeHandler(handler func() time.Time) {
+	sc.getTimeHandler = handler
+}
+
+// NextRunTime -
+func (ds *digestScheduler) NextRunTime(now time.Time) time.Time {
+	return ds.nextRunTime(now)
+}
+
+// SendDigest -
+func (ds *digestScheduler) SendDigest(now time.Time) {
+	ds.sendDigest(now)
+}
+
+// Check -
+func (er *epochReporter) Check() {
+	er.check()
+}
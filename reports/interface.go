@@ -0,0 +1,39 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+)
+
+// NodesStatusProvider defines the behaviour of a component able to provide the state of the monitored nodes
+type NodesStatusProvider interface {
+	NodesStatus() []status.NodeStatus
+	IsInterfaceNil() bool
+}
+
+// PeriodProvider defines the behaviour of a component which aggregates the samples of the nodes over a period
+type PeriodProvider interface {
+	TakePeriod(end time.Time) Period
+	IsInterfaceNil() bool
+}
+
+// ReportSender defines the behaviour of a component able to send a report through the notifiers with the provided names
+type ReportSender interface {
+	SendReport(notifierNames []string, msg data.NotificationMessage) error
+	IsInterfaceNil() bool
+}
+
+// StatsFetcher defines the behaviour of a component able to fetch the network stats from api
+type StatsFetcher interface {
+	FetchStats() (clients.APIStats, error)
+	IsInterfaceNil() bool
+}
+
+// NodesFetcher defines the behaviour of a component able to fetch the nodes info from api
+type NodesFetcher interface {
+	FetchNodes(pubKeys []string) ([]clients.APINode, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,221 @@
+package reports
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+)
+
+var log = logger.GetOrCreate("reports")
+
+const samplesStorerKey = "ReportSamples"
+
+// NodeStats holds the values of a node aggregated over a period
+type NodeStats struct {
+	PubKey          string
+	Name            string
+	Shard           int
+	Version         string
+	StartRating     int
+	EndRating       int
+	StartTempRating float64
+	EndTempRating   float64
+	MinTempRating   float64
+	MaxTempRating   float64
+	Alerts          int
+	Offline         time.Duration
+	StatusChanges   int
+	Online          bool
+	Status          string
+	Samples         int
+	LastSampleAt    time.Time
+}
+
+// Period holds the stats of the nodes sampled between the start and the end times
+type Period struct {
+	Start time.Time
+	End   time.Time
+	Nodes map[string]*NodeStats
+}
+
+// ArgsSamplesCollector defines the arguments needed to create a new samples collector
+type ArgsSamplesCollector struct {
+	NodesStatusProvider NodesStatusProvider
+	Storer              clients.Storer
+}
+
+type samplesCollector struct {
+	nodesStatusProvider NodesStatusProvider
+	storer              clients.Storer
+	period              Period
+	mutPeriod           sync.Mutex
+	getTimeHandler      func() time.Time
+}
+
+// NewSamplesCollector will create a component which aggregates the state of the nodes fetched on every check,
+// to be used by the scheduled reports. The current period is persisted, so it survives restarts
+func NewSamplesCollector(args ArgsSamplesCollector) (*samplesCollector, error) {
+	if check.IfNil(args.NodesStatusProvider) {
+		return nil, ErrNilNodesStatusProvider
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	sc := &samplesCollector{
+		nodesStatusProvider: args.NodesStatusProvider,
+		storer:              args.Storer,
+		getTimeHandler:      time.Now,
+	}
+	sc.load()
+
+	return sc, nil
+}
+
+func (sc *samplesCollector) load() {
+	period := Period{}
+	err := sc.storer.Get(samplesStorerKey, &period)
+	if err != nil || period.Nodes == nil {
+		sc.period = Period{
+			Nodes: make(map[string]*NodeStats),
+		}
+		return
+	}
+
+	log.Info("loaded stored report samples", "period start", period.Start, "num nodes", len(period.Nodes))
+	sc.period = period
+}
+
+// RecordEvent will sample the current state of the nodes and will count the alerts notified for every node
+func (sc *samplesCollector) RecordEvent(_ string, event data.NotificationMessage) {
+	now := sc.getTimeHandler()
+	nodes := sc.nodesStatusProvider.NodesStatus()
+
+	alertsCount := make(map[string]int)
+	for _, alert := range event.Alerts {
+		// the alerts still firing are passed on unchanged, only the notified ones are counted
+		if alert.Changed {
+			alertsCount[alert.PubKey]++
+		}
+	}
+
+	sc.mutPeriod.Lock()
+	defer sc.mutPeriod.Unlock()
+
+	if sc.period.Start.IsZero() {
+		// the first period starts with the first sample
+		sc.period.Start = now
+	}
+	for _, node := range nodes {
+		stats, ok := sc.period.Nodes[node.PubKey]
+		if !ok {
+			stats = newNodeStats(node, now)
+			sc.period.Nodes[node.PubKey] = stats
+		}
+
+		stats.addSample(node, now)
+		stats.Alerts += alertsCount[node.PubKey]
+	}
+
+	sc.save()
+}
+
+func newNodeStats(node status.NodeStatus, now time.Time) *NodeStats {
+	return &NodeStats{
+		PubKey:          node.PubKey,
+		StartRating:     node.Rating,
+		StartTempRating: node.TempRating,
+		MinTempRating:   node.TempRating,
+		MaxTempRating:   node.TempRating,
+		Online:          node.Online,
+		Status:          node.Status,
+		LastSampleAt:    now,
+	}
+}
+
+func (ns *NodeStats) addSample(node status.NodeStatus, now time.Time) {
+	// the node is considered offline between two samples if it was offline on the first one
+	if !ns.Online {
+		ns.Offline += now.Sub(ns.LastSampleAt)
+	}
+	if ns.Online != node.Online || ns.Status != node.Status {
+		ns.StatusChanges++
+	}
+	if node.TempRating < ns.MinTempRating {
+		ns.MinTempRating = node.TempRating
+	}
+	if node.TempRating > ns.MaxTempRating {
+		ns.MaxTempRating = node.TempRating
+	}
+
+	ns.Name = node.DisplayName()
+	ns.Shard = node.Shard
+	ns.Version = node.Version
+	ns.EndRating = node.Rating
+	ns.EndTempRating = node.TempRating
+	ns.Online = node.Online
+	ns.Status = node.Status
+	ns.Samples++
+	ns.LastSampleAt = now
+}
+
+// TakePeriod returns the stats aggregated until the provided time and starts a new period. The stats of the
+// new period start from the last values of the nodes
+func (sc *samplesCollector) TakePeriod(end time.Time) Period {
+	sc.mutPeriod.Lock()
+	defer sc.mutPeriod.Unlock()
+
+	period := sc.period
+	period.End = end
+
+	sc.period = Period{
+		Start: end,
+		Nodes: make(map[string]*NodeStats),
+	}
+	for pubKey, stats := range period.Nodes {
+		if stats.Samples == 0 {
+			// the keys which are no longer monitored are dropped
+			delete(period.Nodes, pubKey)
+			continue
+		}
+		if !stats.Online {
+			stats.Offline += end.Sub(stats.LastSampleAt)
+		}
+
+		sc.period.Nodes[pubKey] = &NodeStats{
+			PubKey:          stats.PubKey,
+			Name:            stats.Name,
+			Shard:           stats.Shard,
+			Version:         stats.Version,
+			StartRating:     stats.EndRating,
+			EndRating:       stats.EndRating,
+			StartTempRating: stats.EndTempRating,
+			EndTempRating:   stats.EndTempRating,
+			MinTempRating:   stats.EndTempRating,
+			MaxTempRating:   stats.EndTempRating,
+			Online:          stats.Online,
+			Status:          stats.Status,
+			LastSampleAt:    end,
+		}
+	}
+	sc.save()
+
+	return period
+}
+
+func (sc *samplesCollector) save() {
+	err := sc.storer.Put(samplesStorerKey, sc.period)
+	if err != nil {
+		log.Warn("failed to save the report samples", "error", err.Error())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *samplesCollector) IsInterfaceNil() bool {
+	return sc == nil
+}
@@ -0,0 +1,181 @@
+package reports_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/reports"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var periodStart = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func createMockSamplesCollectorArgs() reports.ArgsSamplesCollector {
+	return reports.ArgsSamplesCollector{
+		NodesStatusProvider: &mocks.NodesStatusProviderStub{},
+		Storer:              &mocks.StorerStub{},
+	}
+}
+
+// nodesSequence returns the provided samples, one on every call
+type nodesSequence struct {
+	samples [][]status.NodeStatus
+	index   int
+}
+
+func (ns *nodesSequence) next() []status.NodeStatus {
+	sample := ns.samples[ns.index]
+	ns.index++
+
+	return sample
+}
+
+func TestNewSamplesCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes status provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSamplesCollectorArgs()
+		args.NodesStatusProvider = nil
+
+		sc, err := reports.NewSamplesCollector(args)
+		assert.Nil(t, sc)
+		assert.Equal(t, reports.ErrNilNodesStatusProvider, err)
+	})
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSamplesCollectorArgs()
+		args.Storer = nil
+
+		sc, err := reports.NewSamplesCollector(args)
+		assert.Nil(t, sc)
+		assert.Equal(t, reports.ErrNilStorer, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := reports.NewSamplesCollector(createMockSamplesCollectorArgs())
+		require.Nil(t, err)
+		assert.False(t, sc.IsInterfaceNil())
+	})
+}
+
+func TestSamplesCollector_RecordEventAndTakePeriod(t *testing.T) {
+	t.Parallel()
+
+	sequence := &nodesSequence{
+		samples: [][]status.NodeStatus{
+			{{PubKey: "key1", Name: "node-1", Alias: "validator-01", Shard: 1, Version: "v1.6.0", Online: true, Status: "eligible", Rating: 100, TempRating: 98}},
+			{{PubKey: "key1", Name: "node-1", Alias: "validator-01", Shard: 1, Version: "v1.6.0", Online: false, Status: "eligible", Rating: 99, TempRating: 90}},
+			{{PubKey: "key1", Name: "node-1", Alias: "validator-01", Shard: 1, Version: "v1.6.1", Online: true, Status: "eligible", Rating: 99, TempRating: 95}},
+		},
+	}
+
+	stored := make(map[string][]byte)
+	args := createMockSamplesCollectorArgs()
+	args.NodesStatusProvider = &mocks.NodesStatusProviderStub{
+		NodesStatusCalled: sequence.next,
+	}
+	args.Storer = &mocks.StorerStub{
+		PutCalled: func(key string, value interface{}) error {
+			buff, err := json.Marshal(value)
+			require.Nil(t, err)
+			stored[key] = buff
+			return nil
+		},
+		GetCalled: func(key string, value interface{}) error {
+			buff, ok := stored[key]
+			if !ok {
+				return mocks.ErrKeyNotFound
+			}
+			return json.Unmarshal(buff, value)
+		},
+	}
+
+	sc, err := reports.NewSamplesCollector(args)
+	require.Nil(t, err)
+
+	now := periodStart
+	sc.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+
+	// the alerts still firing without changes have already been counted
+	alert := data.Alert{PubKey: "key1", Level: common.CriticalEvent, Changed: true}
+	now = periodStart.Add(time.Minute)
+	sc.RecordEvent("NodeRating", data.NotificationMessage{})
+	now = periodStart.Add(2 * time.Minute)
+	sc.RecordEvent("NodeRating", data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert}})
+
+	// the period is restored from the storer, as after a restart
+	sc, err = reports.NewSamplesCollector(args)
+	require.Nil(t, err)
+	sc.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+
+	now = periodStart.Add(17 * time.Minute)
+	sc.RecordEvent("NodeRating", data.NotificationMessage{Level: common.CriticalEvent, Alerts: []data.Alert{alert, {PubKey: "unknown", Changed: true}, {PubKey: "key1"}}})
+
+	end := periodStart.Add(24 * time.Hour)
+	period := sc.TakePeriod(end)
+	assert.Equal(t, end, period.End)
+	require.Len(t, period.Nodes, 1)
+
+	stats := period.Nodes["key1"]
+	assert.Equal(t, "validator-01", stats.Name)
+	assert.Equal(t, 1, stats.Shard)
+	assert.Equal(t, "v1.6.1", stats.Version)
+	assert.Equal(t, 100, stats.StartRating)
+	assert.Equal(t, 99, stats.EndRating)
+	assert.Equal(t, 98.0, stats.StartTempRating)
+	assert.Equal(t, 95.0, stats.EndTempRating)
+	assert.Equal(t, 90.0, stats.MinTempRating)
+	assert.Equal(t, 98.0, stats.MaxTempRating)
+	assert.Equal(t, 2, stats.Alerts)
+	assert.Equal(t, 15*time.Minute, stats.Offline)
+	assert.Equal(t, 2, stats.StatusChanges)
+
+	// the next period starts from the last values and drops the keys without new samples
+	period = sc.TakePeriod(end.Add(24 * time.Hour))
+	assert.Empty(t, period.Nodes)
+}
+
+func TestSamplesCollector_OfflineUntilPeriodEnd(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSamplesCollectorArgs()
+	args.NodesStatusProvider = &mocks.NodesStatusProviderStub{
+		NodesStatusCalled: func() []status.NodeStatus {
+			return []status.NodeStatus{{PubKey: "key1", Name: "node-1", Online: false, TempRating: 50}}
+		},
+	}
+	sc, _ := reports.NewSamplesCollector(args)
+
+	now := periodStart.Add(time.Hour)
+	sc.SetGetTimeHandler(func() time.Time {
+		return now
+	})
+	sc.RecordEvent("NodeRating", data.NotificationMessage{})
+
+	period := sc.TakePeriod(periodStart.Add(3 * time.Hour))
+	assert.Equal(t, 2*time.Hour, period.Nodes["key1"].Offline)
+
+	now = periodStart.Add(4 * time.Hour)
+	sc.RecordEvent("NodeRating", data.NotificationMessage{})
+	period = sc.TakePeriod(periodStart.Add(5 * time.Hour))
+	stats := period.Nodes["key1"]
+	assert.Equal(t, 2*time.Hour, stats.Offline)
+	assert.Equal(t, 50.0, stats.StartTempRating)
+	assert.Equal(t, 0, stats.StatusChanges)
+}
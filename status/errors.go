@@ -0,0 +1,6 @@
+package status
+
+import "errors"
+
+// ErrUnknownSortField signals that an unknown sort field has been provided
+var ErrUnknownSortField = errors.New("unknown sort field")
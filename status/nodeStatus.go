@@ -0,0 +1,157 @@
+package status
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+)
+
+// NodeStatus holds the current state of a monitored node, as displayed to the user
+type NodeStatus struct {
+	PubKey     string   `json:"pubKey"`
+	Alias      string   `json:"alias,omitempty"`
+	Name       string   `json:"name"`
+	Identity   string   `json:"identity"`
+	Tags       []string `json:"tags,omitempty"`
+	Shard      int      `json:"shard"`
+	Status     string   `json:"status"`
+	Online     bool     `json:"online"`
+	TempRating float64  `json:"tempRating"`
+	Rating     int      `json:"rating"`
+	Version    string   `json:"version"`
+	Nonce      int      `json:"nonce"`
+	Instances  int      `json:"instances"`
+}
+
+// DisplayName returns the configured alias, if any, or the node name otherwise
+func (ns NodeStatus) DisplayName() string {
+	if ns.Alias != "" {
+		return ns.Alias
+	}
+
+	return ns.Name
+}
+
+type lessFunc func(first NodeStatus, second NodeStatus) bool
+
+var sortFields = map[string]lessFunc{
+	"node": func(first NodeStatus, second NodeStatus) bool {
+		return strings.ToLower(first.DisplayName()) < strings.ToLower(second.DisplayName())
+	},
+	"shard": func(first NodeStatus, second NodeStatus) bool {
+		return first.Shard < second.Shard
+	},
+	"status": func(first NodeStatus, second NodeStatus) bool {
+		return first.Status < second.Status
+	},
+	"online": func(first NodeStatus, second NodeStatus) bool {
+		return !first.Online && second.Online
+	},
+	"temprating": func(first NodeStatus, second NodeStatus) bool {
+		return first.TempRating < second.TempRating
+	},
+	"rating": func(first NodeStatus, second NodeStatus) bool {
+		return first.Rating < second.Rating
+	},
+	"version": func(first NodeStatus, second NodeStatus) bool {
+		return first.Version < second.Version
+	},
+	"nonce": func(first NodeStatus, second NodeStatus) bool {
+		return first.Nonce < second.Nonce
+	},
+	"instances": func(first NodeStatus, second NodeStatus) bool {
+		return first.Instances < second.Instances
+	},
+}
+
+// SortFields returns the names of the fields the statuses can be sorted by
+func SortFields() []string {
+	fields := make([]string, 0, len(sortFields))
+	for field := range sortFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// NewNodesStatus will create the nodes statuses out of the nodes fetched from api and the configured labels
+func NewNodesStatus(nodes []clients.APINode, labels []config.NodeLabels) []NodeStatus {
+	labelsByKey := make(map[string]config.NodeLabels)
+	for _, nodeLabels := range labels {
+		labelsByKey[nodeLabels.PubKey] = nodeLabels
+	}
+
+	statuses := make([]NodeStatus, 0, len(nodes))
+	for _, node := range nodes {
+		nodeLabels := labelsByKey[node.Bls]
+		statuses = append(statuses, NodeStatus{
+			PubKey:     node.Bls,
+			Alias:      nodeLabels.Alias,
+			Name:       node.Name,
+			Identity:   node.Identity,
+			Tags:       nodeLabels.Tags,
+			Shard:      node.Shard,
+			Status:     node.Status,
+			Online:     node.Online,
+			TempRating: node.TempRating,
+			Rating:     node.Rating,
+			Version:    node.Version,
+			Nonce:      node.Nonce,
+			Instances:  node.Instances,
+		})
+	}
+
+	return statuses
+}
+
+// Filter returns the statuses matching the provided identity and tag; empty values match everything
+func Filter(statuses []NodeStatus, identity string, tag string) []NodeStatus {
+	filtered := make([]NodeStatus, 0, len(statuses))
+	for _, nodeStatus := range statuses {
+		if identity != "" && !strings.EqualFold(nodeStatus.Identity, identity) {
+			continue
+		}
+		if tag != "" && !hasTag(nodeStatus, tag) {
+			continue
+		}
+
+		filtered = append(filtered, nodeStatus)
+	}
+
+	return filtered
+}
+
+func hasTag(nodeStatus NodeStatus, tag string) bool {
+	for _, nodeTag := range nodeStatus.Tags {
+		if strings.EqualFold(nodeTag, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sort will sort the statuses by the provided field; a "-" prefix means descending order
+func Sort(statuses []NodeStatus, field string) error {
+	descending := strings.HasPrefix(field, "-")
+	field = strings.ToLower(strings.TrimPrefix(field, "-"))
+
+	less, ok := sortFields[field]
+	if !ok {
+		return fmt.Errorf("%w %s, available fields: %s", ErrUnknownSortField, field, strings.Join(SortFields(), ", "))
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if descending {
+			return less(statuses[j], statuses[i])
+		}
+
+		return less(statuses[i], statuses[j])
+	})
+
+	return nil
+}
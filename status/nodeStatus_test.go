@@ -0,0 +1,149 @@
+package status_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/clients"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStatuses() []status.NodeStatus {
+	nodes := []clients.APINode{
+		{Bls: "key1", Name: "node-b", Identity: "provider1", Shard: 1, TempRating: 90, Online: true},
+		{Bls: "key2", Name: "node-a", Identity: "provider2", Shard: 0, TempRating: 50},
+		{Bls: "key3", Name: "node-c", Identity: "provider1", Shard: 2, TempRating: 70, Online: true},
+	}
+	labels := []config.NodeLabels{
+		{PubKey: "key3", Alias: "alias", Tags: []string{"Backup", "eu"}},
+	}
+
+	return status.NewNodesStatus(nodes, labels)
+}
+
+func displayNames(statuses []status.NodeStatus) []string {
+	names := make([]string, 0, len(statuses))
+	for _, nodeStatus := range statuses {
+		names = append(names, nodeStatus.DisplayName())
+	}
+
+	return names
+}
+
+func TestNewNodesStatus(t *testing.T) {
+	t.Parallel()
+
+	statuses := createStatuses()
+	require.Len(t, statuses, 3)
+
+	assert.Equal(t, "node-b", statuses[0].DisplayName())
+	assert.Empty(t, statuses[0].Tags)
+
+	assert.Equal(t, "alias", statuses[2].DisplayName())
+	assert.Equal(t, "node-c", statuses[2].Name)
+	assert.Equal(t, []string{"Backup", "eu"}, statuses[2].Tags)
+	assert.Equal(t, 70.0, statuses[2].TempRating)
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no filters", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Len(t, status.Filter(createStatuses(), "", ""), 3)
+	})
+
+	t.Run("by identity", func(t *testing.T) {
+		t.Parallel()
+
+		filtered := status.Filter(createStatuses(), "PROVIDER1", "")
+		assert.Equal(t, []string{"node-b", "alias"}, displayNames(filtered))
+	})
+
+	t.Run("by tag", func(t *testing.T) {
+		t.Parallel()
+
+		filtered := status.Filter(createStatuses(), "", "backup")
+		assert.Equal(t, []string{"alias"}, displayNames(filtered))
+	})
+
+	t.Run("by identity and tag", func(t *testing.T) {
+		t.Parallel()
+
+		filtered := status.Filter(createStatuses(), "provider2", "backup")
+		assert.Empty(t, filtered)
+	})
+}
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown field should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := status.Sort(createStatuses(), "missing")
+		assert.True(t, errors.Is(err, status.ErrUnknownSortField))
+	})
+
+	t.Run("by node name", func(t *testing.T) {
+		t.Parallel()
+
+		statuses := createStatuses()
+		err := status.Sort(statuses, "node")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"alias", "node-a", "node-b"}, displayNames(statuses))
+	})
+
+	t.Run("by temp rating descending", func(t *testing.T) {
+		t.Parallel()
+
+		statuses := createStatuses()
+		err := status.Sort(statuses, "-TempRating")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"node-b", "alias", "node-a"}, displayNames(statuses))
+	})
+
+	t.Run("by shard", func(t *testing.T) {
+		t.Parallel()
+
+		statuses := createStatuses()
+		err := status.Sort(statuses, "shard")
+		require.Nil(t, err)
+		assert.Equal(t, []string{"node-a", "node-b", "alias"}, displayNames(statuses))
+	})
+}
+
+func TestWriteTable(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	err := status.WriteTable(buff, createStatuses())
+	require.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.True(t, strings.HasPrefix(lines[0], "NODE"))
+	assert.Contains(t, lines[3], "alias")
+	assert.Contains(t, lines[3], "70.00")
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	statuses := createStatuses()
+	err := status.WriteJSON(buff, statuses)
+	require.Nil(t, err)
+
+	var decoded []status.NodeStatus
+	err = json.Unmarshal(buff.Bytes(), &decoded)
+	require.Nil(t, err)
+	assert.Equal(t, statuses, decoded)
+}
@@ -0,0 +1,44 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// WriteTable will write the statuses as a table with aligned columns
+func WriteTable(writer io.Writer, statuses []NodeStatus) error {
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	_, err := fmt.Fprintln(tw, "NODE\tSHARD\tSTATUS\tONLINE\tTEMP RATING\tRATING\tVERSION\tNONCE\tINSTANCES")
+	if err != nil {
+		return err
+	}
+
+	for _, nodeStatus := range statuses {
+		_, err = fmt.Fprintf(
+			tw,
+			"%s\t%d\t%s\t%t\t%.2f\t%d\t%s\t%d\t%d\n",
+			nodeStatus.DisplayName(),
+			nodeStatus.Shard,
+			nodeStatus.Status,
+			nodeStatus.Online,
+			nodeStatus.TempRating,
+			nodeStatus.Rating,
+			nodeStatus.Version,
+			nodeStatus.Nonce,
+			nodeStatus.Instances,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// WriteJSON will write the statuses as a json array, on a single line
+func WriteJSON(writer io.Writer, statuses []NodeStatus) error {
+	return json.NewEncoder(writer).Encode(statuses)
+}
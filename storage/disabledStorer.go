@@ -0,0 +1,23 @@
+package storage
+
+type disabledStorer struct{}
+
+// NewDisabledStorer will create a storer which does not persist anything, to be used when no state file is configured
+func NewDisabledStorer() *disabledStorer {
+	return &disabledStorer{}
+}
+
+// Put does nothing
+func (ds *disabledStorer) Put(_ string, _ interface{}) error {
+	return nil
+}
+
+// Get returns ErrKeyNotFound
+func (ds *disabledStorer) Get(_ string, _ interface{}) error {
+	return ErrKeyNotFound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ds *disabledStorer) IsInterfaceNil() bool {
+	return ds == nil
+}
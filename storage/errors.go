@@ -0,0 +1,9 @@
+package storage
+
+import "errors"
+
+// ErrKeyNotFound signals that the provided key has no value stored
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const filePermissions = 0600
+
+type jsonFileStorer struct {
+	filePath string
+	mutFile  sync.Mutex
+}
+
+// NewJSONFileStorer will create a storer which keeps all the values, mapped by key, in a single json file
+func NewJSONFileStorer(filePath string) (*jsonFileStorer, error) {
+	if filePath == "" {
+		return nil, ErrEmptyFilePath
+	}
+
+	return &jsonFileStorer{
+		filePath: filePath,
+	}, nil
+}
+
+// Put will marshal the value and will save it under the provided key
+func (jfs *jsonFileStorer) Put(key string, value interface{}) error {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	jfs.mutFile.Lock()
+	defer jfs.mutFile.Unlock()
+
+	values, err := jfs.readValues()
+	if err != nil {
+		return err
+	}
+	values[key] = valueBytes
+
+	return jfs.writeValues(values)
+}
+
+// Get will unmarshal the value saved under the provided key
+func (jfs *jsonFileStorer) Get(key string, value interface{}) error {
+	jfs.mutFile.Lock()
+	values, err := jfs.readValues()
+	jfs.mutFile.Unlock()
+	if err != nil {
+		return err
+	}
+
+	valueBytes, ok := values[key]
+	if !ok {
+		return ErrKeyNotFound
+	}
+
+	return json.Unmarshal(valueBytes, value)
+}
+
+func (jfs *jsonFileStorer) readValues() (map[string]json.RawMessage, error) {
+	values := make(map[string]json.RawMessage)
+
+	fileBytes, err := ioutil.ReadFile(jfs.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(fileBytes, &values)
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// writeValues will write the values in a temporary file first, so that the state file is never left half written
+func (jfs *jsonFileStorer) writeValues(values map[string]json.RawMessage) error {
+	fileBytes, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(jfs.filePath), filepath.Base(jfs.filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	_, err = tmpFile.Write(fileBytes)
+	if err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	err = tmpFile.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmpFile.Name(), filePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), jfs.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jfs *jsonFileStorer) IsInterfaceNil() bool {
+	return jfs == nil
+}
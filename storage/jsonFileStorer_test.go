@@ -0,0 +1,70 @@
+package storage_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-node-monitoring/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSONFileStorer(t *testing.T) {
+	t.Parallel()
+
+	jfs, err := storage.NewJSONFileStorer("")
+	require.Nil(t, jfs)
+	assert.Equal(t, storage.ErrEmptyFilePath, err)
+
+	jfs, err = storage.NewJSONFileStorer(filepath.Join(t.TempDir(), "state.json"))
+	require.Nil(t, err)
+	assert.False(t, jfs.IsInterfaceNil())
+}
+
+func TestJSONFileStorer_PutGet(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "state.json")
+	jfs, _ := storage.NewJSONFileStorer(filePath)
+
+	values := make(map[string]float64)
+	err := jfs.Get("NodeRating", &values)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	err = jfs.Put("NodeRating", map[string]float64{"blskey": 99.5})
+	require.Nil(t, err)
+	err = jfs.Put("Other", []string{"value"})
+	require.Nil(t, err)
+
+	// a new instance should read the values saved by the previous one
+	jfs, _ = storage.NewJSONFileStorer(filePath)
+	err = jfs.Get("NodeRating", &values)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]float64{"blskey": 99.5}, values)
+
+	otherValues := make([]string, 0)
+	err = jfs.Get("Other", &otherValues)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"value"}, otherValues)
+
+	files, err := ioutil.ReadDir(filepath.Dir(filePath))
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(files))
+}
+
+func TestJSONFileStorer_CorruptedFile(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "state.json")
+	err := ioutil.WriteFile(filePath, []byte("{not json"), 0600)
+	require.Nil(t, err)
+
+	jfs, _ := storage.NewJSONFileStorer(filePath)
+	values := make(map[string]float64)
+	err = jfs.Get("NodeRating", &values)
+	assert.NotNil(t, err)
+
+	err = jfs.Put("NodeRating", values)
+	assert.NotNil(t, err)
+}
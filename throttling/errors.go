@@ -0,0 +1,9 @@
+package throttling
+
+import "errors"
+
+// ErrNilNotifier signals that a nil notifier has been provided
+var ErrNilNotifier = errors.New("nil notifier")
+
+// ErrRateLimited signals that a notification has been dropped because the notifier rate limit was reached
+var ErrRateLimited = errors.New("notification dropped by the rate limit")
@@ -0,0 +1,21 @@
+package throttling
+
+import "time"
+
+// TokenBucket -
+type TokenBucket = tokenBucket
+
+// NewTokenBucket -
+func NewTokenBucket(messagesPerMinute float64, burst int, now time.Time) *tokenBucket {
+	return newTokenBucket(messagesPerMinute, burst, now)
+}
+
+// Take -
+func (tb *tokenBucket) Take(now time.Time) bool {
+	return tb.take(now)
+}
+
+// NextTokenIn -
+func (tb *tokenBucket) NextTokenIn(now time.Time) time.Duration {
+	return tb.nextTokenIn(now)
+}
@@ -0,0 +1,321 @@
+package throttling
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/process"
+)
+
+var log = logger.GetOrCreate("throttling")
+
+const droppedTimeFormat = "15:04:05 MST"
+
+// ArgsThrottledNotifier defines the arguments needed to create a new throttled notifier
+type ArgsThrottledNotifier struct {
+	Notifier       process.Notifier
+	RateLimit      config.RateLimit
+	GroupingWindow time.Duration
+}
+
+// batch holds the messages gathered during a grouping window, sent as a single message
+type batch struct {
+	messages []data.NotificationMessage
+	done     chan struct{}
+	err      error
+}
+
+// droppedStats counts the notifications dropped by the rate limit, until they are reported
+type droppedStats struct {
+	notifications int
+	alerts        int
+	level         common.EventLevel
+	since         time.Time
+}
+
+type throttledNotifier struct {
+	notifier            process.Notifier
+	groupingWindow      time.Duration
+	bucket              *tokenBucket
+	pending             *batch
+	dropped             droppedStats
+	dropReportScheduled bool
+	flushTimer          *time.Timer
+	dropReportTimer     *time.Timer
+	closed              bool
+	mutState            sync.Mutex
+}
+
+// NewThrottledNotifier will wrap the provided notifier so that the messages pushed within the grouping window are
+// sent as a single summary message, and at most as many messages as the rate limit allows are sent. The messages
+// dropped by the rate limit are counted and reported with the next message sent
+func NewThrottledNotifier(args ArgsThrottledNotifier) (*throttledNotifier, error) {
+	if args.Notifier == nil {
+		return nil, ErrNilNotifier
+	}
+	if args.RateLimit.MessagesPerMinute <= 0 {
+		return nil, fmt.Errorf("%w: messages per minute should be positive, provided %v", common.ErrInvalidValue, args.RateLimit.MessagesPerMinute)
+	}
+	if args.RateLimit.Burst < 1 {
+		return nil, fmt.Errorf("%w: burst should be at least 1, provided %d", common.ErrInvalidValue, args.RateLimit.Burst)
+	}
+	if args.GroupingWindow < 0 {
+		return nil, fmt.Errorf("%w: grouping window should not be negative, provided %v", common.ErrInvalidValue, args.GroupingWindow)
+	}
+
+	return &throttledNotifier{
+		notifier:       args.Notifier,
+		groupingWindow: args.GroupingWindow,
+		bucket:         newTokenBucket(args.RateLimit.MessagesPerMinute, args.RateLimit.Burst, time.Now()),
+	}, nil
+}
+
+// PushMessage will add the message to the current grouping window and will wait until the window is sent.
+// ErrRateLimited is returned if the window has been dropped by the rate limit
+// Once closed, the messages are sent right away through the wrapped notifier
+func (tn *throttledNotifier) PushMessage(msg data.NotificationMessage) error {
+	tn.mutState.Lock()
+	if tn.closed {
+		tn.mutState.Unlock()
+		return tn.notifier.PushMessage(msg)
+	}
+	if tn.pending == nil {
+		tn.pending = &batch{
+			done: make(chan struct{}),
+		}
+		tn.flushTimer = time.AfterFunc(tn.groupingWindow, tn.flush)
+	}
+	currentBatch := tn.pending
+	currentBatch.messages = append(currentBatch.messages, msg)
+	tn.mutState.Unlock()
+
+	<-currentBatch.done
+
+	return currentBatch.err
+}
+
+func (tn *throttledNotifier) flush() {
+	tn.mutState.Lock()
+	currentBatch := tn.pending
+	tn.pending = nil
+	if currentBatch == nil {
+		tn.mutState.Unlock()
+		return
+	}
+
+	now := time.Now()
+	if !tn.bucket.take(now) {
+		tn.dropped.add(currentBatch.messages, now)
+		tn.scheduleDropReport(now)
+		tn.mutState.Unlock()
+
+		log.Warn("notification dropped by the rate limit", "notifier", tn.notifier.GetID(), "num messages", len(currentBatch.messages))
+		currentBatch.err = ErrRateLimited
+		close(currentBatch.done)
+		return
+	}
+
+	dropped := tn.dropped
+	tn.dropped = droppedStats{}
+	tn.mutState.Unlock()
+
+	currentBatch.err = tn.notifier.PushMessage(mergeMessages(currentBatch.messages, dropped))
+	if currentBatch.err != nil {
+		tn.restoreDropped(dropped)
+	}
+	close(currentBatch.done)
+}
+
+// scheduleDropReport will send the count of the dropped messages as soon as the rate limit allows it,
+// unless another message is sent before. It should be called under mutex protection
+func (tn *throttledNotifier) scheduleDropReport(now time.Time) {
+	if tn.dropReportScheduled || tn.closed {
+		return
+	}
+
+	tn.dropReportScheduled = true
+	tn.dropReportTimer = time.AfterFunc(tn.bucket.nextTokenIn(now), tn.reportDropped)
+}
+
+func (tn *throttledNotifier) reportDropped() {
+	tn.mutState.Lock()
+	tn.dropReportScheduled = false
+	if tn.dropped.notifications == 0 || tn.pending != nil {
+		// nothing to report or the pending window will report it
+		tn.mutState.Unlock()
+		return
+	}
+
+	now := time.Now()
+	if !tn.bucket.take(now) {
+		tn.scheduleDropReport(now)
+		tn.mutState.Unlock()
+		return
+	}
+
+	dropped := tn.dropped
+	tn.dropped = droppedStats{}
+	tn.mutState.Unlock()
+
+	err := tn.notifier.PushMessage(mergeMessages(nil, dropped))
+	if err != nil {
+		log.Error("failed to report the dropped notifications", "notifier", tn.notifier.GetID(), "error", err.Error())
+		tn.restoreDropped(dropped)
+	}
+}
+
+// restoreDropped adds back the dropped messages which could not be reported, to be reported with the next message
+func (tn *throttledNotifier) restoreDropped(dropped droppedStats) {
+	if dropped.notifications == 0 {
+		return
+	}
+
+	tn.mutState.Lock()
+	tn.dropped.merge(dropped)
+	tn.mutState.Unlock()
+}
+
+// Close will send the pending grouping window right away and will stop the scheduled report of the dropped
+// messages. It should be called once the notifier is no longer used, as when it is replaced on a config reload
+func (tn *throttledNotifier) Close() error {
+	tn.mutState.Lock()
+	tn.closed = true
+	if tn.dropReportTimer != nil {
+		tn.dropReportTimer.Stop()
+	}
+	dropped := tn.dropped
+	flushNow := tn.pending != nil && tn.flushTimer.Stop()
+	tn.mutState.Unlock()
+
+	if dropped.notifications > 0 {
+		log.Warn("closed notifier had unreported dropped notifications", "notifier", tn.notifier.GetID(), "num notifications", dropped.notifications)
+	}
+	if flushNow {
+		tn.flush()
+	}
+
+	return nil
+}
+
+// GetID returns the id of the wrapped notifier
+func (tn *throttledNotifier) GetID() string {
+	return tn.notifier.GetID()
+}
+
+// NotifiesResolved returns true if the wrapped notifier is able to notify the resolved alerts
+func (tn *throttledNotifier) NotifiesResolved() bool {
+	resolveNotifier, ok := tn.notifier.(process.ResolveNotifier)
+
+	return ok && resolveNotifier.NotifiesResolved()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tn *throttledNotifier) IsInterfaceNil() bool {
+	return tn == nil
+}
+
+func (ds *droppedStats) add(messages []data.NotificationMessage, now time.Time) {
+	if ds.notifications == 0 {
+		ds.since = now
+	}
+	for _, msg := range messages {
+		ds.notifications++
+		ds.alerts += len(msg.Alerts)
+		if msg.Level > ds.level {
+			ds.level = msg.Level
+		}
+	}
+}
+
+func (ds *droppedStats) merge(other droppedStats) {
+	if ds.notifications == 0 || other.since.Before(ds.since) {
+		ds.since = other.since
+	}
+	ds.notifications += other.notifications
+	ds.alerts += other.alerts
+	if other.level > ds.level {
+		ds.level = other.level
+	}
+}
+
+func (ds droppedStats) summary() string {
+	return fmt.Sprintf("%s with %s dropped by the rate limit since %s",
+		plural(ds.notifications, "notification"),
+		plural(ds.alerts, "alert"),
+		ds.since.Format(droppedTimeFormat),
+	)
+}
+
+// mergeMessages creates the message sent for a grouping window: a single message is sent as it is, while more
+// messages are sent as a summary with the counts of the alerts, followed by every message text
+func mergeMessages(messages []data.NotificationMessage, dropped droppedStats) data.NotificationMessage {
+	if len(messages) == 1 && dropped.notifications == 0 {
+		return messages[0]
+	}
+
+	merged := data.NotificationMessage{}
+	lines := make([]string, 0, len(messages)+2)
+	if dropped.notifications > 0 {
+		merged.Level = dropped.level
+		lines = append(lines, dropped.summary())
+	}
+	if len(messages) > 1 {
+		lines = append(lines, groupSummary(messages))
+	}
+
+	for _, msg := range messages {
+		if msg.Level > merged.Level {
+			merged.Level = msg.Level
+		}
+		merged.Alerts = append(merged.Alerts, msg.Alerts...)
+		merged.Resolved = append(merged.Resolved, msg.Resolved...)
+		if msg.Message != "" {
+			lines = append(lines, msg.Message)
+		}
+	}
+	merged.Message = strings.Join(lines, "\n")
+
+	return merged
+}
+
+func groupSummary(messages []data.NotificationMessage) string {
+	countByLevel := make(map[common.EventLevel]int)
+	numResolved := 0
+	for _, msg := range messages {
+		for _, alert := range msg.Alerts {
+			countByLevel[alert.Level]++
+		}
+		numResolved += len(msg.Resolved)
+	}
+
+	counts := make([]string, 0, 4)
+	for _, level := range []common.EventLevel{common.CriticalEvent, common.WarningEvent, common.InfoEvent} {
+		if countByLevel[level] > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", countByLevel[level], level))
+		}
+	}
+	if numResolved > 0 {
+		counts = append(counts, fmt.Sprintf("%d resolved", numResolved))
+	}
+
+	summary := fmt.Sprintf("%d notifications grouped", len(messages))
+	if len(counts) == 0 {
+		return summary
+	}
+
+	return fmt.Sprintf("%s: %s", summary, strings.Join(counts, ", "))
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
+}
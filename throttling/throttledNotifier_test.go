@@ -0,0 +1,291 @@
+package throttling_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/common"
+	"github.com/multiversx/mx-chain-node-monitoring/config"
+	"github.com/multiversx/mx-chain-node-monitoring/data"
+	"github.com/multiversx/mx-chain-node-monitoring/process/mocks"
+	"github.com/multiversx/mx-chain-node-monitoring/throttling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGroupingWindow = 50 * time.Millisecond
+
+// pushedMessages collects the messages pushed to a notifier stub
+type pushedMessages struct {
+	messages []data.NotificationMessage
+	mut      sync.Mutex
+}
+
+func (pm *pushedMessages) notifier(err error) *mocks.ResolveNotifierStub {
+	return &mocks.ResolveNotifierStub{
+		NotifierStub: mocks.NotifierStub{
+			PushMessageCalled: func(msg data.NotificationMessage) error {
+				pm.mut.Lock()
+				pm.messages = append(pm.messages, msg)
+				pm.mut.Unlock()
+				return err
+			},
+			GetIDCalled: func() string {
+				return "Slack"
+			},
+		},
+	}
+}
+
+func (pm *pushedMessages) get() []data.NotificationMessage {
+	pm.mut.Lock()
+	defer pm.mut.Unlock()
+
+	return append([]data.NotificationMessage{}, pm.messages...)
+}
+
+func createMockThrottledNotifierArgs() throttling.ArgsThrottledNotifier {
+	return throttling.ArgsThrottledNotifier{
+		Notifier:       &mocks.NotifierStub{},
+		RateLimit:      config.RateLimit{MessagesPerMinute: 60, Burst: 10},
+		GroupingWindow: testGroupingWindow,
+	}
+}
+
+func createAlertMessage(name string, level common.EventLevel) data.NotificationMessage {
+	return data.NotificationMessage{
+		Level:   level,
+		Message: name + " temp rating decreased",
+		Alerts:  []data.Alert{{NodeName: name, Level: level}},
+	}
+}
+
+// pushConcurrently pushes the messages as the notify processor does, on separate go routines, and returns their results
+func pushConcurrently(notifier interface {
+	PushMessage(msg data.NotificationMessage) error
+}, messages ...data.NotificationMessage) []error {
+	results := make([]error, len(messages))
+	wg := sync.WaitGroup{}
+	wg.Add(len(messages))
+	for i := range messages {
+		go func(idx int) {
+			results[idx] = notifier.PushMessage(messages[idx])
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func TestNewThrottledNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil notifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = nil
+
+		tn, err := throttling.NewThrottledNotifier(args)
+		assert.Nil(t, tn)
+		assert.Equal(t, throttling.ErrNilNotifier, err)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		invalidArgs := []func(args *throttling.ArgsThrottledNotifier){
+			func(args *throttling.ArgsThrottledNotifier) { args.RateLimit.MessagesPerMinute = 0 },
+			func(args *throttling.ArgsThrottledNotifier) { args.RateLimit.Burst = 0 },
+			func(args *throttling.ArgsThrottledNotifier) { args.GroupingWindow = -time.Second },
+		}
+		for _, setInvalidValue := range invalidArgs {
+			args := createMockThrottledNotifierArgs()
+			setInvalidValue(&args)
+
+			tn, err := throttling.NewThrottledNotifier(args)
+			assert.Nil(t, tn)
+			assert.True(t, errors.Is(err, common.ErrInvalidValue))
+		}
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = (&pushedMessages{}).notifier(nil)
+
+		tn, err := throttling.NewThrottledNotifier(args)
+		require.Nil(t, err)
+		assert.False(t, tn.IsInterfaceNil())
+		assert.Equal(t, "Slack", tn.GetID())
+		assert.True(t, tn.NotifiesResolved())
+
+		args.Notifier = &mocks.NotifierStub{}
+		tn, _ = throttling.NewThrottledNotifier(args)
+		assert.False(t, tn.NotifiesResolved())
+	})
+}
+
+func TestThrottledNotifier_PushMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single message is sent as it is", func(t *testing.T) {
+		t.Parallel()
+
+		pushed := &pushedMessages{}
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = pushed.notifier(nil)
+		tn, _ := throttling.NewThrottledNotifier(args)
+
+		msg := createAlertMessage("node-1", common.WarningEvent)
+		require.Nil(t, tn.PushMessage(msg))
+		assert.Equal(t, []data.NotificationMessage{msg}, pushed.get())
+	})
+
+	t.Run("messages within the grouping window are sent as a summary", func(t *testing.T) {
+		t.Parallel()
+
+		pushed := &pushedMessages{}
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = pushed.notifier(nil)
+		tn, _ := throttling.NewThrottledNotifier(args)
+
+		resolved := data.NotificationMessage{
+			Level:    common.InfoEvent,
+			Resolved: []data.Alert{{NodeName: "node-4"}},
+		}
+		results := pushConcurrently(tn,
+			createAlertMessage("node-1", common.CriticalEvent),
+			createAlertMessage("node-2", common.WarningEvent),
+			createAlertMessage("node-3", common.CriticalEvent),
+			resolved,
+		)
+		assert.Equal(t, []error{nil, nil, nil, nil}, results)
+
+		messages := pushed.get()
+		require.Len(t, messages, 1)
+		assert.Equal(t, common.CriticalEvent, messages[0].Level)
+		assert.Len(t, messages[0].Alerts, 3)
+		assert.Len(t, messages[0].Resolved, 1)
+
+		lines := strings.Split(messages[0].Message, "\n")
+		require.Len(t, lines, 4)
+		assert.Equal(t, "4 notifications grouped: 2 critical, 1 warning, 1 resolved", lines[0])
+		assert.ElementsMatch(t, []string{
+			"node-1 temp rating decreased",
+			"node-2 temp rating decreased",
+			"node-3 temp rating decreased",
+		}, lines[1:])
+	})
+
+	t.Run("push error is returned to every message of the window", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = (&pushedMessages{}).notifier(expectedErr)
+		tn, _ := throttling.NewThrottledNotifier(args)
+
+		results := pushConcurrently(tn,
+			createAlertMessage("node-1", common.CriticalEvent),
+			createAlertMessage("node-2", common.CriticalEvent),
+		)
+		assert.Equal(t, []error{expectedErr, expectedErr}, results)
+	})
+
+	t.Run("dropped messages are reported later", func(t *testing.T) {
+		t.Parallel()
+
+		pushed := &pushedMessages{}
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = pushed.notifier(nil)
+		// a token every 300ms
+		args.RateLimit = config.RateLimit{MessagesPerMinute: 200, Burst: 1}
+		args.GroupingWindow = 0
+		tn, _ := throttling.NewThrottledNotifier(args)
+
+		require.Nil(t, tn.PushMessage(createAlertMessage("node-1", common.WarningEvent)))
+		assert.Equal(t, throttling.ErrRateLimited, tn.PushMessage(createAlertMessage("node-2", common.CriticalEvent)))
+		assert.Equal(t, throttling.ErrRateLimited, tn.PushMessage(createAlertMessage("node-3", common.WarningEvent)))
+		require.Len(t, pushed.get(), 1)
+
+		require.Eventually(t, func() bool {
+			return len(pushed.get()) == 2
+		}, time.Second, 10*time.Millisecond)
+
+		report := pushed.get()[1]
+		assert.Equal(t, common.CriticalEvent, report.Level)
+		assert.Empty(t, report.Alerts)
+		assert.True(t, strings.HasPrefix(report.Message, "2 notifications with 2 alerts dropped by the rate limit since "))
+
+		// the count is reset once reported
+		time.Sleep(300 * time.Millisecond)
+		msg := createAlertMessage("node-1", common.InfoEvent)
+		require.Nil(t, tn.PushMessage(msg))
+		assert.Equal(t, msg, pushed.get()[2])
+	})
+
+	t.Run("dropped messages are reported with the next message", func(t *testing.T) {
+		t.Parallel()
+
+		pushed := &pushedMessages{}
+		args := createMockThrottledNotifierArgs()
+		args.Notifier = pushed.notifier(nil)
+		// a token every 200ms, while a window lasts 150ms
+		args.RateLimit = config.RateLimit{MessagesPerMinute: 300, Burst: 1}
+		args.GroupingWindow = 150 * time.Millisecond
+		tn, _ := throttling.NewThrottledNotifier(args)
+
+		require.Nil(t, tn.PushMessage(createAlertMessage("node-1", common.WarningEvent)))
+		assert.Equal(t, throttling.ErrRateLimited, tn.PushMessage(createAlertMessage("node-2", common.CriticalEvent)))
+		// the window in progress when the token is refilled carries the dropped count
+		require.Nil(t, tn.PushMessage(createAlertMessage("node-3", common.InfoEvent)))
+
+		messages := pushed.get()
+		require.Len(t, messages, 2)
+		assert.Equal(t, common.CriticalEvent, messages[1].Level)
+		assert.Len(t, messages[1].Alerts, 1)
+
+		lines := strings.Split(messages[1].Message, "\n")
+		require.Len(t, lines, 2)
+		assert.True(t, strings.HasPrefix(lines[0], "1 notification with 1 alert dropped by the rate limit since "))
+		assert.Equal(t, "node-3 temp rating decreased", lines[1])
+	})
+}
+
+func TestThrottledNotifier_Close(t *testing.T) {
+	t.Parallel()
+
+	pushed := &pushedMessages{}
+	args := createMockThrottledNotifierArgs()
+	args.Notifier = pushed.notifier(nil)
+	args.GroupingWindow = time.Hour
+	tn, _ := throttling.NewThrottledNotifier(args)
+
+	msg := createAlertMessage("node-1", common.WarningEvent)
+	result := make(chan error)
+	go func() {
+		result <- tn.PushMessage(msg)
+	}()
+	// let the message join the grouping window
+	time.Sleep(testGroupingWindow)
+
+	// the pending window is sent without waiting for the grouping window to end
+	require.Nil(t, tn.Close())
+	select {
+	case err := <-result:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "pending message not sent on close")
+	}
+	assert.Equal(t, []data.NotificationMessage{msg}, pushed.get())
+
+	// after close, the messages are sent right away
+	require.Nil(t, tn.PushMessage(msg))
+	assert.Len(t, pushed.get(), 2)
+}
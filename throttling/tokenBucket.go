@@ -0,0 +1,57 @@
+package throttling
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket allows bursts of up to capacity messages, refilled at a constant rate. It is not concurrent safe
+type tokenBucket struct {
+	capacity     float64
+	ratePerSec   float64
+	tokens       float64
+	lastRefillAt time.Time
+}
+
+func newTokenBucket(messagesPerMinute float64, burst int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:     float64(burst),
+		ratePerSec:   messagesPerMinute / 60,
+		tokens:       float64(burst),
+		lastRefillAt: now,
+	}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefillAt).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.ratePerSec)
+	tb.lastRefillAt = now
+}
+
+// take consumes a token, if there is one available
+func (tb *tokenBucket) take(now time.Time) bool {
+	tb.refill(now)
+	if tb.tokens < 1 {
+		return false
+	}
+
+	tb.tokens--
+
+	return true
+}
+
+// nextTokenIn returns the time until a token becomes available
+func (tb *tokenBucket) nextTokenIn(now time.Time) time.Duration {
+	tb.refill(now)
+	if tb.tokens >= 1 {
+		return 0
+	}
+
+	seconds := (1 - tb.tokens) / tb.ratePerSec
+
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,35 @@
+package throttling_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-node-monitoring/throttling"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	tb := throttling.NewTokenBucket(6, 2, now)
+
+	// the burst is available at once
+	assert.True(t, tb.Take(now))
+	assert.True(t, tb.Take(now))
+	assert.False(t, tb.Take(now))
+	assert.Equal(t, 10*time.Second, tb.NextTokenIn(now))
+
+	// 6 messages per minute refill a token every 10 seconds
+	assert.False(t, tb.Take(now.Add(9*time.Second)))
+	assert.Equal(t, time.Second, tb.NextTokenIn(now.Add(9*time.Second)))
+	assert.True(t, tb.Take(now.Add(10*time.Second)))
+	assert.False(t, tb.Take(now.Add(10*time.Second)))
+
+	// the tokens are not accumulated over the burst
+	later := now.Add(time.Hour)
+	assert.Equal(t, time.Duration(0), tb.NextTokenIn(later))
+	assert.True(t, tb.Take(later))
+	assert.True(t, tb.Take(later))
+	assert.False(t, tb.Take(later))
+}